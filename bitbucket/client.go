@@ -0,0 +1,258 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"release-manager/bitbucket/model"
+	"release-manager/bitbucket/util"
+	"release-manager/pkg/validatorx"
+	svcerrors "release-manager/service/errors"
+	svcmodel "release-manager/service/model"
+)
+
+const (
+	// cloudAPIURL is the API of Bitbucket Cloud, it is served from a different host than the web
+	cloudAPIURL = "https://api.bitbucket.org/2.0"
+	// serverAPIPath is relative to the base URL of the Bitbucket Server instance
+	serverAPIPath = "/rest/api/1.0"
+	// tagsPerPage is the maximum number of items Bitbucket Cloud returns per page, Server accepts larger pages
+	tagsPerPage = 100
+	// requestTimeout limits requests to Bitbucket Server instances which are not reachable
+	requestTimeout = 30 * time.Second
+)
+
+type Client struct {
+	httpClient *http.Client
+}
+
+func NewClient() *Client {
+	return &Client{
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// ReadRepo reads the repo by its URL, the URL must belong to the Bitbucket instance set in the settings.
+func (c *Client) ReadRepo(ctx context.Context, inst svcmodel.BitbucketInstance, rawRepoURL string) (svcmodel.BitbucketRepo, error) {
+	workspace, repoSlug, err := util.ParseBitbucketRepoURL(inst, rawRepoURL)
+	if err != nil {
+		return svcmodel.BitbucketRepo{}, svcerrors.NewBitbucketRepoInvalidURLError().Wrap(err).WithMessage(err.Error())
+	}
+
+	repo := svcmodel.BitbucketRepo{Workspace: workspace, RepoSlug: repoSlug}
+	if inst.IsCloud() {
+		var r model.CloudRepository
+		if err := c.do(ctx, inst, apiURL(inst, repoEndpoint(inst, repo, ""), nil), &r); err != nil {
+			return svcmodel.BitbucketRepo{}, translateRepoError(err)
+		}
+
+		return model.ToSvcCloudRepo(r)
+	}
+
+	var r model.ServerRepository
+	if err := c.do(ctx, inst, apiURL(inst, repoEndpoint(inst, repo, ""), nil), &r); err != nil {
+		return svcmodel.BitbucketRepo{}, translateRepoError(err)
+	}
+
+	return model.ToSvcServerRepo(r)
+}
+
+// ReadTagsForRepo returns all tags of the repo. Only Bitbucket Cloud returns the date of the tagged commit.
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-refs/#api-repositories-workspace-repo-slug-refs-tags-get
+// Docs: https://developer.atlassian.com/server/bitbucket/rest/v815/api-group-repository/#api-api-latest-projects-projectkey-repos-repositoryslug-tags-get
+func (c *Client) ReadTagsForRepo(ctx context.Context, inst svcmodel.BitbucketInstance, repo svcmodel.BitbucketRepo) ([]svcmodel.RepoGitTag, error) {
+	if inst.IsCloud() {
+		return c.readCloudTags(ctx, inst, repo)
+	}
+
+	return c.readServerTags(ctx, inst, repo)
+}
+
+// ReadTag checks that the tag exists, both lightweight and annotated tags are read by their name.
+func (c *Client) ReadTag(ctx context.Context, inst svcmodel.BitbucketInstance, repo svcmodel.BitbucketRepo, tagName string) (svcmodel.GitTag, error) {
+	endpoint := tagsEndpoint(inst, repo) + "/" + escapeRefName(tagName)
+	if err := c.do(ctx, inst, apiURL(inst, endpoint, nil), nil); err != nil {
+		if util.IsNotFoundError(err) {
+			return svcmodel.GitTag{}, svcerrors.NewGitTagNotFoundError().Wrap(err)
+		}
+
+		return svcmodel.GitTag{}, util.TranslateBitbucketAuthError(err)
+	}
+
+	return model.ToSvcGitTag(tagName, repo), nil
+}
+
+// ParseTagWebhook verifies the signature of the delivery and returns the tags deleted by the push.
+// Cloud sends repo:push and Server sends repo:refs_changed events, the payloads of the events differ.
+func (c *Client) ParseTagWebhook(
+	input svcmodel.BitbucketWebhookInput,
+	secret svcmodel.BitbucketWebhookSecret,
+) (svcmodel.BitbucketTagWebhookOutput, error) {
+	if !util.IsValidWebhookPayload(input.RawPayload, input.Signature, secret) {
+		return svcmodel.BitbucketTagWebhookOutput{}, svcerrors.NewInvalidBitbucketWebhookError().WithMessage("invalid payload signature")
+	}
+
+	switch input.Event {
+	case svcmodel.BitbucketCloudPushEvent:
+		var payload model.CloudPushWebhookInput
+		if err := parseWebhookPayload(input.RawPayload, &payload); err != nil {
+			return svcmodel.BitbucketTagWebhookOutput{}, err
+		}
+
+		return model.ToSvcCloudTagWebhookOutput(payload)
+	case svcmodel.BitbucketServerRefsChangedEvent:
+		var payload model.ServerRefsChangedWebhookInput
+		if err := parseWebhookPayload(input.RawPayload, &payload); err != nil {
+			return svcmodel.BitbucketTagWebhookOutput{}, err
+		}
+
+		return model.ToSvcServerTagWebhookOutput(payload)
+	default:
+		return svcmodel.BitbucketTagWebhookOutput{},
+			svcerrors.NewBitbucketEventNotSupportedError().WithMessage(fmt.Sprintf("event %q is not supported", input.Event))
+	}
+}
+
+// readCloudTags fetches pages until there is no next page, Bitbucket Cloud returns the URL of the next page.
+func (c *Client) readCloudTags(ctx context.Context, inst svcmodel.BitbucketInstance, repo svcmodel.BitbucketRepo) ([]svcmodel.RepoGitTag, error) {
+	var tags []model.CloudTag
+	next := apiURL(inst, tagsEndpoint(inst, repo), url.Values{"pagelen": {strconv.Itoa(tagsPerPage)}})
+	for next != "" {
+		var page model.CloudPage[model.CloudTag]
+		if err := c.do(ctx, inst, next, &page); err != nil {
+			return nil, translateRepoError(err)
+		}
+
+		tags = append(tags, page.Values...)
+		next = page.Next
+	}
+
+	return model.ToSvcCloudRepoGitTags(tags, repo), nil
+}
+
+// readServerTags fetches pages until the last page, Bitbucket Server returns the start of the next page.
+func (c *Client) readServerTags(ctx context.Context, inst svcmodel.BitbucketInstance, repo svcmodel.BitbucketRepo) ([]svcmodel.RepoGitTag, error) {
+	var tags []model.ServerTag
+	query := url.Values{"limit": {strconv.Itoa(tagsPerPage)}}
+	for {
+		var page model.ServerPage[model.ServerTag]
+		if err := c.do(ctx, inst, apiURL(inst, tagsEndpoint(inst, repo), query), &page); err != nil {
+			return nil, translateRepoError(err)
+		}
+
+		tags = append(tags, page.Values...)
+
+		if page.IsLastPage {
+			break
+		}
+		query.Set("start", strconv.Itoa(page.NextPageStart))
+	}
+
+	return model.ToSvcServerRepoGitTags(tags, repo), nil
+}
+
+func parseWebhookPayload(rawPayload []byte, payload any) error {
+	if err := json.Unmarshal(rawPayload, payload); err != nil {
+		return svcerrors.NewInvalidBitbucketWebhookError().Wrap(err)
+	}
+
+	if err := validatorx.ValidateStruct(payload); err != nil {
+		return svcerrors.NewInvalidBitbucketWebhookError().Wrap(err)
+	}
+
+	return nil
+}
+
+// do sends a GET request to the Bitbucket API, the response body is decoded into out if it is not nil.
+// Responses with an error status code are returned as util.APIError.
+func (c *Client) do(ctx context.Context, inst svcmodel.BitbucketInstance, rawURL string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	// Access tokens of both Cloud and Server are sent as bearer tokens
+	req.Header.Set("Authorization", "Bearer "+inst.Token.String())
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		// The body of the error is read only for the message, some errors have no body
+		var errResp model.ErrorResponse
+		_ = json.NewDecoder(resp.Body).Decode(&errResp)
+
+		msg := errResp.String()
+		if msg == "" {
+			msg = http.StatusText(resp.StatusCode)
+		}
+
+		return &util.APIError{StatusCode: resp.StatusCode, Message: msg}
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return fmt.Errorf("decoding response body: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func translateRepoError(err error) error {
+	if util.IsNotFoundError(err) {
+		return svcerrors.NewBitbucketRepoNotFoundError().Wrap(err)
+	}
+
+	return util.TranslateBitbucketAuthError(err)
+}
+
+// apiURL returns the URL of the endpoint on the API of Bitbucket Cloud or the Bitbucket Server instance.
+func apiURL(inst svcmodel.BitbucketInstance, endpoint string, query url.Values) string {
+	base := inst.BaseURL.String() + serverAPIPath
+	if inst.IsCloud() {
+		base = cloudAPIURL
+	}
+
+	if query == nil {
+		return base + endpoint
+	}
+
+	return base + endpoint + "?" + query.Encode()
+}
+
+// repoEndpoint returns the endpoint of the repo, repos belong to workspaces on Cloud and to projects on Server.
+func repoEndpoint(inst svcmodel.BitbucketInstance, repo svcmodel.BitbucketRepo, subpath string) string {
+	if inst.IsCloud() {
+		return "/repositories/" + url.PathEscape(repo.Workspace) + "/" + url.PathEscape(repo.RepoSlug) + subpath
+	}
+
+	return "/projects/" + url.PathEscape(repo.Workspace) + "/repos/" + url.PathEscape(repo.RepoSlug) + subpath
+}
+
+func tagsEndpoint(inst svcmodel.BitbucketInstance, repo svcmodel.BitbucketRepo) string {
+	if inst.IsCloud() {
+		return repoEndpoint(inst, repo, "/refs/tags")
+	}
+
+	return repoEndpoint(inst, repo, "/tags")
+}
+
+// escapeRefName escapes the segments of the ref name, slashes are kept since encoded slashes are rejected by Bitbucket Server.
+func escapeRefName(name string) string {
+	segments := strings.Split(name, "/")
+	for i, s := range segments {
+		segments[i] = url.PathEscape(s)
+	}
+
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,33 @@
+package mock
+
+import (
+	"context"
+
+	svcmodel "release-manager/service/model"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type Client struct {
+	mock.Mock
+}
+
+func (c *Client) ReadRepo(ctx context.Context, inst svcmodel.BitbucketInstance, rawRepoURL string) (svcmodel.BitbucketRepo, error) {
+	args := c.Called(ctx, inst, rawRepoURL)
+	return args.Get(0).(svcmodel.BitbucketRepo), args.Error(1)
+}
+
+func (c *Client) ReadTagsForRepo(ctx context.Context, inst svcmodel.BitbucketInstance, repo svcmodel.BitbucketRepo) ([]svcmodel.RepoGitTag, error) {
+	args := c.Called(ctx, inst, repo)
+	return args.Get(0).([]svcmodel.RepoGitTag), args.Error(1)
+}
+
+func (c *Client) ReadTag(ctx context.Context, inst svcmodel.BitbucketInstance, repo svcmodel.BitbucketRepo, tagName string) (svcmodel.GitTag, error) {
+	args := c.Called(ctx, inst, repo, tagName)
+	return args.Get(0).(svcmodel.GitTag), args.Error(1)
+}
+
+func (c *Client) ParseTagWebhook(input svcmodel.BitbucketWebhookInput, secret svcmodel.BitbucketWebhookSecret) (svcmodel.BitbucketTagWebhookOutput, error) {
+	args := c.Called(input, secret)
+	return args.Get(0).(svcmodel.BitbucketTagWebhookOutput), args.Error(1)
+}
@@ -0,0 +1,240 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	svcmodel "release-manager/service/model"
+)
+
+const (
+	// cloudTagRefType is the type of tag refs in push events of Bitbucket Cloud
+	cloudTagRefType = "tag"
+	// serverTagRefType is the type of tag refs in push events of Bitbucket Server
+	serverTagRefType = "TAG"
+	// serverDeleteChangeType is the type of ref changes which deleted the ref on Bitbucket Server
+	serverDeleteChangeType = "DELETE"
+	// serverBrowsePath is appended to the web URL of Bitbucket Server repos returned by the API
+	serverBrowsePath = "/browse"
+)
+
+var errInvalidRepoFullName = errors.New("invalid Bitbucket repo full name, not in the format workspace/repo")
+
+type Link struct {
+	Href string `json:"href"`
+}
+
+// CloudRepository is a repo on Bitbucket Cloud, the full name consists of the workspace and repo slugs
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-repositories-workspace-repo-slug-get
+type CloudRepository struct {
+	FullName string `json:"full_name" validate:"required"`
+	Links    struct {
+		HTML Link `json:"html"`
+	} `json:"links"`
+}
+
+// CloudTag is a git tag of a Bitbucket Cloud repo, the target is the tagged commit
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-refs/#api-repositories-workspace-repo-slug-refs-tags-get
+type CloudTag struct {
+	Name   string `json:"name"`
+	Target *struct {
+		Date *time.Time `json:"date"`
+	} `json:"target"`
+}
+
+// CloudPage is a page of a list on Bitbucket Cloud, next is the URL of the next page and is empty on the last page
+type CloudPage[T any] struct {
+	Values []T    `json:"values"`
+	Next   string `json:"next"`
+}
+
+// ServerRepository is a repo on Bitbucket Server, it belongs to a project identified by its key
+// Docs: https://developer.atlassian.com/server/bitbucket/rest/v815/api-group-repository/
+type ServerRepository struct {
+	Slug    string `json:"slug" validate:"required"`
+	Project struct {
+		Key string `json:"key" validate:"required"`
+	} `json:"project"`
+	Links struct {
+		Self []Link `json:"self"`
+	} `json:"links"`
+}
+
+// ServerTag is a git tag of a Bitbucket Server repo, the API does not return the date of the tagged commit
+type ServerTag struct {
+	DisplayID string `json:"displayId"`
+}
+
+// ServerPage is a page of a list on Bitbucket Server, pages are requested from the start of the next page
+type ServerPage[T any] struct {
+	Values        []T  `json:"values"`
+	IsLastPage    bool `json:"isLastPage"`
+	NextPageStart int  `json:"nextPageStart"`
+}
+
+// ErrorResponse is the body of Bitbucket API errors, Cloud returns a single error and Server a list of them
+type ErrorResponse struct {
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func (r ErrorResponse) String() string {
+	if r.Error != nil {
+		return r.Error.Message
+	}
+
+	messages := make([]string, 0, len(r.Errors))
+	for _, e := range r.Errors {
+		messages = append(messages, e.Message)
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// CloudPushWebhookInput is the payload of the repo:push event, the old ref of a deleted ref is kept and the new one is null
+// Docs: https://support.atlassian.com/bitbucket-cloud/docs/event-payloads/#Push
+type CloudPushWebhookInput struct {
+	Repository CloudRepository `json:"repository"`
+	Push       struct {
+		Changes []struct {
+			Old *CloudRef `json:"old"`
+			New *CloudRef `json:"new"`
+		} `json:"changes"`
+	} `json:"push"`
+}
+
+type CloudRef struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+// ServerRefsChangedWebhookInput is the payload of the repo:refs_changed event
+// Docs: https://confluence.atlassian.com/bitbucketserver/event-payload-938025882.html#Eventpayload-Push
+type ServerRefsChangedWebhookInput struct {
+	Repository ServerRepository `json:"repository"`
+	Changes    []struct {
+		Ref struct {
+			DisplayID string `json:"displayId"`
+			Type      string `json:"type"`
+		} `json:"ref"`
+		Type string `json:"type"`
+	} `json:"changes"`
+}
+
+func ToSvcCloudRepo(r CloudRepository) (svcmodel.BitbucketRepo, error) {
+	workspace, repoSlug, found := strings.Cut(r.FullName, "/")
+	if !found || workspace == "" || repoSlug == "" {
+		return svcmodel.BitbucketRepo{}, errInvalidRepoFullName
+	}
+
+	u, err := url.Parse(r.Links.HTML.Href)
+	if err != nil {
+		return svcmodel.BitbucketRepo{}, fmt.Errorf("parsing Bitbucket repo URL: %w", err)
+	}
+
+	return svcmodel.BitbucketRepo{
+		URL:       *u,
+		Workspace: workspace,
+		RepoSlug:  repoSlug,
+	}, nil
+}
+
+// ToSvcServerRepo uses the self link as the URL of the repo, it points to the browse page of the repo which is trimmed.
+func ToSvcServerRepo(r ServerRepository) (svcmodel.BitbucketRepo, error) {
+	var u url.URL
+	if len(r.Links.Self) > 0 {
+		parsed, err := url.Parse(r.Links.Self[0].Href)
+		if err != nil {
+			return svcmodel.BitbucketRepo{}, fmt.Errorf("parsing Bitbucket repo URL: %w", err)
+		}
+
+		u = *parsed
+		u.Path = strings.TrimSuffix(u.Path, serverBrowsePath)
+	}
+
+	return svcmodel.BitbucketRepo{
+		URL:       u,
+		Workspace: r.Project.Key,
+		RepoSlug:  r.Slug,
+	}, nil
+}
+
+func ToSvcGitTag(tagName string, repo svcmodel.BitbucketRepo) svcmodel.GitTag {
+	return svcmodel.GitTag{
+		Name: tagName,
+		URL:  repo.TagURL(tagName),
+	}
+}
+
+func ToSvcCloudRepoGitTags(tags []CloudTag, repo svcmodel.BitbucketRepo) []svcmodel.RepoGitTag {
+	t := make([]svcmodel.RepoGitTag, 0, len(tags))
+	for _, tag := range tags {
+		var committedAt *time.Time
+		if tag.Target != nil {
+			committedAt = tag.Target.Date
+		}
+
+		t = append(t, svcmodel.RepoGitTag{
+			Tag:         ToSvcGitTag(tag.Name, repo),
+			CommittedAt: committedAt,
+		})
+	}
+
+	return t
+}
+
+func ToSvcServerRepoGitTags(tags []ServerTag, repo svcmodel.BitbucketRepo) []svcmodel.RepoGitTag {
+	t := make([]svcmodel.RepoGitTag, 0, len(tags))
+	for _, tag := range tags {
+		t = append(t, svcmodel.RepoGitTag{
+			Tag: ToSvcGitTag(tag.DisplayID, repo),
+		})
+	}
+
+	return t
+}
+
+func ToSvcCloudTagWebhookOutput(input CloudPushWebhookInput) (svcmodel.BitbucketTagWebhookOutput, error) {
+	repo, err := ToSvcCloudRepo(input.Repository)
+	if err != nil {
+		return svcmodel.BitbucketTagWebhookOutput{}, err
+	}
+
+	var deleted []string
+	for _, c := range input.Push.Changes {
+		if c.New == nil && c.Old != nil && c.Old.Type == cloudTagRefType {
+			deleted = append(deleted, c.Old.Name)
+		}
+	}
+
+	return svcmodel.BitbucketTagWebhookOutput{
+		Repo:            repo,
+		DeletedTagNames: deleted,
+	}, nil
+}
+
+func ToSvcServerTagWebhookOutput(input ServerRefsChangedWebhookInput) (svcmodel.BitbucketTagWebhookOutput, error) {
+	repo, err := ToSvcServerRepo(input.Repository)
+	if err != nil {
+		return svcmodel.BitbucketTagWebhookOutput{}, err
+	}
+
+	var deleted []string
+	for _, c := range input.Changes {
+		if c.Type == serverDeleteChangeType && c.Ref.Type == serverTagRefType {
+			deleted = append(deleted, c.Ref.DisplayID)
+		}
+	}
+
+	return svcmodel.BitbucketTagWebhookOutput{
+		Repo:            repo,
+		DeletedTagNames: deleted,
+	}, nil
+}
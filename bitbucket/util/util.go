@@ -0,0 +1,135 @@
+package util
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	svcerrors "release-manager/service/errors"
+	svcmodel "release-manager/service/model"
+)
+
+const (
+	// signaturePrefix precedes the hex encoded HMAC in the X-Hub-Signature header
+	signaturePrefix = "sha256="
+	// serverUserProjectKeyPrefix turns a username into the key of the personal project of the user on Bitbucket Server
+	serverUserProjectKeyPrefix = "~"
+)
+
+var (
+	errInvalidCloudRepoURLPath      = errors.New("invalid Bitbucket repo URL path, not in the format /workspace/repo")
+	errInvalidServerRepoURLPath     = errors.New("invalid Bitbucket repo URL path, not in the format /projects/KEY/repos/repo")
+	errBitbucketRepoURLHostMismatch = errors.New("Bitbucket repo URL does not belong to the Bitbucket instance set in the settings")
+)
+
+// APIError is returned for responses of the Bitbucket API with an error status code.
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("bitbucket API responded with status %d: %s", e.StatusCode, e.Message)
+}
+
+// ParseBitbucketRepoURL returns the workspace (project key on Bitbucket Server) and the slug of the repo.
+// Relative URLs are resolved against the base URL of the instance, absolute URLs must point to the same instance.
+// Cloud URL format: https://bitbucket.org/workspace/repo, pages of the repo (e.g. /src/main) are ignored.
+// Server URL formats: https://example.com/projects/KEY/repos/repo, https://example.com/users/name/repos/repo
+// and the clone URL https://example.com/scm/KEY/repo.git
+func ParseBitbucketRepoURL(inst svcmodel.BitbucketInstance, rawURL string) (workspace, repoSlug string, err error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", "", err
+	}
+
+	path := u.Path
+	if u.IsAbs() {
+		if u.Host != inst.BaseURL.Host {
+			return "", "", errBitbucketRepoURLHostMismatch
+		}
+
+		// Bitbucket Server can be served from a subpath (e.g. https://example.com/bitbucket)
+		path = strings.TrimPrefix(path, inst.BaseURL.Path)
+	}
+
+	slugs := strings.Split(strings.Trim(path, "/"), "/")
+	if inst.IsCloud() {
+		return parseCloudRepoPath(slugs)
+	}
+
+	return parseServerRepoPath(slugs)
+}
+
+func parseCloudRepoPath(slugs []string) (workspace, repoSlug string, err error) {
+	if len(slugs) < 2 || slugs[0] == "" || slugs[1] == "" {
+		return "", "", errInvalidCloudRepoURLPath
+	}
+
+	return slugs[0], strings.TrimSuffix(slugs[1], ".git"), nil
+}
+
+func parseServerRepoPath(slugs []string) (projectKey, repoSlug string, err error) {
+	switch {
+	case len(slugs) >= 4 && slugs[0] == "projects" && slugs[2] == "repos":
+		projectKey, repoSlug = slugs[1], slugs[3]
+	case len(slugs) >= 4 && slugs[0] == "users" && slugs[2] == "repos":
+		projectKey, repoSlug = serverUserProjectKeyPrefix+slugs[1], slugs[3]
+	case len(slugs) == 3 && slugs[0] == "scm":
+		projectKey, repoSlug = slugs[1], strings.TrimSuffix(slugs[2], ".git")
+	default:
+		return "", "", errInvalidServerRepoURLPath
+	}
+
+	if projectKey == "" || projectKey == serverUserProjectKeyPrefix || repoSlug == "" {
+		return "", "", errInvalidServerRepoURLPath
+	}
+
+	return projectKey, repoSlug, nil
+}
+
+// IsValidWebhookPayload validates the payload of a Bitbucket webhook
+// using the secret and the signature provided in X-Hub-Signature header, Cloud and Server sign payloads the same way.
+// Unlike GitHub webhooks, which can be verified by the secret of the repo, a webhook without a secret is not accepted.
+// Docs: https://support.atlassian.com/bitbucket-cloud/docs/manage-webhooks/#Secure-webhooks
+func IsValidWebhookPayload(rawPayload []byte, signature string, secret svcmodel.BitbucketWebhookSecret) bool {
+	if secret == "" || signature == "" {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	// always returns nil error
+	_, _ = mac.Write(rawPayload)
+	expectedSignature := signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+
+	return hmac.Equal([]byte(expectedSignature), []byte(signature))
+}
+
+func IsNotFoundError(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusNotFound
+	}
+
+	return false
+}
+
+// TranslateBitbucketAuthError translates Bitbucket auth errors to service errors
+func TranslateBitbucketAuthError(err error) error {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusUnauthorized:
+			return svcerrors.NewBitbucketClientUnauthorizedError().Wrap(err)
+		case http.StatusForbidden:
+			return svcerrors.NewBitbucketClientForbiddenError().Wrap(err)
+		}
+	}
+
+	return err
+}
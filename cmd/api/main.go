@@ -7,8 +7,10 @@ import (
 	"os"
 
 	"release-manager/auth"
+	bitbucketx "release-manager/bitbucket"
 	"release-manager/config"
 	githubx "release-manager/github"
+	gitlabx "release-manager/gitlab"
 	"release-manager/repository"
 	resendx "release-manager/resend"
 	"release-manager/service"
@@ -43,11 +45,13 @@ func run() error {
 	})
 
 	supaClient := supabase.CreateClient(cfg.Supabase.APIURL, cfg.Supabase.APISecretKey)
-	githubClient := githubx.NewClient()
+	githubClient := githubx.NewClient(cfg.Github)
+	gitlabClient := gitlabx.NewClient()
+	bitbucketClient := bitbucketx.NewClient()
 	resendClient := resendx.NewClient(taskManager, cfg.Resend, cfg.ClientService)
 	authClient := auth.NewClient(supaClient)
 	slackClient := slack.NewClient()
-	storageClient := storage.NewClient(supaClient, cfg.Supabase.StorageBucket)
+	storageClient := storage.NewClient(supaClient, cfg.Supabase)
 
 	dbpool, err := pgxpool.New(ctx, cfg.Supabase.DatabaseURL)
 	if err != nil {
@@ -66,8 +70,11 @@ func run() error {
 		repo.Settings,
 		repo.Release,
 		githubClient,
+		gitlabClient,
+		bitbucketClient,
 		resendClient,
 		slackClient,
+		storageClient,
 	)
 	h := handler.NewHandler(authClient, svc.User, svc.Project, svc.Settings, svc.Release)
 
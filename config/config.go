@@ -38,6 +38,12 @@ type ClientServiceConfig struct {
 	RejectInvitationRoute string `env:"REJECT_INVITATION_ROUTE, required"`
 }
 
+// GithubConfig is used for registering webhooks on GitHub repositories of projects
+type GithubConfig struct {
+	// WebhookURL is the public URL of the GitHub webhook endpoint of the service
+	WebhookURL string `env:"WEBHOOK_URL, required"`
+}
+
 type ServiceConfig struct {
 	Port          uint                `env:"PORT, default=8080"`
 	LogLevel      slog.Level          `env:"LOG_LEVEL, default=INFO"`
@@ -45,6 +51,7 @@ type ServiceConfig struct {
 	Server        ServerConfig        `env:", prefix=SERVER_"`
 	Resend        ResendConfig        `env:", prefix=RESEND_"`
 	ClientService ClientServiceConfig `env:", prefix=CLIENT_SERVICE_"`
+	Github        GithubConfig        `env:", prefix=GITHUB_"`
 }
 
 func Load(ctx context.Context) ServiceConfig {
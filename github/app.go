@@ -0,0 +1,180 @@
+package github
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"release-manager/github/util"
+	svcerrors "release-manager/service/errors"
+	svcmodel "release-manager/service/model"
+
+	"github.com/google/go-github/v60/github"
+)
+
+const (
+	// appJWTExpiration is below the 10 minutes maximum allowed by GitHub
+	appJWTExpiration = 9 * time.Minute
+	// appJWTClockDrift backdates the JWT issue time to allow for clock drift between the app and GitHub
+	appJWTClockDrift = time.Minute
+	// installationTokenRefreshMargin makes sure a cached token does not expire while a request is in progress
+	installationTokenRefreshMargin = 5 * time.Minute
+)
+
+var (
+	errAppPrivateKeyNotRSA = errors.New("private key is not an RSA key")
+)
+
+type installationTokenKey struct {
+	appID          int64
+	installationID int64
+}
+
+type installationToken struct {
+	token     svcmodel.GithubToken
+	expiresAt time.Time
+}
+
+// installationTokenCache keeps installation tokens until they are about to expire, so a token is not minted for every request.
+type installationTokenCache struct {
+	mu     sync.Mutex
+	tokens map[installationTokenKey]installationToken
+}
+
+func newInstallationTokenCache() *installationTokenCache {
+	return &installationTokenCache{
+		tokens: make(map[installationTokenKey]installationToken),
+	}
+}
+
+func (c *installationTokenCache) get(key installationTokenKey, now time.Time) (svcmodel.GithubToken, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t, ok := c.tokens[key]
+	if !ok || now.Add(installationTokenRefreshMargin).After(t.expiresAt) {
+		return "", false
+	}
+
+	return t.token, true
+}
+
+// owner returns the app installation the token was minted for, the owner stays the same when the token is refreshed.
+func (c *installationTokenCache) owner(tkn svcmodel.GithubToken) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, t := range c.tokens {
+		if t.token == tkn {
+			return fmt.Sprintf("installation:%d/%d", key.appID, key.installationID), true
+		}
+	}
+
+	return "", false
+}
+
+func (c *installationTokenCache) set(key installationTokenKey, t installationToken) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.tokens[key] = t
+}
+
+// CreateInstallationToken returns an installation token of the GitHub App, the token can be used in place of a personal access token.
+// Tokens are cached until they are about to expire.
+func (c *Client) CreateInstallationToken(ctx context.Context, app svcmodel.GithubAppSettings) (svcmodel.GithubToken, error) {
+	key := installationTokenKey{appID: app.AppID, installationID: app.InstallationID}
+	if tkn, ok := c.installationTokens.get(key, time.Now()); ok {
+		return tkn, nil
+	}
+
+	jwt, err := newAppJWT(app, time.Now())
+	if err != nil {
+		return "", svcerrors.NewGithubAppCredentialsInvalidError().Wrap(err)
+	}
+
+	client := github.NewClient(c.httpClient).WithAuthToken(jwt)
+	// Docs: https://docs.github.com/en/rest/apps/apps?apiVersion=2022-11-28#create-an-installation-access-token-for-an-app
+	t, _, err := client.Apps.CreateInstallationToken(ctx, app.InstallationID, nil)
+	if err != nil {
+		if util.IsNotFoundError(err) {
+			return "", svcerrors.NewGithubAppInstallationNotFoundError().Wrap(err)
+		}
+
+		return "", util.TranslateGithubAuthError(err)
+	}
+
+	tkn := svcmodel.GithubToken(t.GetToken())
+	c.installationTokens.set(key, installationToken{
+		token:     tkn,
+		expiresAt: t.GetExpiresAt().Time,
+	})
+
+	return tkn, nil
+}
+
+// newAppJWT creates a JWT signed by the private key of the GitHub App, it authenticates the app itself, not its installation.
+// Docs: https://docs.github.com/en/apps/creating-github-apps/authenticating-with-a-github-app/generating-a-json-web-token-jwt-for-a-github-app
+func newAppJWT(app svcmodel.GithubAppSettings, now time.Time) (string, error) {
+	key, err := parseAppPrivateKey(app.PrivateKey)
+	if err != nil {
+		return "", err
+	}
+
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]int64{
+		"iat": now.Add(-appJWTClockDrift).Unix(),
+		"exp": now.Add(appJWTExpiration).Unix(),
+		"iss": app.AppID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	hash := sha256.Sum256([]byte(unsigned))
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hash[:])
+	if err != nil {
+		return "", fmt.Errorf("signing jwt: %w", err)
+	}
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// parseAppPrivateKey parses the PEM encoded key, GitHub generates PKCS #1 keys, PKCS #8 keys are accepted as well.
+func parseAppPrivateKey(privateKey svcmodel.GithubAppPrivateKey) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(privateKey))
+	if block == nil {
+		return nil, errors.New("private key is not PEM encoded")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing private key: %w", err)
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errAppPrivateKeyNotRSA
+	}
+
+	return rsaKey, nil
+}
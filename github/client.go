@@ -4,8 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/url"
+	"time"
 
+	"release-manager/config"
 	"release-manager/github/model"
 	"release-manager/github/util"
 	"release-manager/pkg/validatorx"
@@ -16,13 +19,36 @@ import (
 )
 
 const (
-	tagsToFetch = 100
+	// tagsPerPage is the maximum number of refs GitHub returns per page of a GraphQL connection
+	tagsPerPage = 100
+	// graphQLEndpoint is relative to the base URL of the REST API
+	graphQLEndpoint = "graphql"
+	// releasesPerPage is the maximum number of releases GitHub returns per page
+	releasesPerPage = 100
+	// commitsToCompare is the maximum number of commits GitHub returns per page of a comparison
+	commitsToCompare = 250
+	// webhookContentType makes GitHub send the payload as the request body instead of a form field
+	webhookContentType = "json"
+	// pullRequestsPerCommit limits the number of pull requests fetched for a single commit
+	pullRequestsPerCommit = 10
+	// issuesPerPage is the maximum number of issues GitHub returns per page of search results
+	issuesPerPage = 100
 )
 
-type Client struct{}
+type Client struct {
+	cfg config.GithubConfig
+	// httpClient is shared by all requests, so cached responses can be reused across requests.
+	httpClient         *http.Client
+	installationTokens *installationTokenCache
+}
 
-func NewClient() *Client {
-	return &Client{}
+func NewClient(cfg config.GithubConfig) *Client {
+	installationTokens := newInstallationTokenCache()
+	return &Client{
+		cfg:                cfg,
+		httpClient:         newHTTPClient(installationTokens.owner),
+		installationTokens: installationTokens,
+	}
 }
 
 func (c *Client) ReadRepo(ctx context.Context, tkn svcmodel.GithubToken, rawRepoURL string) (svcmodel.GithubRepo, error) {
@@ -31,7 +57,7 @@ func (c *Client) ReadRepo(ctx context.Context, tkn svcmodel.GithubToken, rawRepo
 		return svcmodel.GithubRepo{}, svcerrors.NewGithubRepoInvalidURL().Wrap(err).WithMessage(err.Error())
 	}
 
-	return withGithubClientResult[svcmodel.GithubRepo](tkn, func(client *github.Client) (svcmodel.GithubRepo, error) {
+	return withGithubClientResult[svcmodel.GithubRepo](c.httpClient, tkn, func(client *github.Client) (svcmodel.GithubRepo, error) {
 		// Docs: https://docs.github.com/en/rest/repos/repos?apiVersion=2022-11-28#get-a-repository
 		repo, _, err := client.Repositories.Get(ctx, ownerSlug, repoSlug)
 		if err != nil {
@@ -46,31 +72,56 @@ func (c *Client) ReadRepo(ctx context.Context, tkn svcmodel.GithubToken, rawRepo
 	})
 }
 
-func (c *Client) ReadTagsForRepo(ctx context.Context, tkn svcmodel.GithubToken, repo svcmodel.GithubRepo) ([]svcmodel.GitTag, error) {
-	return withGithubClientResult[[]svcmodel.GitTag](tkn, func(client *github.Client) ([]svcmodel.GitTag, error) {
-		// Up to 100 tags can be fetched per page
-		// If the number of pages is not specified in the list options, only one page will be fetched
-		// Docs: https://docs.github.com/en/rest/repos/repos?apiVersion=2022-11-28#list-repository-tags
-		t, _, err := client.Repositories.ListTags(
-			ctx,
-			repo.OwnerSlug,
-			repo.RepoSlug,
-			&github.ListOptions{PerPage: tagsToFetch},
-		)
-		if err != nil {
-			if util.IsNotFoundError(err) {
-				return nil, svcerrors.NewGithubRepoNotFoundError().Wrap(err)
+// ReadTagsForRepo returns all tags of the repository with the date of the tagged commit.
+// GraphQL API is used, because the REST API does not return the commit date and tags would have to be read one by one.
+func (c *Client) ReadTagsForRepo(ctx context.Context, tkn svcmodel.GithubToken, repo svcmodel.GithubRepo) ([]svcmodel.RepoGitTag, error) {
+	return withGithubClientResult[[]svcmodel.RepoGitTag](c.httpClient, tkn, func(client *github.Client) ([]svcmodel.RepoGitTag, error) {
+		// Up to 100 refs can be fetched per page, pages are fetched until there is no next page
+		// Docs: https://docs.github.com/en/graphql/guides/using-pagination-in-the-graphql-api
+		var refs []model.GitTagRef
+		variables := map[string]any{
+			"owner": repo.OwnerSlug,
+			"name":  repo.RepoSlug,
+			"first": tagsPerPage,
+			"after": nil,
+		}
+		for {
+			req, err := client.NewRequest("POST", graphQLEndpoint, model.GraphQLRequest{
+				Query:     model.GitTagRefsQuery,
+				Variables: variables,
+			})
+			if err != nil {
+				return nil, err
 			}
 
-			return nil, err
+			var page model.GitTagRefsResponse
+			if _, err := client.Do(ctx, req, &page); err != nil {
+				return nil, err
+			}
+
+			if page.Errors.IsNotFound() {
+				return nil, svcerrors.NewGithubRepoNotFoundError().Wrap(page.Errors.Err())
+			}
+			if err := page.Errors.Err(); err != nil {
+				return nil, fmt.Errorf("querying tags: %w", err)
+			}
+			if page.Data.Repository == nil {
+				return nil, svcerrors.NewGithubRepoNotFoundError()
+			}
+
+			refs = append(refs, page.Data.Repository.Refs.Nodes...)
+			if !page.Data.Repository.Refs.PageInfo.HasNextPage {
+				break
+			}
+			variables["after"] = page.Data.Repository.Refs.PageInfo.EndCursor
 		}
 
-		return model.ToSvcGitTags(t, repo)
+		return model.ToSvcRepoGitTags(refs, repo)
 	})
 }
 
 func (c *Client) ReadTag(ctx context.Context, tkn svcmodel.GithubToken, repo svcmodel.GithubRepo, tagName string) (svcmodel.GitTag, error) {
-	return withGithubClientResult[svcmodel.GitTag](tkn, func(client *github.Client) (svcmodel.GitTag, error) {
+	return withGithubClientResult[svcmodel.GitTag](c.httpClient, tkn, func(client *github.Client) (svcmodel.GitTag, error) {
 		// Git tag can be fetched only by its SHA, using GET /repos/{owner}/{repo}/git/tags/{tag_sha}
 		// Another limitation is that only annotated tags can be fetched by /repos/{owner}/{repo}/git/tags/{tag_sha}
 		// Because lightweight tags do not have their own SHA, they only reference a commit
@@ -97,32 +148,134 @@ func (c *Client) ReadTag(ctx context.Context, tkn svcmodel.GithubToken, repo svc
 	})
 }
 
-func (c *Client) UpsertRelease(ctx context.Context, tkn svcmodel.GithubToken, repo svcmodel.GithubRepo, rls svcmodel.Release) error {
-	if err := c.createRelease(ctx, tkn, repo, rls); err != nil {
-		if util.IsReleaseAlreadyExistsError(err) {
-			if err := c.updateRelease(ctx, tkn, repo, rls); err != nil {
-				return fmt.Errorf("updating release: %w", err)
+// CreateTag creates an annotated tag pointing to the target, which is a branch name or a commit SHA.
+// The tag message is the message of the annotated tag, the tagger is the owner of the token.
+func (c *Client) CreateTag(
+	ctx context.Context,
+	tkn svcmodel.GithubToken,
+	repo svcmodel.GithubRepo,
+	tagName string,
+	target string,
+	message string,
+) (svcmodel.GitTag, error) {
+	return withGithubClientResult[svcmodel.GitTag](c.httpClient, tkn, func(client *github.Client) (svcmodel.GitTag, error) {
+		// Creating the reference fails if the tag already exists, but the tag object would be created before for nothing
+		// Docs https://docs.github.com/rest/git/refs#get-a-reference
+		if _, _, err := client.Git.GetRef(ctx, repo.OwnerSlug, repo.RepoSlug, fmt.Sprintf("tags/%s", tagName)); err == nil {
+			return svcmodel.GitTag{}, svcerrors.NewGitTagAlreadyExistsError()
+		} else if !util.IsNotFoundError(err) {
+			return svcmodel.GitTag{}, err
+		}
+
+		// Branch names and commit SHAs are both resolved to the SHA of the commit
+		// GitHub returns 422 for a SHA which does not exist and 404 for an unknown branch
+		// Docs https://docs.github.com/rest/commits/commits#get-a-commit
+		sha, _, err := client.Repositories.GetCommitSHA1(ctx, repo.OwnerSlug, repo.RepoSlug, target, "")
+		if err != nil {
+			if util.IsNotFoundError(err) || util.IsUnprocessableEntityError(err) {
+				return svcmodel.GitTag{}, svcerrors.NewGitTagTargetNotFoundError().Wrap(err)
+			}
+
+			return svcmodel.GitTag{}, err
+		}
+
+		// An annotated tag is a tag object referenced by refs/tags/{tag_name}, both have to be created
+		// Docs https://docs.github.com/rest/git/tags#create-a-tag-object
+		tag, _, err := client.Git.CreateTag(ctx, repo.OwnerSlug, repo.RepoSlug, &github.Tag{
+			Tag:     github.String(tagName),
+			Message: github.String(message),
+			Object: &github.GitObject{
+				Type: github.String("commit"),
+				SHA:  github.String(sha),
+			},
+		})
+		if err != nil {
+			return svcmodel.GitTag{}, err
+		}
+
+		// Docs https://docs.github.com/rest/git/refs#create-a-reference
+		if _, _, err := client.Git.CreateRef(ctx, repo.OwnerSlug, repo.RepoSlug, &github.Reference{
+			Ref:    github.String(fmt.Sprintf("refs/tags/%s", tagName)),
+			Object: &github.GitObject{SHA: tag.SHA},
+		}); err != nil {
+			// The tag could have been created by someone else since it was checked
+			if util.IsUnprocessableEntityError(err) {
+				return svcmodel.GitTag{}, svcerrors.NewGitTagAlreadyExistsError().Wrap(err)
+			}
+
+			return svcmodel.GitTag{}, err
+		}
+
+		return model.ToSvcGitTag(tagName, repo)
+	})
+}
+
+// DeleteTag deletes the tag reference, it is used to undo CreateTag.
+func (c *Client) DeleteTag(ctx context.Context, tkn svcmodel.GithubToken, repo svcmodel.GithubRepo, tagName string) error {
+	return withGithubClient(c.httpClient, tkn, func(client *github.Client) error {
+		// Docs https://docs.github.com/rest/git/refs#delete-a-reference
+		if _, err := client.Git.DeleteRef(ctx, repo.OwnerSlug, repo.RepoSlug, fmt.Sprintf("tags/%s", tagName)); err != nil {
+			if util.IsNotFoundError(err) {
+				return svcerrors.NewGitTagNotFoundError().Wrap(err)
+			}
+
+			return err
+		}
+
+		return nil
+	})
+}
+
+// ListReleases returns all releases of the repository including drafts, newest first.
+func (c *Client) ListReleases(ctx context.Context, tkn svcmodel.GithubToken, repo svcmodel.GithubRepo) ([]svcmodel.GithubRelease, error) {
+	return withGithubClientResult[[]svcmodel.GithubRelease](c.httpClient, tkn, func(client *github.Client) ([]svcmodel.GithubRelease, error) {
+		// Up to 100 releases can be fetched per page, pages are fetched until there is no next page
+		// Draft releases are listed only to users with push access to the repository
+		// Docs: https://docs.github.com/en/rest/releases/releases?apiVersion=2022-11-28#list-releases
+		var releases []*github.RepositoryRelease
+		opts := &github.ListOptions{PerPage: releasesPerPage}
+		for {
+			page, resp, err := client.Repositories.ListReleases(ctx, repo.OwnerSlug, repo.RepoSlug, opts)
+			if err != nil {
+				if util.IsNotFoundError(err) {
+					return nil, svcerrors.NewGithubRepoNotFoundError().Wrap(err)
+				}
+
+				return nil, err
 			}
 
-			return nil
+			releases = append(releases, page...)
+			if resp.NextPage == 0 {
+				break
+			}
+			opts.Page = resp.NextPage
 		}
 
-		return fmt.Errorf("creating release: %w", err)
+		return model.ToSvcGithubReleases(releases, repo)
+	})
+}
+
+// UpsertRelease updates the existing release first, because a draft release does not conflict
+// with a new release of the same tag and creating it again would make a duplicate.
+func (c *Client) UpsertRelease(ctx context.Context, tkn svcmodel.GithubToken, repo svcmodel.GithubRepo, rls svcmodel.Release) error {
+	if err := c.updateRelease(ctx, tkn, repo, rls); err != nil {
+		if !util.IsNotFoundError(err) {
+			return fmt.Errorf("updating release: %w", err)
+		}
+
+		if err := c.createRelease(ctx, tkn, repo, rls); err != nil {
+			return fmt.Errorf("creating release: %w", err)
+		}
 	}
 
 	return nil
 }
 
 func (c *Client) DeleteReleaseByTag(ctx context.Context, tkn svcmodel.GithubToken, repo svcmodel.GithubRepo, tag svcmodel.GitTag) error {
-	return withGithubClient(tkn, func(client *github.Client) error {
+	return withGithubClient(c.httpClient, tkn, func(client *github.Client) error {
 		// Release can be deleted only by release ID
 		// Therefore I need to get release object first
-		rls, _, err := client.Repositories.GetReleaseByTag(
-			ctx,
-			repo.OwnerSlug,
-			repo.RepoSlug,
-			tag.Name,
-		)
+		rls, err := getReleaseByTag(ctx, client, repo, tag.Name)
 		if err != nil {
 			if util.IsNotFoundError(err) {
 				return svcerrors.NewGithubReleaseNotFoundError().Wrap(err)
@@ -148,9 +301,9 @@ func (c *Client) GenerateReleaseNotes(
 	ctx context.Context,
 	tkn svcmodel.GithubToken,
 	repo svcmodel.GithubRepo,
-	input svcmodel.GithubReleaseNotesInput,
-) (svcmodel.GithubReleaseNotes, error) {
-	return withGithubClientResult[svcmodel.GithubReleaseNotes](tkn, func(client *github.Client) (svcmodel.GithubReleaseNotes, error) {
+	input svcmodel.GitReleaseNotesInput,
+) (svcmodel.GitReleaseNotes, error) {
+	return withGithubClientResult[svcmodel.GitReleaseNotes](c.httpClient, tkn, func(client *github.Client) (svcmodel.GitReleaseNotes, error) {
 		// Generates release notes based on git tag and previous git tag
 		// Git tag must be present, and it can be either existing tag or new tag that will be created
 		// Previous git tag name is optional field
@@ -167,17 +320,97 @@ func (c *Client) GenerateReleaseNotes(
 		)
 		if err != nil {
 			if util.IsInvalidPreviousTagError(err) {
-				return svcmodel.GithubReleaseNotes{},
+				return svcmodel.GitReleaseNotes{},
 					svcerrors.NewGithubNotesInvalidInputError().Wrap(err).WithMessage("Invalid previous git tag")
 			}
 
-			return svcmodel.GithubReleaseNotes{}, fmt.Errorf("generating release notes: %w", err)
+			return svcmodel.GitReleaseNotes{}, fmt.Errorf("generating release notes: %w", err)
 		}
 
 		return model.ToSvcGithubReleaseNotes(notes), nil
 	})
 }
 
+func (c *Client) CompareTags(
+	ctx context.Context,
+	tkn svcmodel.GithubToken,
+	repo svcmodel.GithubRepo,
+	baseTagName string,
+	headTagName string,
+) (svcmodel.GitTagComparison, error) {
+	return withGithubClientResult[svcmodel.GitTagComparison](c.httpClient, tkn, func(client *github.Client) (svcmodel.GitTagComparison, error) {
+		// Compares two commits, refs (e.g. tags) can be used instead of commit SHAs
+		// Only the first page of commits is fetched, GitHub returns up to 250 commits per page
+		// Files are returned only with the first page as well (up to 300 files)
+		//
+		// Docs: https://docs.github.com/en/rest/commits/commits?apiVersion=2022-11-28#compare-two-commits
+		cmp, _, err := client.Repositories.CompareCommits(
+			ctx,
+			repo.OwnerSlug,
+			repo.RepoSlug,
+			baseTagName,
+			headTagName,
+			&github.ListOptions{PerPage: commitsToCompare},
+		)
+		if err != nil {
+			if util.IsNotFoundError(err) {
+				return svcmodel.GitTagComparison{}, svcerrors.NewGitTagNotFoundError().Wrap(err)
+			}
+
+			return svcmodel.GitTagComparison{}, fmt.Errorf("comparing tags: %w", err)
+		}
+
+		prs, err := listMergedPullRequestsForCommits(ctx, client, repo, cmp.Commits)
+		if err != nil {
+			return svcmodel.GitTagComparison{}, fmt.Errorf("listing pull requests: %w", err)
+		}
+
+		return model.ToSvcGitTagComparison(cmp, prs)
+	})
+}
+
+// ListReleaseLinkedItems lists pull requests merged and issues closed between the previous tag and the tag.
+// Pull requests are associated with the commits of the comparison, so only the first 250 commits are taken into account.
+// Issues are searched by the date they were closed, between the dates of the commits the tags point to.
+func (c *Client) ListReleaseLinkedItems(
+	ctx context.Context,
+	tkn svcmodel.GithubToken,
+	repo svcmodel.GithubRepo,
+	previousTagName string,
+	tagName string,
+) (svcmodel.ReleaseLinkedItems, error) {
+	return withGithubClientResult(c.httpClient, tkn, func(client *github.Client) (svcmodel.ReleaseLinkedItems, error) {
+		// Docs: https://docs.github.com/en/rest/commits/commits?apiVersion=2022-11-28#compare-two-commits
+		cmp, _, err := client.Repositories.CompareCommits(
+			ctx,
+			repo.OwnerSlug,
+			repo.RepoSlug,
+			previousTagName,
+			tagName,
+			&github.ListOptions{PerPage: commitsToCompare},
+		)
+		if err != nil {
+			if util.IsNotFoundError(err) {
+				return svcmodel.ReleaseLinkedItems{}, svcerrors.NewGitTagNotFoundError().Wrap(err)
+			}
+
+			return svcmodel.ReleaseLinkedItems{}, fmt.Errorf("comparing tags: %w", err)
+		}
+
+		prs, err := listMergedPullRequestsForCommits(ctx, client, repo, cmp.Commits)
+		if err != nil {
+			return svcmodel.ReleaseLinkedItems{}, fmt.Errorf("listing pull requests: %w", err)
+		}
+
+		issues, err := searchIssuesClosedInComparison(ctx, client, repo, cmp, tagName)
+		if err != nil {
+			return svcmodel.ReleaseLinkedItems{}, fmt.Errorf("searching closed issues: %w", err)
+		}
+
+		return model.ToSvcReleaseLinkedItems(previousTagName, prs, issues)
+	})
+}
+
 func (c *Client) ParseTagDeletionWebhook(
 	ctx context.Context,
 	webhook svcmodel.GithubTagDeletionWebhookInput,
@@ -197,6 +430,10 @@ func (c *Client) ParseTagDeletionWebhook(
 		return svcmodel.GithubTagDeletionWebhookOutput{}, svcerrors.NewInvalidGithubTagDeletionWebhookError().Wrap(err)
 	}
 
+	if input.RefType != model.RefTypeTag {
+		return svcmodel.GithubTagDeletionWebhookOutput{}, svcerrors.NewGithubWebhookDeliveryIgnoredError().WithMessage(fmt.Sprintf("%s events are not processed", input.RefType))
+	}
+
 	repo, err := c.ReadRepo(ctx, tkn, input.Repo.Slugs)
 	if err != nil {
 		return svcmodel.GithubTagDeletionWebhookOutput{}, fmt.Errorf("reading repo: %w", err)
@@ -207,6 +444,196 @@ func (c *Client) ParseTagDeletionWebhook(
 	return model.ToSvcGithubTagDeletionWebhookOutput(repo, input.Tag), nil
 }
 
+func (c *Client) ParseTagCreationWebhook(
+	ctx context.Context,
+	webhook svcmodel.GithubTagCreationWebhookInput,
+	tkn svcmodel.GithubToken,
+	secret svcmodel.GithubWebhookSecret,
+) (svcmodel.GithubTagCreationWebhookOutput, error) {
+	if !util.IsValidWebhookPayload(webhook.RawPayload, webhook.Signature, secret) {
+		return svcmodel.GithubTagCreationWebhookOutput{}, svcerrors.NewInvalidGithubTagCreationWebhookError().WithMessage("invalid webhook payload")
+	}
+
+	var input model.TagCreationWebhookInput
+	if err := json.Unmarshal(webhook.RawPayload, &input); err != nil {
+		return svcmodel.GithubTagCreationWebhookOutput{}, svcerrors.NewInvalidGithubTagCreationWebhookError().Wrap(err)
+	}
+
+	if err := validatorx.ValidateStruct(input); err != nil {
+		return svcmodel.GithubTagCreationWebhookOutput{}, svcerrors.NewInvalidGithubTagCreationWebhookError().Wrap(err)
+	}
+
+	if input.RefType != model.RefTypeTag {
+		return svcmodel.GithubTagCreationWebhookOutput{}, svcerrors.NewGithubWebhookDeliveryIgnoredError().WithMessage(fmt.Sprintf("%s events are not processed", input.RefType))
+	}
+
+	repo, err := c.ReadRepo(ctx, tkn, input.Repo.Slugs)
+	if err != nil {
+		return svcmodel.GithubTagCreationWebhookOutput{}, fmt.Errorf("reading repo: %w", err)
+	}
+
+	tag, err := model.ToSvcGitTag(input.Tag, repo)
+	if err != nil {
+		return svcmodel.GithubTagCreationWebhookOutput{}, fmt.Errorf("converting git tag: %w", err)
+	}
+
+	return model.ToSvcGithubTagCreationWebhookOutput(repo, tag), nil
+}
+
+// CreateRepoWebhook registers the webhook of the service on the repo, the deliveries are signed with the secret.
+// Docs: https://docs.github.com/en/rest/repos/webhooks#create-a-repository-webhook
+func (c *Client) CreateRepoWebhook(
+	ctx context.Context,
+	tkn svcmodel.GithubToken,
+	repo svcmodel.GithubRepo,
+	secret svcmodel.GithubWebhookSecret,
+) (int64, error) {
+	return withGithubClientResult(c.httpClient, tkn, func(client *github.Client) (int64, error) {
+		hook, _, err := client.Repositories.CreateHook(ctx, repo.OwnerSlug, repo.RepoSlug, &github.Hook{
+			Events: model.WebhookEvents,
+			Active: github.Bool(true),
+			Config: c.webhookConfig(secret),
+		})
+		if err != nil {
+			if util.IsNotFoundError(err) {
+				return 0, svcerrors.NewGithubRepoNotFoundError().Wrap(err)
+			}
+
+			return 0, err
+		}
+
+		return hook.GetID(), nil
+	})
+}
+
+// UpdateRepoWebhookSecret replaces the secret of the webhook, the config of the webhook is replaced as a whole.
+// Docs: https://docs.github.com/en/rest/repos/webhooks#update-a-repository-webhook
+func (c *Client) UpdateRepoWebhookSecret(
+	ctx context.Context,
+	tkn svcmodel.GithubToken,
+	repo svcmodel.GithubRepo,
+	hookID int64,
+	secret svcmodel.GithubWebhookSecret,
+) error {
+	return withGithubClient(c.httpClient, tkn, func(client *github.Client) error {
+		if _, _, err := client.Repositories.EditHook(ctx, repo.OwnerSlug, repo.RepoSlug, hookID, &github.Hook{
+			Events: model.WebhookEvents,
+			Active: github.Bool(true),
+			Config: c.webhookConfig(secret),
+		}); err != nil {
+			if util.IsNotFoundError(err) {
+				return svcerrors.NewGithubWebhookNotFoundError().Wrap(err)
+			}
+
+			return err
+		}
+
+		return nil
+	})
+}
+
+// DeleteRepoWebhook removes the webhook from the repo, a webhook which was already removed on GitHub is ignored.
+// Docs: https://docs.github.com/en/rest/repos/webhooks#delete-a-repository-webhook
+func (c *Client) DeleteRepoWebhook(ctx context.Context, tkn svcmodel.GithubToken, repo svcmodel.GithubRepo, hookID int64) error {
+	return withGithubClient(c.httpClient, tkn, func(client *github.Client) error {
+		if _, err := client.Repositories.DeleteHook(ctx, repo.OwnerSlug, repo.RepoSlug, hookID); err != nil && !util.IsNotFoundError(err) {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// CreateDeployment mirrors the deployment to the repo, so it is shown in the environments of the repo and on pull requests.
+// Docs: https://docs.github.com/en/rest/deployments/deployments?apiVersion=2022-11-28#create-a-deployment
+func (c *Client) CreateDeployment(ctx context.Context, tkn svcmodel.GithubToken, repo svcmodel.GithubRepo, dpl svcmodel.Deployment) (int64, error) {
+	return withGithubClientResult(c.httpClient, tkn, func(client *github.Client) (int64, error) {
+		// Ref is the git tag of the deployed release
+		// AutoMerge is disabled, the tag must not be merged with the default branch
+		// RequiredContexts is empty, the release was already approved, so commit statuses are not checked
+		d, _, err := client.Repositories.CreateDeployment(ctx, repo.OwnerSlug, repo.RepoSlug, &github.DeploymentRequest{
+			Ref:              &dpl.Release.Tag.Name,
+			Environment:      &dpl.Environment.Name,
+			Description:      &dpl.Release.ReleaseTitle,
+			AutoMerge:        github.Bool(false),
+			RequiredContexts: &[]string{},
+		})
+		if err != nil {
+			if util.IsNotFoundError(err) {
+				return 0, svcerrors.NewGithubRepoNotFoundError().Wrap(err)
+			}
+
+			return 0, err
+		}
+
+		return d.GetID(), nil
+	})
+}
+
+// CreateDeploymentStatus reports the status of the deployment to the mirrored GitHub deployment.
+// Docs: https://docs.github.com/en/rest/deployments/statuses?apiVersion=2022-11-28#create-a-deployment-status
+func (c *Client) CreateDeploymentStatus(
+	ctx context.Context,
+	tkn svcmodel.GithubToken,
+	repo svcmodel.GithubRepo,
+	githubDeploymentID int64,
+	dpl svcmodel.Deployment,
+) error {
+	return withGithubClient(c.httpClient, tkn, func(client *github.Client) error {
+		// EnvironmentURL links the environment view of the repo to the service
+		if _, _, err := client.Repositories.CreateDeploymentStatus(ctx, repo.OwnerSlug, repo.RepoSlug, githubDeploymentID, &github.DeploymentStatusRequest{
+			State:          github.String(string(dpl.GithubDeploymentState())),
+			Environment:    &dpl.Environment.Name,
+			EnvironmentURL: github.String(dpl.Environment.ServiceURL.String()),
+		}); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// ReadWebhookRepo reads the repo the delivery was sent from without calling GitHub,
+// false is returned if the payload does not contain a repository.
+func (c *Client) ReadWebhookRepo(rawPayload []byte) (svcmodel.GithubRepo, bool) {
+	var input model.WebhookDeliveryInput
+	if err := json.Unmarshal(rawPayload, &input); err != nil {
+		return svcmodel.GithubRepo{}, false
+	}
+
+	// Full name of the repo has the same path as the repo URL (e.g. "owner/repo")
+	ownerSlug, repoSlug, err := util.ParseGithubRepoURL(input.Repo.Slugs)
+	if err != nil {
+		return svcmodel.GithubRepo{}, false
+	}
+
+	repoURL, err := util.GenerateRepoURL(ownerSlug, repoSlug)
+	if err != nil {
+		return svcmodel.GithubRepo{}, false
+	}
+
+	return svcmodel.GithubRepo{
+		URL:       repoURL,
+		OwnerSlug: ownerSlug,
+		RepoSlug:  repoSlug,
+	}, true
+}
+
+// ReadWebhookDeliveryInfo reads the delivery info before the delivery is processed, so it never fails.
+// The repo is left empty if the payload is not a valid JSON.
+func (c *Client) ReadWebhookDeliveryInfo(
+	webhook svcmodel.GithubWebhookDeliveryInput,
+	secret svcmodel.GithubWebhookSecret,
+) svcmodel.GithubWebhookDeliveryInfo {
+	var input model.WebhookDeliveryInput
+	_ = json.Unmarshal(webhook.RawPayload, &input)
+
+	return svcmodel.GithubWebhookDeliveryInfo{
+		RepoFullName:   input.Repo.Slugs,
+		SignatureValid: util.IsValidWebhookPayload(webhook.RawPayload, webhook.Signature, secret),
+	}
+}
+
 func (c *Client) GenerateRepoURL(ownerSlug, repoSlug string) (url.URL, error) {
 	return util.GenerateRepoURL(ownerSlug, repoSlug)
 }
@@ -216,17 +643,21 @@ func (c *Client) GenerateGitTagURL(ownerSlug, repoSlug, tagName string) (url.URL
 }
 
 func (c *Client) createRelease(ctx context.Context, tkn svcmodel.GithubToken, repo svcmodel.GithubRepo, rls svcmodel.Release) error {
-	return withGithubClient(tkn, func(client *github.Client) error {
+	return withGithubClient(c.httpClient, tkn, func(client *github.Client) error {
 		// Creates a new release
 		// Docs: https://docs.github.com/en/rest/releases/releases?apiVersion=2022-11-28#create-a-release
 		//
 		// TagName is the name of the tag to link the release to
 		// Name is the name of the release
 		// Body is the description of the release
+		// Draft, Prerelease and MakeLatest are the flags of the release
 		if _, _, err := client.Repositories.CreateRelease(ctx, repo.OwnerSlug, repo.RepoSlug, &github.RepositoryRelease{
-			TagName: &rls.Tag.Name,
-			Name:    &rls.ReleaseTitle,
-			Body:    &rls.ReleaseNotes,
+			TagName:    &rls.Tag.Name,
+			Name:       &rls.ReleaseTitle,
+			Body:       &rls.ReleaseNotes,
+			Draft:      &rls.GithubOptions.Draft,
+			Prerelease: &rls.GithubOptions.Prerelease,
+			MakeLatest: model.ToGithubMakeLatest(rls.GithubOptions.MakeLatest),
 		}); err != nil {
 			return err
 		}
@@ -236,15 +667,10 @@ func (c *Client) createRelease(ctx context.Context, tkn svcmodel.GithubToken, re
 }
 
 func (c *Client) updateRelease(ctx context.Context, tkn svcmodel.GithubToken, repo svcmodel.GithubRepo, rls svcmodel.Release) error {
-	return withGithubClient(tkn, func(client *github.Client) error {
+	return withGithubClient(c.httpClient, tkn, func(client *github.Client) error {
 		// Release can be updated only by release ID
 		// Therefore I need to get release ID first
-		githubRls, _, err := client.Repositories.GetReleaseByTag(
-			ctx,
-			repo.OwnerSlug,
-			repo.RepoSlug,
-			rls.Tag.Name,
-		)
+		githubRls, err := getReleaseByTag(ctx, client, repo, rls.Tag.Name)
 		if err != nil {
 			return fmt.Errorf("getting release by tag: %w", err)
 		}
@@ -254,14 +680,18 @@ func (c *Client) updateRelease(ctx context.Context, tkn svcmodel.GithubToken, re
 		//
 		// Name is the name of the release
 		// Body is the description of the release
+		// Draft, Prerelease and MakeLatest are the flags of the release, a draft is published when Draft is false
 		if _, _, err = client.Repositories.EditRelease(
 			ctx,
 			repo.OwnerSlug,
 			repo.RepoSlug,
 			githubRls.GetID(),
 			&github.RepositoryRelease{
-				Name: &rls.ReleaseTitle,
-				Body: &rls.ReleaseNotes,
+				Name:       &rls.ReleaseTitle,
+				Body:       &rls.ReleaseNotes,
+				Draft:      &rls.GithubOptions.Draft,
+				Prerelease: &rls.GithubOptions.Prerelease,
+				MakeLatest: model.ToGithubMakeLatest(rls.GithubOptions.MakeLatest),
 			},
 		); err != nil {
 			return fmt.Errorf("updating release: %w", err)
@@ -271,8 +701,163 @@ func (c *Client) updateRelease(ctx context.Context, tkn svcmodel.GithubToken, re
 	})
 }
 
-func withGithubClientResult[T any](tkn svcmodel.GithubToken, fn func(client *github.Client) (T, error)) (T, error) {
-	client := github.NewClient(nil).WithAuthToken(tkn.String())
+// getReleaseByTag returns the release of the tag including a draft release.
+// GitHub does not return drafts by the tag, so they are searched in the list of releases,
+// the not found error of GitHub is returned if there is no release of the tag.
+func getReleaseByTag(
+	ctx context.Context,
+	client *github.Client,
+	repo svcmodel.GithubRepo,
+	tagName string,
+) (*github.RepositoryRelease, error) {
+	// Docs: https://docs.github.com/en/rest/releases/releases?apiVersion=2022-11-28#get-a-release-by-tag-name
+	rls, _, err := client.Repositories.GetReleaseByTag(ctx, repo.OwnerSlug, repo.RepoSlug, tagName)
+	if err == nil || !util.IsNotFoundError(err) {
+		return rls, err
+	}
+
+	// Draft releases are listed only to users with push access to the repository
+	// Docs: https://docs.github.com/en/rest/releases/releases?apiVersion=2022-11-28#list-releases
+	opts := &github.ListOptions{PerPage: releasesPerPage}
+	for {
+		releases, resp, listErr := client.Repositories.ListReleases(ctx, repo.OwnerSlug, repo.RepoSlug, opts)
+		if listErr != nil {
+			return nil, fmt.Errorf("listing releases: %w", listErr)
+		}
+
+		for _, r := range releases {
+			if r.GetDraft() && r.GetTagName() == tagName {
+				return r, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return nil, err
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// listMergedPullRequestsForCommits returns unique merged pull requests associated with the commits.
+// Merge commit, squashed commit or any commit of the pull request is associated with the pull request.
+func listMergedPullRequestsForCommits(
+	ctx context.Context,
+	client *github.Client,
+	repo svcmodel.GithubRepo,
+	commits []*github.RepositoryCommit,
+) ([]*github.PullRequest, error) {
+	prs := make([]*github.PullRequest, 0)
+	seen := make(map[int]bool)
+
+	for _, commit := range commits {
+		// Docs: https://docs.github.com/en/rest/commits/commits?apiVersion=2022-11-28#list-pull-requests-associated-with-a-commit
+		commitPRs, _, err := client.PullRequests.ListPullRequestsWithCommit(
+			ctx,
+			repo.OwnerSlug,
+			repo.RepoSlug,
+			commit.GetSHA(),
+			&github.ListOptions{PerPage: pullRequestsPerCommit},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pr := range commitPRs {
+			if pr.MergedAt == nil || seen[pr.GetNumber()] {
+				continue
+			}
+
+			seen[pr.GetNumber()] = true
+			prs = append(prs, pr)
+		}
+	}
+
+	return prs, nil
+}
+
+// searchIssuesClosedInComparison returns issues closed as completed between the dates of the base and the head commit.
+// Issues closed as not planned were not resolved by the release, so they are skipped.
+func searchIssuesClosedInComparison(
+	ctx context.Context,
+	client *github.Client,
+	repo svcmodel.GithubRepo,
+	cmp *github.CommitsComparison,
+	tagName string,
+) ([]*github.Issue, error) {
+	if len(cmp.Commits) == 0 {
+		return []*github.Issue{}, nil
+	}
+
+	from := cmp.GetBaseCommit().GetCommit().GetCommitter().GetDate().Time
+	to, err := readHeadCommitDate(ctx, client, repo, cmp, tagName)
+	if err != nil {
+		return nil, fmt.Errorf("reading head commit date: %w", err)
+	}
+
+	q := fmt.Sprintf(
+		"repo:%s/%s is:issue is:closed reason:completed closed:%s..%s",
+		repo.OwnerSlug,
+		repo.RepoSlug,
+		from.UTC().Format(time.RFC3339),
+		to.UTC().Format(time.RFC3339),
+	)
+
+	// Search returns up to 1000 results
+	// Docs: https://docs.github.com/en/rest/search/search?apiVersion=2022-11-28#search-issues-and-pull-requests
+	issues := make([]*github.Issue, 0)
+	opts := &github.SearchOptions{ListOptions: github.ListOptions{PerPage: issuesPerPage}}
+	for {
+		result, resp, err := client.Search.Issues(ctx, q, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		issues = append(issues, result.Issues...)
+
+		if resp.NextPage == 0 {
+			return issues, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// readHeadCommitDate returns the date of the commit the tag points to,
+// the commit is read only if it is not the last commit of the comparison because the comparison was truncated.
+func readHeadCommitDate(
+	ctx context.Context,
+	client *github.Client,
+	repo svcmodel.GithubRepo,
+	cmp *github.CommitsComparison,
+	tagName string,
+) (time.Time, error) {
+	if len(cmp.Commits) == cmp.GetTotalCommits() {
+		return cmp.Commits[len(cmp.Commits)-1].GetCommit().GetCommitter().GetDate().Time, nil
+	}
+
+	// Docs: https://docs.github.com/en/rest/commits/commits?apiVersion=2022-11-28#get-a-commit
+	commit, _, err := client.Repositories.GetCommit(ctx, repo.OwnerSlug, repo.RepoSlug, tagName, &github.ListOptions{PerPage: 1})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return commit.GetCommit().GetCommitter().GetDate().Time, nil
+}
+
+func (c *Client) webhookConfig(secret svcmodel.GithubWebhookSecret) *github.HookConfig {
+	return &github.HookConfig{
+		URL:         github.String(c.cfg.WebhookURL),
+		ContentType: github.String(webhookContentType),
+		Secret:      github.String(string(secret)),
+		InsecureSSL: github.String("0"),
+	}
+}
+
+func withGithubClientResult[T any](
+	httpClient *http.Client,
+	tkn svcmodel.GithubToken,
+	fn func(client *github.Client) (T, error),
+) (T, error) {
+	client := github.NewClient(httpClient).WithAuthToken(tkn.String())
 	var zeroValue T
 	result, err := fn(client)
 	if err != nil {
@@ -281,8 +866,8 @@ func withGithubClientResult[T any](tkn svcmodel.GithubToken, fn func(client *git
 	return result, nil
 }
 
-func withGithubClient(tkn svcmodel.GithubToken, fn func(client *github.Client) error) error {
-	client := github.NewClient(nil).WithAuthToken(tkn.String())
+func withGithubClient(httpClient *http.Client, tkn svcmodel.GithubToken, fn func(client *github.Client) error) error {
+	client := github.NewClient(httpClient).WithAuthToken(tkn.String())
 	if err := fn(client); err != nil {
 		return util.TranslateGithubAuthError(err)
 	}
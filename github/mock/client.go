@@ -13,9 +13,9 @@ type Client struct {
 	mock.Mock
 }
 
-func (c *Client) ReadTagsForRepo(ctx context.Context, tkn svcmodel.GithubToken, repo svcmodel.GithubRepo) ([]svcmodel.GitTag, error) {
+func (c *Client) ReadTagsForRepo(ctx context.Context, tkn svcmodel.GithubToken, repo svcmodel.GithubRepo) ([]svcmodel.RepoGitTag, error) {
 	args := c.Called(ctx, tkn, repo)
-	return args.Get(0).([]svcmodel.GitTag), args.Error(1)
+	return args.Get(0).([]svcmodel.RepoGitTag), args.Error(1)
 }
 
 func (c *Client) ReadRepo(ctx context.Context, tkn svcmodel.GithubToken, rawRepoURL string) (svcmodel.GithubRepo, error) {
@@ -38,22 +38,92 @@ func (c *Client) ReadTag(ctx context.Context, tkn svcmodel.GithubToken, repo svc
 	return args.Get(0).(svcmodel.GitTag), args.Error(1)
 }
 
+func (c *Client) CreateTag(ctx context.Context, tkn svcmodel.GithubToken, repo svcmodel.GithubRepo, tagName, target, message string) (svcmodel.GitTag, error) {
+	args := c.Called(ctx, tkn, repo, tagName, target, message)
+	return args.Get(0).(svcmodel.GitTag), args.Error(1)
+}
+
+func (c *Client) DeleteTag(ctx context.Context, tkn svcmodel.GithubToken, repo svcmodel.GithubRepo, tagName string) error {
+	args := c.Called(ctx, tkn, repo, tagName)
+	return args.Error(0)
+}
+
 func (c *Client) UpsertRelease(ctx context.Context, tkn svcmodel.GithubToken, repo svcmodel.GithubRepo, rls svcmodel.Release) error {
 	args := c.Called(ctx, tkn, repo, rls)
 	return args.Error(0)
 }
 
+func (c *Client) ListReleases(ctx context.Context, tkn svcmodel.GithubToken, repo svcmodel.GithubRepo) ([]svcmodel.GithubRelease, error) {
+	args := c.Called(ctx, tkn, repo)
+	return args.Get(0).([]svcmodel.GithubRelease), args.Error(1)
+}
+
 func (c *Client) GenerateRepoURL(ownerSlug, repoSlug string) (url.URL, error) {
 	args := c.Called(ownerSlug, repoSlug)
 	return args.Get(0).(url.URL), args.Error(1)
 }
 
-func (c *Client) GenerateReleaseNotes(ctx context.Context, tkn svcmodel.GithubToken, repo svcmodel.GithubRepo, input svcmodel.GithubReleaseNotesInput) (svcmodel.GithubReleaseNotes, error) {
+func (c *Client) GenerateReleaseNotes(ctx context.Context, tkn svcmodel.GithubToken, repo svcmodel.GithubRepo, input svcmodel.GitReleaseNotesInput) (svcmodel.GitReleaseNotes, error) {
 	args := c.Called(ctx, tkn, repo, input)
-	return args.Get(0).(svcmodel.GithubReleaseNotes), args.Error(1)
+	return args.Get(0).(svcmodel.GitReleaseNotes), args.Error(1)
+}
+
+func (c *Client) CompareTags(ctx context.Context, tkn svcmodel.GithubToken, repo svcmodel.GithubRepo, baseTagName, headTagName string) (svcmodel.GitTagComparison, error) {
+	args := c.Called(ctx, tkn, repo, baseTagName, headTagName)
+	return args.Get(0).(svcmodel.GitTagComparison), args.Error(1)
 }
 
 func (c *Client) ParseTagDeletionWebhook(ctx context.Context, webhook svcmodel.GithubTagDeletionWebhookInput, tkn svcmodel.GithubToken, secret svcmodel.GithubWebhookSecret) (svcmodel.GithubTagDeletionWebhookOutput, error) {
 	args := c.Called(ctx, webhook, tkn, secret)
 	return args.Get(0).(svcmodel.GithubTagDeletionWebhookOutput), args.Error(1)
 }
+
+func (c *Client) ParseTagCreationWebhook(ctx context.Context, webhook svcmodel.GithubTagCreationWebhookInput, tkn svcmodel.GithubToken, secret svcmodel.GithubWebhookSecret) (svcmodel.GithubTagCreationWebhookOutput, error) {
+	args := c.Called(ctx, webhook, tkn, secret)
+	return args.Get(0).(svcmodel.GithubTagCreationWebhookOutput), args.Error(1)
+}
+
+func (c *Client) ReadWebhookDeliveryInfo(webhook svcmodel.GithubWebhookDeliveryInput, secret svcmodel.GithubWebhookSecret) svcmodel.GithubWebhookDeliveryInfo {
+	args := c.Called(webhook, secret)
+	return args.Get(0).(svcmodel.GithubWebhookDeliveryInfo)
+}
+
+func (c *Client) ReadWebhookRepo(rawPayload []byte) (svcmodel.GithubRepo, bool) {
+	args := c.Called(rawPayload)
+	return args.Get(0).(svcmodel.GithubRepo), args.Bool(1)
+}
+
+func (c *Client) CreateRepoWebhook(ctx context.Context, tkn svcmodel.GithubToken, repo svcmodel.GithubRepo, secret svcmodel.GithubWebhookSecret) (int64, error) {
+	args := c.Called(ctx, tkn, repo, secret)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (c *Client) UpdateRepoWebhookSecret(ctx context.Context, tkn svcmodel.GithubToken, repo svcmodel.GithubRepo, hookID int64, secret svcmodel.GithubWebhookSecret) error {
+	args := c.Called(ctx, tkn, repo, hookID, secret)
+	return args.Error(0)
+}
+
+func (c *Client) DeleteRepoWebhook(ctx context.Context, tkn svcmodel.GithubToken, repo svcmodel.GithubRepo, hookID int64) error {
+	args := c.Called(ctx, tkn, repo, hookID)
+	return args.Error(0)
+}
+
+func (c *Client) CreateInstallationToken(ctx context.Context, app svcmodel.GithubAppSettings) (svcmodel.GithubToken, error) {
+	args := c.Called(ctx, app)
+	return args.Get(0).(svcmodel.GithubToken), args.Error(1)
+}
+
+func (c *Client) CreateDeployment(ctx context.Context, tkn svcmodel.GithubToken, repo svcmodel.GithubRepo, dpl svcmodel.Deployment) (int64, error) {
+	args := c.Called(ctx, tkn, repo, dpl)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (c *Client) CreateDeploymentStatus(ctx context.Context, tkn svcmodel.GithubToken, repo svcmodel.GithubRepo, githubDeploymentID int64, dpl svcmodel.Deployment) error {
+	args := c.Called(ctx, tkn, repo, githubDeploymentID, dpl)
+	return args.Error(0)
+}
+
+func (c *Client) ListReleaseLinkedItems(ctx context.Context, tkn svcmodel.GithubToken, repo svcmodel.GithubRepo, previousTagName, tagName string) (svcmodel.ReleaseLinkedItems, error) {
+	args := c.Called(ctx, tkn, repo, previousTagName, tagName)
+	return args.Get(0).(svcmodel.ReleaseLinkedItems), args.Error(1)
+}
@@ -0,0 +1,137 @@
+package model
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	svcmodel "release-manager/service/model"
+)
+
+const (
+	// GraphQLErrTypeNotFound is returned when the repository does not exist or is not accessible with the token
+	GraphQLErrTypeNotFound = "NOT_FOUND"
+
+	// GitTagRefsQuery lists tags of the repo with the date of the tagged commit.
+	// Annotated tags point to a tag object, the commit is the target of the tag object.
+	// Docs: https://docs.github.com/en/graphql/reference/objects#repository
+	GitTagRefsQuery = `query($owner: String!, $name: String!, $first: Int!, $after: String) {
+  repository(owner: $owner, name: $name) {
+    refs(refPrefix: "refs/tags/", first: $first, after: $after) {
+      pageInfo {
+        hasNextPage
+        endCursor
+      }
+      nodes {
+        name
+        target {
+          ... on Commit {
+            committedDate
+          }
+          ... on Tag {
+            target {
+              ... on Commit {
+                committedDate
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+)
+
+type GraphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables"`
+}
+
+type GraphQLError struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+type GraphQLErrors []GraphQLError
+
+// Err returns nil if there are no errors, messages of all errors are joined otherwise.
+func (e GraphQLErrors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Message)
+	}
+
+	return errors.New(strings.Join(messages, "; "))
+}
+
+func (e GraphQLErrors) IsNotFound() bool {
+	for _, err := range e {
+		if err.Type == GraphQLErrTypeNotFound {
+			return true
+		}
+	}
+
+	return false
+}
+
+type GitTagRefsResponse struct {
+	Data struct {
+		Repository *struct {
+			Refs GitTagRefs `json:"refs"`
+		} `json:"repository"`
+	} `json:"data"`
+	Errors GraphQLErrors `json:"errors"`
+}
+
+type GitTagRefs struct {
+	PageInfo struct {
+		HasNextPage bool   `json:"hasNextPage"`
+		EndCursor   string `json:"endCursor"`
+	} `json:"pageInfo"`
+	Nodes []GitTagRef `json:"nodes"`
+}
+
+type GitTagRef struct {
+	Name   string          `json:"name"`
+	Target GitTagRefTarget `json:"target"`
+}
+
+// GitTagRefTarget is a commit for lightweight tags or a tag object for annotated tags.
+type GitTagRefTarget struct {
+	CommittedDate *time.Time `json:"committedDate"`
+	Target        *struct {
+		CommittedDate *time.Time `json:"committedDate"`
+	} `json:"target"`
+}
+
+func (t GitTagRefTarget) commitDate() *time.Time {
+	if t.CommittedDate != nil {
+		return t.CommittedDate
+	}
+	if t.Target != nil {
+		return t.Target.CommittedDate
+	}
+
+	return nil
+}
+
+func ToSvcRepoGitTags(refs []GitTagRef, repo svcmodel.GithubRepo) ([]svcmodel.RepoGitTag, error) {
+	t := make([]svcmodel.RepoGitTag, 0, len(refs))
+	for _, ref := range refs {
+		tag, err := ToSvcGitTag(ref.Name, repo)
+		if err != nil {
+			return nil, err
+		}
+
+		t = append(t, svcmodel.RepoGitTag{
+			Tag:         tag,
+			CommittedAt: ref.Target.commitDate(),
+		})
+	}
+
+	return t, nil
+}
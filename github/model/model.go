@@ -13,8 +13,8 @@ import (
 type TagDeletionWebhookInput struct {
 	Tag string `json:"ref" validate:"required"`
 	// RefType is the type of the reference (e.g. "branch" or "tag")
-	// We only care about tags
-	RefType string `json:"ref_type" validate:"required,eq=tag"`
+	// We only care about tags, events of branches are ignored
+	RefType string `json:"ref_type" validate:"required"`
 	Repo    struct {
 		// Owner and repo slug of the GitHub repo separated by a slash
 		// (e.g. "owner/repo")
@@ -22,6 +22,25 @@ type TagDeletionWebhookInput struct {
 	} `json:"repository"`
 }
 
+// TagCreationWebhookInput has the same payload as the tag deletion webhook
+// Docs: https://docs.github.com/en/webhooks/webhook-events-and-payloads#create
+type TagCreationWebhookInput TagDeletionWebhookInput
+
+// WebhookEvents are the events the webhook registered by the service subscribes to,
+// create and delete events are sent for both branches and tags, only tags are processed.
+// GitHub also sends a ping event when the webhook is registered, it is acknowledged without processing.
+var WebhookEvents = []string{"create", "delete"}
+
+// RefTypeTag is the ref type of create and delete events of tags
+const RefTypeTag = "tag"
+
+// WebhookDeliveryInput is the part of the payload common to all webhook events
+type WebhookDeliveryInput struct {
+	Repo struct {
+		Slugs string `json:"full_name"`
+	} `json:"repository"`
+}
+
 func ToSvcGitTag(tagName string, repo svcmodel.GithubRepo) (svcmodel.GitTag, error) {
 	tagURL, err := util.GenerateGitTagURL(repo.OwnerSlug, repo.RepoSlug, tagName)
 	if err != nil {
@@ -34,20 +53,6 @@ func ToSvcGitTag(tagName string, repo svcmodel.GithubRepo) (svcmodel.GitTag, err
 	}, nil
 }
 
-func ToSvcGitTags(tags []*github.RepositoryTag, repo svcmodel.GithubRepo) ([]svcmodel.GitTag, error) {
-	t := make([]svcmodel.GitTag, 0, len(tags))
-	for _, tag := range tags {
-		svcTag, err := ToSvcGitTag(*tag.Name, repo)
-		if err != nil {
-			return nil, err
-		}
-
-		t = append(t, svcTag)
-	}
-
-	return t, nil
-}
-
 func ToSvcGithubRepo(repo *github.Repository, ownerSlug, repoSlug string) (svcmodel.GithubRepo, error) {
 	u, err := url.Parse(repo.GetHTMLURL())
 	if err != nil {
@@ -61,16 +66,208 @@ func ToSvcGithubRepo(repo *github.Repository, ownerSlug, repoSlug string) (svcmo
 	}, nil
 }
 
-func ToSvcGithubReleaseNotes(notes *github.RepositoryReleaseNotes) svcmodel.GithubReleaseNotes {
-	return svcmodel.GithubReleaseNotes{
+func ToSvcGithubReleaseNotes(notes *github.RepositoryReleaseNotes) svcmodel.GitReleaseNotes {
+	return svcmodel.GitReleaseNotes{
 		Title: notes.Name,
 		Notes: notes.Body,
 	}
 }
 
+func ToSvcGithubReleases(releases []*github.RepositoryRelease, repo svcmodel.GithubRepo) ([]svcmodel.GithubRelease, error) {
+	r := make([]svcmodel.GithubRelease, 0, len(releases))
+	for _, rls := range releases {
+		svcRls, err := ToSvcGithubRelease(rls, repo)
+		if err != nil {
+			return nil, err
+		}
+
+		r = append(r, svcRls)
+	}
+
+	return r, nil
+}
+
+func ToSvcGithubRelease(rls *github.RepositoryRelease, repo svcmodel.GithubRepo) (svcmodel.GithubRelease, error) {
+	tag, err := ToSvcGitTag(rls.GetTagName(), repo)
+	if err != nil {
+		return svcmodel.GithubRelease{}, err
+	}
+
+	assets := make([]svcmodel.GithubReleaseAsset, 0, len(rls.Assets))
+	for _, a := range rls.Assets {
+		u, err := url.Parse(a.GetBrowserDownloadURL())
+		if err != nil {
+			return svcmodel.GithubRelease{}, fmt.Errorf("parsing GitHub release asset URL: %w", err)
+		}
+
+		assets = append(assets, svcmodel.GithubReleaseAsset{
+			Name:      a.GetName(),
+			URL:       *u,
+			CreatedAt: a.GetCreatedAt().Time,
+		})
+	}
+
+	return svcmodel.GithubRelease{
+		Tag:        tag,
+		Name:       rls.GetName(),
+		Body:       rls.GetBody(),
+		Draft:      rls.GetDraft(),
+		Prerelease: rls.GetPrerelease(),
+		CreatedAt:  rls.GetCreatedAt().Time,
+		Assets:     assets,
+	}, nil
+}
+
+// ToGithubMakeLatest returns nil if make latest is not set, GitHub then makes the release latest.
+func ToGithubMakeLatest(m svcmodel.GithubMakeLatest) *string {
+	if m == "" {
+		return nil
+	}
+
+	return github.String(string(m))
+}
+
 func ToSvcGithubTagDeletionWebhookOutput(repo svcmodel.GithubRepo, tagName string) svcmodel.GithubTagDeletionWebhookOutput {
 	return svcmodel.GithubTagDeletionWebhookOutput{
 		Repo:    repo,
 		TagName: tagName,
 	}
 }
+
+func ToSvcGithubTagCreationWebhookOutput(repo svcmodel.GithubRepo, tag svcmodel.GitTag) svcmodel.GithubTagCreationWebhookOutput {
+	return svcmodel.GithubTagCreationWebhookOutput{
+		Repo: repo,
+		Tag:  tag,
+	}
+}
+
+func ToSvcGitTagComparison(cmp *github.CommitsComparison, prs []*github.PullRequest) (svcmodel.GitTagComparison, error) {
+	u, err := url.Parse(cmp.GetHTMLURL())
+	if err != nil {
+		return svcmodel.GitTagComparison{}, fmt.Errorf("parsing GitHub comparison URL: %w", err)
+	}
+
+	commits := make([]svcmodel.GitCommit, 0, len(cmp.Commits))
+	for _, c := range cmp.Commits {
+		commit, err := ToSvcGitCommit(c)
+		if err != nil {
+			return svcmodel.GitTagComparison{}, err
+		}
+
+		commits = append(commits, commit)
+	}
+
+	pullRequests := make([]svcmodel.GithubPullRequest, 0, len(prs))
+	for _, pr := range prs {
+		pullRequest, err := ToSvcGithubPullRequest(pr)
+		if err != nil {
+			return svcmodel.GitTagComparison{}, err
+		}
+
+		pullRequests = append(pullRequests, pullRequest)
+	}
+
+	files := make([]svcmodel.GitChangedFile, 0, len(cmp.Files))
+	for _, f := range cmp.Files {
+		files = append(files, svcmodel.GitChangedFile{
+			Filename:  f.GetFilename(),
+			Status:    f.GetStatus(),
+			Additions: f.GetAdditions(),
+			Deletions: f.GetDeletions(),
+			Changes:   f.GetChanges(),
+		})
+	}
+
+	return svcmodel.GitTagComparison{
+		Status:       cmp.GetStatus(),
+		AheadBy:      cmp.GetAheadBy(),
+		BehindBy:     cmp.GetBehindBy(),
+		TotalCommits: cmp.GetTotalCommits(),
+		Commits:      commits,
+		PullRequests: pullRequests,
+		Files:        files,
+		URL:          *u,
+	}, nil
+}
+
+func ToSvcGitCommit(c *github.RepositoryCommit) (svcmodel.GitCommit, error) {
+	u, err := url.Parse(c.GetHTMLURL())
+	if err != nil {
+		return svcmodel.GitCommit{}, fmt.Errorf("parsing GitHub commit URL: %w", err)
+	}
+
+	author := svcmodel.GitCommitAuthor{
+		Name:  c.GetCommit().GetAuthor().GetName(),
+		Email: c.GetCommit().GetAuthor().GetEmail(),
+	}
+	// Author is nil if the commit email is not linked to any GitHub account
+	if c.Author != nil && c.Author.Login != nil {
+		author.Login = c.Author.Login
+	}
+
+	return svcmodel.GitCommit{
+		SHA:         c.GetSHA(),
+		Message:     c.GetCommit().GetMessage(),
+		Author:      author,
+		URL:         *u,
+		CommittedAt: c.GetCommit().GetAuthor().GetDate().Time,
+	}, nil
+}
+
+func ToSvcGithubPullRequest(pr *github.PullRequest) (svcmodel.GithubPullRequest, error) {
+	u, err := url.Parse(pr.GetHTMLURL())
+	if err != nil {
+		return svcmodel.GithubPullRequest{}, fmt.Errorf("parsing GitHub pull request URL: %w", err)
+	}
+
+	return svcmodel.GithubPullRequest{
+		Number:      pr.GetNumber(),
+		Title:       pr.GetTitle(),
+		AuthorLogin: pr.GetUser().GetLogin(),
+		URL:         *u,
+		MergedAt:    pr.GetMergedAt().Time,
+	}, nil
+}
+
+func ToSvcReleaseLinkedItems(previousTagName string, prs []*github.PullRequest, issues []*github.Issue) (svcmodel.ReleaseLinkedItems, error) {
+	pullRequests := make([]svcmodel.GithubPullRequest, 0, len(prs))
+	for _, pr := range prs {
+		pullRequest, err := ToSvcGithubPullRequest(pr)
+		if err != nil {
+			return svcmodel.ReleaseLinkedItems{}, err
+		}
+
+		pullRequests = append(pullRequests, pullRequest)
+	}
+
+	svcIssues := make([]svcmodel.GithubIssue, 0, len(issues))
+	for _, i := range issues {
+		issue, err := ToSvcGithubIssue(i)
+		if err != nil {
+			return svcmodel.ReleaseLinkedItems{}, err
+		}
+
+		svcIssues = append(svcIssues, issue)
+	}
+
+	return svcmodel.ReleaseLinkedItems{
+		PreviousGitTagName: previousTagName,
+		PullRequests:       pullRequests,
+		Issues:             svcIssues,
+	}, nil
+}
+
+func ToSvcGithubIssue(i *github.Issue) (svcmodel.GithubIssue, error) {
+	u, err := url.Parse(i.GetHTMLURL())
+	if err != nil {
+		return svcmodel.GithubIssue{}, fmt.Errorf("parsing GitHub issue URL: %w", err)
+	}
+
+	return svcmodel.GithubIssue{
+		Number:      i.GetNumber(),
+		Title:       i.GetTitle(),
+		AuthorLogin: i.GetUser().GetLogin(),
+		URL:         *u,
+		ClosedAt:    i.GetClosedAt().Time,
+	}, nil
+}
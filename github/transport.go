@@ -0,0 +1,319 @@
+package github
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	svcmodel "release-manager/service/model"
+)
+
+const (
+	// maxRetries is the number of retries after the first attempt of a request
+	maxRetries = 3
+	// maxRetryWait limits how long a request waits for a retry, a longer wait (e.g. for the reset of the primary rate limit) is not worth blocking the request
+	maxRetryWait = 30 * time.Second
+	// initialRetryBackoff doubles with every retry of a request failed by a temporary server error
+	initialRetryBackoff = time.Second
+
+	// maxCachedResponses limits the memory used by the cache, the least recently used responses are evicted first
+	maxCachedResponses = 1000
+
+	headerRetryAfter         = "Retry-After"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+	headerRateLimitReset     = "X-RateLimit-Reset"
+	headerETag               = "ETag"
+	headerIfNoneMatch        = "If-None-Match"
+	headerAuthorization      = "Authorization"
+
+	// authorizationBearerPrefix precedes the token in the Authorization header set by the go-github client
+	authorizationBearerPrefix = "Bearer "
+)
+
+var (
+	errRequestBodyNotReplayable = errors.New("request body cannot be read again")
+)
+
+// tokenOwnerFunc returns the stable identity of a token which is replaced periodically (e.g. an installation token of the GitHub App),
+// false if the token is not known.
+type tokenOwnerFunc func(tkn svcmodel.GithubToken) (string, bool)
+
+// newHTTPClient returns the HTTP client shared by all requests to the GitHub API.
+// Conditional requests answered with 304 Not Modified do not count against the rate limit.
+func newHTTPClient(tokenOwner tokenOwnerFunc) *http.Client {
+	return &http.Client{
+		Transport: &retryTransport{
+			next: newConditionalCacheTransport(http.DefaultTransport, tokenOwner),
+		},
+	}
+}
+
+// retryTransport retries requests rejected by a rate limit and idempotent requests failed by a temporary server error.
+type retryTransport struct {
+	next http.RoundTripper
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+
+		wait, ok := retryWait(req, resp, attempt)
+		if !ok || attempt == maxRetries || wait > maxRetryWait {
+			return resp, nil
+		}
+
+		retryReq, err := cloneRequestForRetry(req)
+		if err != nil {
+			return resp, nil
+		}
+
+		// The body is drained so the connection can be reused.
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		if err := sleepContext(req.Context(), wait); err != nil {
+			return nil, err
+		}
+		req = retryReq
+	}
+}
+
+// retryWait returns how long to wait before the request is retried, false if the request should not be retried.
+// Requests rejected by a rate limit were not processed, so they are retried regardless of the method.
+// Docs: https://docs.github.com/en/rest/using-the-rest-api/rate-limits-for-the-rest-api?apiVersion=2022-11-28#exceeding-the-rate-limit
+func retryWait(req *http.Request, resp *http.Response, attempt int) (time.Duration, bool) {
+	switch resp.StatusCode {
+	case http.StatusForbidden, http.StatusTooManyRequests:
+		// Secondary rate limits are signalled by Retry-After.
+		if d, ok := parseRetryAfter(resp.Header.Get(headerRetryAfter)); ok {
+			return d, true
+		}
+		// Primary rate limit is exceeded when no requests remain, it is reset at the given time.
+		// Forbidden without rate limit headers means missing permissions, it is not retried.
+		if resp.Header.Get(headerRateLimitRemaining) == "0" {
+			return untilRateLimitReset(resp.Header.Get(headerRateLimitReset))
+		}
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		// Non-idempotent requests (e.g. creating a tag) are not retried, they might have been processed.
+		if isIdempotent(req.Method) {
+			return initialRetryBackoff << attempt, true
+		}
+	}
+
+	return 0, false
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+
+	return time.Duration(seconds) * time.Second, true
+}
+
+func untilRateLimitReset(value string) (time.Duration, bool) {
+	reset, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return max(time.Until(time.Unix(reset, 0)), 0), true
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// cloneRequestForRetry returns a copy of the request with a fresh body, the body of the original request was already read.
+func cloneRequestForRetry(req *http.Request) (*http.Request, error) {
+	clone := req.Clone(req.Context())
+	if req.Body == nil || req.Body == http.NoBody {
+		return clone, nil
+	}
+	if req.GetBody == nil {
+		return nil, errRequestBodyNotReplayable
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	clone.Body = body
+
+	return clone, nil
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type cachedResponse struct {
+	key        string
+	etag       string
+	statusCode int
+	header     http.Header
+	body       []byte
+}
+
+// conditionalCacheTransport caches GET responses with ETag and revalidates them with If-None-Match.
+// Responses are cached per token owner, so a response is never served to a token which cannot access it.
+// Installation tokens are refreshed every hour, their responses are cached per installation, so they survive the refresh.
+type conditionalCacheTransport struct {
+	next       http.RoundTripper
+	tokenOwner tokenOwnerFunc
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+func newConditionalCacheTransport(next http.RoundTripper, tokenOwner tokenOwnerFunc) *conditionalCacheTransport {
+	return &conditionalCacheTransport{
+		next:       next,
+		tokenOwner: tokenOwner,
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+	}
+}
+
+func (t *conditionalCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || req.Header.Get(headerIfNoneMatch) != "" {
+		return t.next.RoundTrip(req)
+	}
+
+	key := t.responseCacheKey(req)
+	cached, ok := t.get(key)
+	if ok {
+		req = req.Clone(req.Context())
+		req.Header.Set(headerIfNoneMatch, cached.etag)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok && resp.StatusCode == http.StatusNotModified {
+		_ = resp.Body.Close()
+		return cached.toResponse(req, resp.Header), nil
+	}
+
+	etag := resp.Header.Get(headerETag)
+	if resp.StatusCode != http.StatusOK || etag == "" {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	t.set(&cachedResponse{
+		key:        key,
+		etag:       etag,
+		statusCode: resp.StatusCode,
+		header:     resp.Header.Clone(),
+		body:       body,
+	})
+
+	return resp, nil
+}
+
+func (t *conditionalCacheTransport) get(key string) (*cachedResponse, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	e, ok := t.entries[key]
+	if !ok {
+		return nil, false
+	}
+	t.lru.MoveToFront(e)
+
+	return e.Value.(*cachedResponse), true
+}
+
+func (t *conditionalCacheTransport) set(r *cachedResponse) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if e, ok := t.entries[r.key]; ok {
+		e.Value = r
+		t.lru.MoveToFront(e)
+		return
+	}
+
+	t.entries[r.key] = t.lru.PushFront(r)
+	if t.lru.Len() > maxCachedResponses {
+		oldest := t.lru.Back()
+		t.lru.Remove(oldest)
+		delete(t.entries, oldest.Value.(*cachedResponse).key)
+	}
+}
+
+// toResponse rebuilds the cached response, rate limit headers are taken from the 304 response, so they are up to date.
+func (r *cachedResponse) toResponse(req *http.Request, notModifiedHeader http.Header) *http.Response {
+	header := r.header.Clone()
+	for _, h := range []string{headerRateLimitRemaining, headerRateLimitReset} {
+		if v := notModifiedHeader.Get(h); v != "" {
+			header.Set(h, v)
+		}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.statusCode, http.StatusText(r.statusCode)),
+		StatusCode:    r.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(r.body)),
+		ContentLength: int64(len(r.body)),
+		Request:       req,
+	}
+}
+
+// responseCacheKey identifies the response by the URL, the accepted media type and the owner of the token.
+// A token without a known owner (e.g. a personal access token) is identified by its hash.
+func (t *conditionalCacheTransport) responseCacheKey(req *http.Request) string {
+	auth := req.Header.Get(headerAuthorization)
+	owner, ok := t.tokenOwner(svcmodel.GithubToken(strings.TrimPrefix(auth, authorizationBearerPrefix)))
+	if !ok {
+		hash := sha256.Sum256([]byte(auth))
+		owner = hex.EncodeToString(hash[:])
+	}
+
+	return req.URL.String() + "|" + req.Header.Get("Accept") + "|" + owner
+}
@@ -57,8 +57,14 @@ func ParseGithubRepoURL(rawURL string) (ownerSlug, repoSlug string, err error) {
 	return slugs[0], slugs[1], nil
 }
 
-// TranslateGithubAuthError translates GitHub auth errors to service errors
+// TranslateGithubAuthError translates GitHub auth and rate limit errors to service errors
 func TranslateGithubAuthError(err error) error {
+	var rateLimitErr *github.RateLimitError
+	var abuseRateLimitErr *github.AbuseRateLimitError
+	if errors.As(err, &rateLimitErr) || errors.As(err, &abuseRateLimitErr) {
+		return svcerrors.NewGithubRateLimitedError().Wrap(err)
+	}
+
 	var githubErr *github.ErrorResponse
 	if errors.As(err, &githubErr) {
 		switch githubErr.Response.StatusCode {
@@ -66,6 +72,8 @@ func TranslateGithubAuthError(err error) error {
 			return svcerrors.NewGithubClientUnauthorizedError().Wrap(err)
 		case http.StatusForbidden:
 			return svcerrors.NewGithubClientForbiddenError().Wrap(err)
+		case http.StatusTooManyRequests:
+			return svcerrors.NewGithubRateLimitedError().Wrap(err)
 		}
 	}
 
@@ -96,6 +104,16 @@ func IsReleaseAlreadyExistsError(err error) bool {
 	return false
 }
 
+// IsUnprocessableEntityError is returned e.g. when a commit does not exist or a git reference already exists
+func IsUnprocessableEntityError(err error) bool {
+	var githubErr *github.ErrorResponse
+	if errors.As(err, &githubErr) {
+		return githubErr.Response.StatusCode == http.StatusUnprocessableEntity
+	}
+
+	return false
+}
+
 func IsInvalidPreviousTagError(err error) bool {
 	var githubErr *github.ErrorResponse
 	if errors.As(err, &githubErr) {
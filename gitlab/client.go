@@ -0,0 +1,342 @@
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"release-manager/gitlab/model"
+	"release-manager/gitlab/util"
+	"release-manager/pkg/validatorx"
+	svcerrors "release-manager/service/errors"
+	svcmodel "release-manager/service/model"
+)
+
+const (
+	// apiPath is relative to the base URL of the GitLab instance
+	apiPath = "/api/v4"
+	// tagsPerPage is the maximum number of items GitLab returns per page
+	tagsPerPage = 100
+	// tokenHeader authenticates requests with a personal, group or project access token
+	tokenHeader = "PRIVATE-TOKEN"
+	// nextPageHeader is empty on the last page of offset-based pagination
+	nextPageHeader = "X-Next-Page"
+	// requestTimeout limits requests to self-hosted instances which are not reachable
+	requestTimeout = 30 * time.Second
+)
+
+type Client struct {
+	httpClient *http.Client
+}
+
+func NewClient() *Client {
+	return &Client{
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// ReadRepo reads the GitLab project by its URL, the URL must belong to the GitLab instance set in the settings.
+// Docs: https://docs.gitlab.com/ee/api/projects.html#get-single-project
+func (c *Client) ReadRepo(ctx context.Context, inst svcmodel.GitlabInstance, rawRepoURL string) (svcmodel.GitlabRepo, error) {
+	path, err := util.ParseGitlabRepoURL(inst.BaseURL, rawRepoURL)
+	if err != nil {
+		return svcmodel.GitlabRepo{}, svcerrors.NewGitlabRepoInvalidURLError().Wrap(err).WithMessage(err.Error())
+	}
+
+	var p model.Project
+	if _, err := c.do(ctx, inst, http.MethodGet, projectEndpoint(path, ""), nil, nil, &p); err != nil {
+		if util.IsNotFoundError(err) {
+			return svcmodel.GitlabRepo{}, svcerrors.NewGitlabRepoNotFoundError().Wrap(err)
+		}
+
+		return svcmodel.GitlabRepo{}, util.TranslateGitlabAuthError(err)
+	}
+
+	return model.ToSvcGitlabRepo(p)
+}
+
+// ReadTagsForRepo returns all tags of the project with the date of the tagged commit.
+// Docs: https://docs.gitlab.com/ee/api/tags.html#list-project-repository-tags
+func (c *Client) ReadTagsForRepo(ctx context.Context, inst svcmodel.GitlabInstance, repo svcmodel.GitlabRepo) ([]svcmodel.RepoGitTag, error) {
+	// Up to 100 tags can be fetched per page, pages are fetched until there is no next page
+	var tags []model.Tag
+	query := url.Values{"per_page": {strconv.Itoa(tagsPerPage)}}
+	for {
+		var page []model.Tag
+		resp, err := c.do(ctx, inst, http.MethodGet, repoEndpoint(repo, "/repository/tags"), query, nil, &page)
+		if err != nil {
+			if util.IsNotFoundError(err) {
+				return nil, svcerrors.NewGitlabRepoNotFoundError().Wrap(err)
+			}
+
+			return nil, util.TranslateGitlabAuthError(err)
+		}
+
+		tags = append(tags, page...)
+
+		next := resp.Header.Get(nextPageHeader)
+		if next == "" {
+			break
+		}
+		query.Set("page", next)
+	}
+
+	return model.ToSvcRepoGitTags(tags, repo), nil
+}
+
+// ReadTag checks that the tag exists, both lightweight and annotated tags are read by their name.
+// Docs: https://docs.gitlab.com/ee/api/tags.html#get-a-single-repository-tag
+func (c *Client) ReadTag(ctx context.Context, inst svcmodel.GitlabInstance, repo svcmodel.GitlabRepo, tagName string) (svcmodel.GitTag, error) {
+	if _, err := c.do(ctx, inst, http.MethodGet, tagEndpoint(repo, tagName), nil, nil, nil); err != nil {
+		if util.IsNotFoundError(err) {
+			return svcmodel.GitTag{}, svcerrors.NewGitTagNotFoundError().Wrap(err)
+		}
+
+		return svcmodel.GitTag{}, util.TranslateGitlabAuthError(err)
+	}
+
+	return model.ToSvcGitTag(tagName, repo), nil
+}
+
+// CreateTag creates an annotated tag pointing to the target, which is a branch name or a commit SHA.
+// Docs: https://docs.gitlab.com/ee/api/tags.html#create-a-new-tag
+func (c *Client) CreateTag(
+	ctx context.Context,
+	inst svcmodel.GitlabInstance,
+	repo svcmodel.GitlabRepo,
+	tagName string,
+	target string,
+	message string,
+) (svcmodel.GitTag, error) {
+	// GitLab responds with 400 both for an existing tag and an unknown target
+	if _, err := c.do(ctx, inst, http.MethodPost, repoEndpoint(repo, "/repository/tags"), nil, model.CreateTagRequest{
+		TagName: tagName,
+		Ref:     target,
+		Message: message,
+	}, nil); err != nil {
+		switch {
+		case util.IsAlreadyExistsError(err):
+			return svcmodel.GitTag{}, svcerrors.NewGitTagAlreadyExistsError().Wrap(err)
+		case util.IsBadRequestError(err):
+			return svcmodel.GitTag{}, svcerrors.NewGitTagTargetNotFoundError().Wrap(err)
+		case util.IsNotFoundError(err):
+			return svcmodel.GitTag{}, svcerrors.NewGitlabRepoNotFoundError().Wrap(err)
+		}
+
+		return svcmodel.GitTag{}, util.TranslateGitlabAuthError(err)
+	}
+
+	return model.ToSvcGitTag(tagName, repo), nil
+}
+
+// DeleteTag deletes the tag, it is used to undo CreateTag.
+// Docs: https://docs.gitlab.com/ee/api/tags.html#delete-a-tag
+func (c *Client) DeleteTag(ctx context.Context, inst svcmodel.GitlabInstance, repo svcmodel.GitlabRepo, tagName string) error {
+	if _, err := c.do(ctx, inst, http.MethodDelete, tagEndpoint(repo, tagName), nil, nil, nil); err != nil {
+		if util.IsNotFoundError(err) {
+			return svcerrors.NewGitTagNotFoundError().Wrap(err)
+		}
+
+		return util.TranslateGitlabAuthError(err)
+	}
+
+	return nil
+}
+
+// UpsertRelease updates the release of the tag and creates it if the tag does not have one yet.
+// GitLab releases have no drafts or prereleases, so the GitHub options of the release are not used.
+// Docs: https://docs.gitlab.com/ee/api/releases/
+func (c *Client) UpsertRelease(ctx context.Context, inst svcmodel.GitlabInstance, repo svcmodel.GitlabRepo, rls svcmodel.Release) error {
+	req := model.ReleaseRequest{
+		Name:        rls.ReleaseTitle,
+		Description: rls.ReleaseNotes,
+	}
+
+	_, err := c.do(ctx, inst, http.MethodPut, releaseEndpoint(repo, rls.Tag.Name), nil, req, nil)
+	if err == nil {
+		return nil
+	}
+	if !util.IsNotFoundError(err) {
+		return fmt.Errorf("updating release: %w", util.TranslateGitlabAuthError(err))
+	}
+
+	req.TagName = rls.Tag.Name
+	if _, err := c.do(ctx, inst, http.MethodPost, repoEndpoint(repo, "/releases"), nil, req, nil); err != nil {
+		if util.IsNotFoundError(err) {
+			return svcerrors.NewGitlabRepoNotFoundError().Wrap(err)
+		}
+
+		return fmt.Errorf("creating release: %w", util.TranslateGitlabAuthError(err))
+	}
+
+	return nil
+}
+
+// DeleteReleaseByTag deletes the release of the tag, the tag itself is kept.
+// Docs: https://docs.gitlab.com/ee/api/releases/#delete-a-release
+func (c *Client) DeleteReleaseByTag(ctx context.Context, inst svcmodel.GitlabInstance, repo svcmodel.GitlabRepo, tag svcmodel.GitTag) error {
+	if _, err := c.do(ctx, inst, http.MethodDelete, releaseEndpoint(repo, tag.Name), nil, nil, nil); err != nil {
+		if util.IsNotFoundError(err) {
+			return svcerrors.NewGitlabReleaseNotFoundError().Wrap(err)
+		}
+
+		return fmt.Errorf("deleting release: %w", util.TranslateGitlabAuthError(err))
+	}
+
+	return nil
+}
+
+// GenerateReleaseNotes lists the commits between the previous tag and the tag.
+// GitLab generates changelogs only from commit trailers, so the notes are rendered from the comparison instead.
+// Without the previous tag, the notes only link the commits of the tag.
+func (c *Client) GenerateReleaseNotes(
+	ctx context.Context,
+	inst svcmodel.GitlabInstance,
+	repo svcmodel.GitlabRepo,
+	input svcmodel.GitReleaseNotesInput,
+) (svcmodel.GitReleaseNotes, error) {
+	tagName := input.GetGitTagName()
+	if input.PreviousGitTagName == nil {
+		if _, err := c.ReadTag(ctx, inst, repo, tagName); err != nil {
+			return svcmodel.GitReleaseNotes{}, err
+		}
+
+		return model.ToSvcReleaseNotes(repo, tagName, nil, nil), nil
+	}
+
+	// Docs: https://docs.gitlab.com/ee/api/repositories.html#compare-branches-tags-or-commits
+	var cmp model.Comparison
+	if _, err := c.do(ctx, inst, http.MethodGet, repoEndpoint(repo, "/repository/compare"), url.Values{
+		"from":     {*input.PreviousGitTagName},
+		"to":       {tagName},
+		"straight": {"false"},
+	}, nil, &cmp); err != nil {
+		if util.IsNotFoundError(err) {
+			return svcmodel.GitReleaseNotes{},
+				svcerrors.NewGithubNotesInvalidInputError().Wrap(err).WithMessage("Invalid git tag or previous git tag")
+		}
+
+		return svcmodel.GitReleaseNotes{}, fmt.Errorf("comparing tags: %w", util.TranslateGitlabAuthError(err))
+	}
+
+	return model.ToSvcReleaseNotes(repo, tagName, input.PreviousGitTagName, cmp.Commits), nil
+}
+
+// ParseTagPushWebhook verifies the secret token of the delivery and parses the tag push event.
+// The token is compared in constant time, a webhook without a secret is not accepted.
+func (c *Client) ParseTagPushWebhook(
+	input svcmodel.GitlabWebhookInput,
+	secret svcmodel.GitlabWebhookSecret,
+) (svcmodel.GitlabTagPushWebhookOutput, error) {
+	if secret == "" || subtle.ConstantTimeCompare([]byte(input.Token), []byte(secret)) != 1 {
+		return svcmodel.GitlabTagPushWebhookOutput{}, svcerrors.NewInvalidGitlabTagPushWebhookError().WithMessage("invalid webhook token")
+	}
+
+	if input.Event != svcmodel.GitlabTagPushEvent {
+		return svcmodel.GitlabTagPushWebhookOutput{},
+			svcerrors.NewGitlabWebhookEventNotSupportedError().WithMessage(fmt.Sprintf("event %q is not supported", input.Event))
+	}
+
+	var payload model.TagPushWebhookInput
+	if err := json.Unmarshal(input.RawPayload, &payload); err != nil {
+		return svcmodel.GitlabTagPushWebhookOutput{}, svcerrors.NewInvalidGitlabTagPushWebhookError().Wrap(err)
+	}
+
+	if err := validatorx.ValidateStruct(payload); err != nil {
+		return svcmodel.GitlabTagPushWebhookOutput{}, svcerrors.NewInvalidGitlabTagPushWebhookError().Wrap(err)
+	}
+
+	return model.ToSvcGitlabTagPushWebhookOutput(payload)
+}
+
+// do sends the request to the GitLab API, the response body is decoded into out if it is not nil.
+// Responses with an error status code are returned as util.APIError.
+func (c *Client) do(
+	ctx context.Context,
+	inst svcmodel.GitlabInstance,
+	method string,
+	endpoint string,
+	query url.Values,
+	body any,
+	out any,
+) (*http.Response, error) {
+	u, err := url.Parse(inst.BaseURL.String() + apiPath + endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parsing request URL: %w", err)
+	}
+	if query != nil {
+		u.RawQuery = query.Encode()
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("encoding request body: %w", err)
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set(tokenHeader, inst.Token.String())
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		// The body of the error is read only for the message, some errors have no body
+		var errResp model.ErrorResponse
+		_ = json.NewDecoder(resp.Body).Decode(&errResp)
+
+		msg := errResp.String()
+		if msg == "" {
+			msg = http.StatusText(resp.StatusCode)
+		}
+
+		return resp, &util.APIError{StatusCode: resp.StatusCode, Message: msg}
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return resp, fmt.Errorf("decoding response body: %w", err)
+		}
+	}
+
+	return resp, nil
+}
+
+// projectEndpoint returns the endpoint of the project, the project is identified either by its ID or by its URL-encoded path.
+func projectEndpoint(projectIDOrPath, subpath string) string {
+	return "/projects/" + url.PathEscape(projectIDOrPath) + subpath
+}
+
+// repoEndpoint uses the ID of the project, so the endpoint works even if the project was moved since it was linked.
+func repoEndpoint(repo svcmodel.GitlabRepo, subpath string) string {
+	return projectEndpoint(strconv.FormatInt(repo.ProjectID, 10), subpath)
+}
+
+// tagEndpoint escapes the tag name, since tag names can contain slashes.
+func tagEndpoint(repo svcmodel.GitlabRepo, tagName string) string {
+	return repoEndpoint(repo, "/repository/tags/"+url.PathEscape(tagName))
+}
+
+func releaseEndpoint(repo svcmodel.GitlabRepo, tagName string) string {
+	return repoEndpoint(repo, "/releases/"+url.PathEscape(tagName))
+}
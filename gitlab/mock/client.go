@@ -0,0 +1,58 @@
+package mock
+
+import (
+	"context"
+
+	svcmodel "release-manager/service/model"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type Client struct {
+	mock.Mock
+}
+
+func (c *Client) ReadRepo(ctx context.Context, inst svcmodel.GitlabInstance, rawRepoURL string) (svcmodel.GitlabRepo, error) {
+	args := c.Called(ctx, inst, rawRepoURL)
+	return args.Get(0).(svcmodel.GitlabRepo), args.Error(1)
+}
+
+func (c *Client) ReadTagsForRepo(ctx context.Context, inst svcmodel.GitlabInstance, repo svcmodel.GitlabRepo) ([]svcmodel.RepoGitTag, error) {
+	args := c.Called(ctx, inst, repo)
+	return args.Get(0).([]svcmodel.RepoGitTag), args.Error(1)
+}
+
+func (c *Client) ReadTag(ctx context.Context, inst svcmodel.GitlabInstance, repo svcmodel.GitlabRepo, tagName string) (svcmodel.GitTag, error) {
+	args := c.Called(ctx, inst, repo, tagName)
+	return args.Get(0).(svcmodel.GitTag), args.Error(1)
+}
+
+func (c *Client) CreateTag(ctx context.Context, inst svcmodel.GitlabInstance, repo svcmodel.GitlabRepo, tagName, target, message string) (svcmodel.GitTag, error) {
+	args := c.Called(ctx, inst, repo, tagName, target, message)
+	return args.Get(0).(svcmodel.GitTag), args.Error(1)
+}
+
+func (c *Client) DeleteTag(ctx context.Context, inst svcmodel.GitlabInstance, repo svcmodel.GitlabRepo, tagName string) error {
+	args := c.Called(ctx, inst, repo, tagName)
+	return args.Error(0)
+}
+
+func (c *Client) UpsertRelease(ctx context.Context, inst svcmodel.GitlabInstance, repo svcmodel.GitlabRepo, rls svcmodel.Release) error {
+	args := c.Called(ctx, inst, repo, rls)
+	return args.Error(0)
+}
+
+func (c *Client) DeleteReleaseByTag(ctx context.Context, inst svcmodel.GitlabInstance, repo svcmodel.GitlabRepo, tag svcmodel.GitTag) error {
+	args := c.Called(ctx, inst, repo, tag)
+	return args.Error(0)
+}
+
+func (c *Client) GenerateReleaseNotes(ctx context.Context, inst svcmodel.GitlabInstance, repo svcmodel.GitlabRepo, input svcmodel.GitReleaseNotesInput) (svcmodel.GitReleaseNotes, error) {
+	args := c.Called(ctx, inst, repo, input)
+	return args.Get(0).(svcmodel.GitReleaseNotes), args.Error(1)
+}
+
+func (c *Client) ParseTagPushWebhook(input svcmodel.GitlabWebhookInput, secret svcmodel.GitlabWebhookSecret) (svcmodel.GitlabTagPushWebhookOutput, error) {
+	args := c.Called(input, secret)
+	return args.Get(0).(svcmodel.GitlabTagPushWebhookOutput), args.Error(1)
+}
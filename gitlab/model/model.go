@@ -0,0 +1,166 @@
+package model
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	svcmodel "release-manager/service/model"
+)
+
+const (
+	// tagRefPrefix precedes the tag name in the ref of tag push events
+	tagRefPrefix = "refs/tags/"
+	// deletedRefSHA is the SHA of the ref after the push which deleted it
+	deletedRefSHA = "0000000000000000000000000000000000000000"
+)
+
+// Project is a GitLab project
+// Docs: https://docs.gitlab.com/ee/api/projects.html#get-single-project
+type Project struct {
+	ID                int64  `json:"id" validate:"required"`
+	WebURL            string `json:"web_url" validate:"required"`
+	PathWithNamespace string `json:"path_with_namespace"`
+}
+
+// Tag is a git tag of a GitLab project, both lightweight and annotated tags are listed
+// Docs: https://docs.gitlab.com/ee/api/tags.html
+type Tag struct {
+	Name   string `json:"name"`
+	Commit *struct {
+		CommittedDate *time.Time `json:"committed_date"`
+	} `json:"commit"`
+}
+
+type CreateTagRequest struct {
+	TagName string `json:"tag_name"`
+	// Ref is a branch name or a commit SHA the tag points to
+	Ref string `json:"ref"`
+	// Message makes the tag annotated
+	Message string `json:"message"`
+}
+
+// ReleaseRequest is used to both create and update a release, the tag name is set only when creating it
+// Docs: https://docs.gitlab.com/ee/api/releases/
+type ReleaseRequest struct {
+	TagName     string `json:"tag_name,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// Comparison contains the commits between two refs, the oldest commit is first
+// Docs: https://docs.gitlab.com/ee/api/repositories.html#compare-branches-tags-or-commits
+type Comparison struct {
+	Commits []Commit `json:"commits"`
+}
+
+type Commit struct {
+	ShortID    string `json:"short_id"`
+	Title      string `json:"title"`
+	AuthorName string `json:"author_name"`
+	WebURL     string `json:"web_url"`
+}
+
+// ErrorResponse is the body of GitLab API errors, the message is either a string or an object with validation errors
+type ErrorResponse struct {
+	Message any    `json:"message"`
+	Error   string `json:"error"`
+}
+
+func (r ErrorResponse) String() string {
+	if r.Message != nil {
+		return fmt.Sprint(r.Message)
+	}
+
+	return r.Error
+}
+
+// TagPushWebhookInput is the payload of the tag push event
+// Docs: https://docs.gitlab.com/ee/user/project/integrations/webhook_events.html#tag-events
+type TagPushWebhookInput struct {
+	ObjectKind string `json:"object_kind" validate:"required,eq=tag_push"`
+	// After is the SHA the tag points to after the push, it is all zeros if the tag was deleted
+	After   string  `json:"after" validate:"required"`
+	Ref     string  `json:"ref" validate:"required,startswith=refs/tags/"`
+	Project Project `json:"project"`
+}
+
+func ToSvcGitlabRepo(p Project) (svcmodel.GitlabRepo, error) {
+	u, err := url.Parse(p.WebURL)
+	if err != nil {
+		return svcmodel.GitlabRepo{}, fmt.Errorf("parsing GitLab project URL: %w", err)
+	}
+
+	return svcmodel.GitlabRepo{
+		URL:       *u,
+		ProjectID: p.ID,
+		Path:      p.PathWithNamespace,
+	}, nil
+}
+
+func ToSvcGitTag(tagName string, repo svcmodel.GitlabRepo) svcmodel.GitTag {
+	return svcmodel.GitTag{
+		Name: tagName,
+		URL:  repo.TagURL(tagName),
+	}
+}
+
+func ToSvcRepoGitTags(tags []Tag, repo svcmodel.GitlabRepo) []svcmodel.RepoGitTag {
+	t := make([]svcmodel.RepoGitTag, 0, len(tags))
+	for _, tag := range tags {
+		var committedAt *time.Time
+		if tag.Commit != nil {
+			committedAt = tag.Commit.CommittedDate
+		}
+
+		t = append(t, svcmodel.RepoGitTag{
+			Tag:         ToSvcGitTag(tag.Name, repo),
+			CommittedAt: committedAt,
+		})
+	}
+
+	return t
+}
+
+// ToSvcReleaseNotes renders the commits in the format of notes generated by GitHub,
+// so the notes look the same regardless of the provider of the project repo.
+func ToSvcReleaseNotes(repo svcmodel.GitlabRepo, tagName string, previousTagName *string, commits []Commit) svcmodel.GitReleaseNotes {
+	var b strings.Builder
+
+	if len(commits) > 0 {
+		b.WriteString("## What's Changed\n")
+		// The newest commit is listed first, the same as in the notes generated by GitHub
+		for i := len(commits) - 1; i >= 0; i-- {
+			c := commits[i]
+			b.WriteString(fmt.Sprintf("* %s by %s in %s\n", c.Title, c.AuthorName, c.WebURL))
+		}
+		b.WriteString("\n")
+	}
+
+	changelogURL := repo.URL
+	if previousTagName != nil {
+		changelogURL.Path = fmt.Sprintf("%s/-/compare/%s...%s", strings.TrimSuffix(changelogURL.Path, "/"), *previousTagName, tagName)
+	} else {
+		changelogURL.Path = fmt.Sprintf("%s/-/commits/%s", strings.TrimSuffix(changelogURL.Path, "/"), tagName)
+	}
+	b.WriteString(fmt.Sprintf("**Full Changelog**: %s", changelogURL.String()))
+
+	return svcmodel.GitReleaseNotes{
+		Title: tagName,
+		Notes: b.String(),
+	}
+}
+
+func ToSvcGitlabTagPushWebhookOutput(input TagPushWebhookInput) (svcmodel.GitlabTagPushWebhookOutput, error) {
+	repo, err := ToSvcGitlabRepo(input.Project)
+	if err != nil {
+		return svcmodel.GitlabTagPushWebhookOutput{}, err
+	}
+
+	return svcmodel.GitlabTagPushWebhookOutput{
+		Repo:    repo,
+		Tag:     ToSvcGitTag(strings.TrimPrefix(input.Ref, tagRefPrefix), repo),
+		Deleted: input.After == deletedRefSHA,
+	}, nil
+}
@@ -0,0 +1,116 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	svcerrors "release-manager/service/errors"
+)
+
+const (
+	// minRepoURLSlugCount is the minimum number of slugs in a GitLab project URL
+	// Example URL: https://gitlab.com/group/project -> group and project are the slugs,
+	// projects in subgroups have more slugs (e.g. group/subgroup/project)
+	minRepoURLSlugCount = 2
+	// pathSeparator separates the project path from the pages of the project in GitLab URLs
+	// Example URL: https://gitlab.com/group/project/-/tags
+	pathSeparator = "/-/"
+)
+
+var (
+	errInvalidGitlabRepoURLPath  = errors.New("invalid GitLab project URL path, not in the format /group/project")
+	errGitlabRepoURLHostMismatch = errors.New("GitLab project URL does not belong to the GitLab instance set in the settings")
+)
+
+// APIError is returned for responses of the GitLab API with an error status code.
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("gitlab API responded with status %d: %s", e.StatusCode, e.Message)
+}
+
+// ParseGitlabRepoURL returns the full path of the project including its namespace (e.g. group/subgroup/project).
+// Relative URLs (e.g. /group/project) are resolved against the base URL of the GitLab instance,
+// absolute URLs must point to the same instance.
+func ParseGitlabRepoURL(baseURL url.URL, rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+
+	path := u.Path
+	if u.IsAbs() {
+		if u.Host != baseURL.Host {
+			return "", errGitlabRepoURLHostMismatch
+		}
+
+		// Self-hosted instances can be served from a subpath (e.g. https://example.com/gitlab)
+		path = strings.TrimPrefix(path, baseURL.Path)
+	}
+
+	if i := strings.Index(path, pathSeparator); i >= 0 {
+		path = path[:i]
+	}
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+
+	slugs := strings.Split(path, "/")
+	if len(slugs) < minRepoURLSlugCount {
+		return "", errInvalidGitlabRepoURLPath
+	}
+	for _, slug := range slugs {
+		if slug == "" {
+			return "", errInvalidGitlabRepoURLPath
+		}
+	}
+
+	return path, nil
+}
+
+func IsNotFoundError(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+func IsBadRequestError(err error) bool {
+	return hasStatusCode(err, http.StatusBadRequest)
+}
+
+// IsAlreadyExistsError returns true if the GitLab API refused to create a tag or a release because it already exists.
+func IsAlreadyExistsError(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return (apiErr.StatusCode == http.StatusBadRequest || apiErr.StatusCode == http.StatusConflict) &&
+			strings.Contains(strings.ToLower(apiErr.Message), "already exists")
+	}
+
+	return false
+}
+
+// TranslateGitlabAuthError translates GitLab auth errors to service errors
+func TranslateGitlabAuthError(err error) error {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusUnauthorized:
+			return svcerrors.NewGitlabClientUnauthorizedError().Wrap(err)
+		case http.StatusForbidden:
+			return svcerrors.NewGitlabClientForbiddenError().Wrap(err)
+		}
+	}
+
+	return err
+}
+
+func hasStatusCode(err error, statusCode int) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == statusCode
+	}
+
+	return false
+}
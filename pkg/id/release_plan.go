@@ -0,0 +1,39 @@
+package id
+
+import "github.com/google/uuid"
+
+type ReleasePlan uuid.UUID
+
+func NewReleasePlan() ReleasePlan {
+	return ReleasePlan(uuid.New())
+}
+
+func (p ReleasePlan) IsNil() bool {
+	return uuid.UUID(p) == uuid.Nil
+}
+
+func (p *ReleasePlan) FromString(s string) error {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return err
+	}
+
+	*p = ReleasePlan(id)
+	return nil
+}
+
+func (p ReleasePlan) String() string {
+	return uuid.UUID(p).String()
+}
+
+func (p *ReleasePlan) Scan(data any) error {
+	return scanUUID((*uuid.UUID)(p), "ReleasePlan", data)
+}
+
+func (p ReleasePlan) MarshalText() ([]byte, error) {
+	return []byte(uuid.UUID(p).String()), nil
+}
+
+func (p *ReleasePlan) UnmarshalText(data []byte) error {
+	return unmarshalUUID((*uuid.UUID)(p), "ReleasePlan", data)
+}
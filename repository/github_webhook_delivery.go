@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"release-manager/repository/helper"
+	"release-manager/repository/model"
+	"release-manager/repository/query"
+	svcerrors "release-manager/service/errors"
+	svcmodel "release-manager/service/model"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// CreateGithubWebhookDelivery stores a received delivery, a delivery which was already received is stored again
+// only if its processing failed and the new delivery has a valid signature. Otherwise, an error is returned.
+func (r *ReleaseRepository) CreateGithubWebhookDelivery(ctx context.Context, d svcmodel.GithubWebhookDelivery) error {
+	result, err := r.dbpool.Exec(ctx, query.CreateGithubWebhookDelivery, pgx.NamedArgs{
+		"deliveryID":     d.DeliveryID,
+		"event":          d.Event,
+		"repoFullName":   d.RepoFullName,
+		"payload":        d.RawPayload,
+		"signature":      d.Signature,
+		"signatureValid": d.SignatureValid,
+		"status":         d.Status,
+		"error":          d.Error,
+		"replayCount":    d.ReplayCount,
+		"receivedAt":     d.ReceivedAt,
+		"processedAt":    d.ProcessedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return svcerrors.NewGithubWebhookDeliveryProcessedError()
+	}
+
+	return nil
+}
+
+func (r *ReleaseRepository) ReadGithubWebhookDelivery(ctx context.Context, deliveryID string) (svcmodel.GithubWebhookDelivery, error) {
+	return r.readGithubWebhookDelivery(ctx, r.dbpool, query.ReadGithubWebhookDelivery, pgx.NamedArgs{
+		"deliveryID": deliveryID,
+	})
+}
+
+func (r *ReleaseRepository) ListGithubWebhookDeliveries(
+	ctx context.Context,
+	params svcmodel.ListGithubWebhookDeliveriesParams,
+) ([]svcmodel.GithubWebhookDelivery, error) {
+	// Status and event are optional and can be nil
+	deliveries, err := helper.ListValues[model.GithubWebhookDelivery](ctx, r.dbpool, query.ListGithubWebhookDeliveries, pgx.NamedArgs{
+		"status": params.Status,
+		"event":  params.Event,
+		"limit":  params.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ToSvcGithubWebhookDeliveries(deliveries), nil
+}
+
+func (r *ReleaseRepository) UpdateGithubWebhookDelivery(
+	ctx context.Context,
+	deliveryID string,
+	updateFn func(d svcmodel.GithubWebhookDelivery) (svcmodel.GithubWebhookDelivery, error),
+) error {
+	return helper.RunTransaction(ctx, r.dbpool, func(tx pgx.Tx) error {
+		d, err := r.readGithubWebhookDelivery(ctx, tx, query.AppendForUpdate(query.ReadGithubWebhookDelivery), pgx.NamedArgs{
+			"deliveryID": deliveryID,
+		})
+		if err != nil {
+			return fmt.Errorf("reading github webhook delivery: %w", err)
+		}
+
+		d, err = updateFn(d)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, query.UpdateGithubWebhookDelivery, pgx.NamedArgs{
+			"deliveryID":  d.DeliveryID,
+			"status":      d.Status,
+			"error":       d.Error,
+			"replayCount": d.ReplayCount,
+			"processedAt": d.ProcessedAt,
+		}); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (r *ReleaseRepository) readGithubWebhookDelivery(
+	ctx context.Context,
+	q helper.Querier,
+	query string,
+	args pgx.NamedArgs,
+) (svcmodel.GithubWebhookDelivery, error) {
+	d, err := helper.ReadValue[model.GithubWebhookDelivery](ctx, q, query, args)
+	if err != nil {
+		if helper.IsNotFound(err) {
+			return svcmodel.GithubWebhookDelivery{}, svcerrors.NewGithubWebhookDeliveryNotFoundError().Wrap(err)
+		}
+
+		return svcmodel.GithubWebhookDelivery{}, err
+	}
+
+	return model.ToSvcGithubWebhookDelivery(d), nil
+}
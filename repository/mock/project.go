@@ -33,6 +33,21 @@ func (m *ProjectRepository) ListProjectsForUser(ctx context.Context, userID id.A
 	return args.Get(0).([]svcmodel.Project), args.Error(1)
 }
 
+func (m *ProjectRepository) ListProjectsByGithubRepo(ctx context.Context, repo svcmodel.GithubRepo) ([]svcmodel.Project, error) {
+	args := m.Called(ctx, repo)
+	return args.Get(0).([]svcmodel.Project), args.Error(1)
+}
+
+func (m *ProjectRepository) ListProjectsByGitlabRepo(ctx context.Context, gitlabProjectID int64) ([]svcmodel.Project, error) {
+	args := m.Called(ctx, gitlabProjectID)
+	return args.Get(0).([]svcmodel.Project), args.Error(1)
+}
+
+func (m *ProjectRepository) ListProjectsByBitbucketRepo(ctx context.Context, workspace, repoSlug string) ([]svcmodel.Project, error) {
+	args := m.Called(ctx, workspace, repoSlug)
+	return args.Get(0).([]svcmodel.Project), args.Error(1)
+}
+
 func (m *ProjectRepository) DeleteProject(ctx context.Context, id id.Project) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
@@ -62,6 +77,11 @@ func (m *ProjectRepository) ListEnvironmentsForProject(ctx context.Context, proj
 	return args.Get(0).([]svcmodel.Environment), args.Error(1)
 }
 
+func (m *ProjectRepository) ListReleaseGitTagNamesForProject(ctx context.Context, projectID id.Project) ([]string, error) {
+	args := m.Called(ctx, projectID)
+	return args.Get(0).([]string), args.Error(1)
+}
+
 func (m *ProjectRepository) DeleteEnvironment(ctx context.Context, projectID id.Project, envID id.Environment) error {
 	args := m.Called(ctx, projectID, envID)
 	return args.Error(0)
@@ -6,6 +6,7 @@ import (
 	"release-manager/pkg/id"
 	svcmodel "release-manager/service/model"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -18,6 +19,11 @@ func (m *ReleaseRepository) CreateRelease(ctx context.Context, rls svcmodel.Rele
 	return args.Error(0)
 }
 
+func (m *ReleaseRepository) ImportRelease(ctx context.Context, rls svcmodel.Release) error {
+	args := m.Called(ctx, rls)
+	return args.Error(0)
+}
+
 func (m *ReleaseRepository) ReadRelease(ctx context.Context, releaseID id.Release) (svcmodel.Release, error) {
 	args := m.Called(ctx, releaseID)
 	return args.Get(0).(svcmodel.Release), args.Error(1)
@@ -33,20 +39,73 @@ func (m *ReleaseRepository) DeleteRelease(ctx context.Context, releaseID id.Rele
 	return args.Error(0)
 }
 
-func (m *ReleaseRepository) ListReleasesForProject(ctx context.Context, projectID id.Project) ([]svcmodel.Release, error) {
+func (m *ReleaseRepository) ReadLastPublishedRelease(ctx context.Context, projectID id.Project) (svcmodel.Release, error) {
 	args := m.Called(ctx, projectID)
-	return args.Get(0).([]svcmodel.Release), args.Error(1)
+	return args.Get(0).(svcmodel.Release), args.Error(1)
+}
+
+func (m *ReleaseRepository) ReadPreviousPublishedRelease(ctx context.Context, rls svcmodel.Release) (svcmodel.Release, error) {
+	args := m.Called(ctx, rls)
+	return args.Get(0).(svcmodel.Release), args.Error(1)
+}
+
+func (m *ReleaseRepository) ListReleasesForProject(
+	ctx context.Context,
+	params svcmodel.ListReleasesFilterParams,
+	projectID id.Project,
+) (svcmodel.ReleasePage, error) {
+	args := m.Called(ctx, params, projectID)
+	return args.Get(0).(svcmodel.ReleasePage), args.Error(1)
 }
 
 func (m *ReleaseRepository) UpdateRelease(
 	ctx context.Context,
 	releaseID id.Release,
-	updateFn func(r svcmodel.Release) (svcmodel.Release, error),
+	updateFn func(r svcmodel.Release) (svcmodel.Release, *svcmodel.ReleaseRevision, error),
 ) error {
 	args := m.Called(ctx, releaseID, updateFn)
 	return args.Error(0)
 }
 
+func (m *ReleaseRepository) SearchReleases(ctx context.Context, params svcmodel.SearchReleasesParams, projectIDs []id.Project) ([]svcmodel.ReleaseSearchHit, error) {
+	args := m.Called(ctx, params, projectIDs)
+	return args.Get(0).([]svcmodel.ReleaseSearchHit), args.Error(1)
+}
+
+func (m *ReleaseRepository) ReadReleaseRevision(ctx context.Context, releaseID id.Release, revisionNumber int) (svcmodel.ReleaseRevision, error) {
+	args := m.Called(ctx, releaseID, revisionNumber)
+	return args.Get(0).(svcmodel.ReleaseRevision), args.Error(1)
+}
+
+func (m *ReleaseRepository) ListReleaseRevisions(ctx context.Context, releaseID id.Release) ([]svcmodel.ReleaseRevision, error) {
+	args := m.Called(ctx, releaseID)
+	return args.Get(0).([]svcmodel.ReleaseRevision), args.Error(1)
+}
+
+func (m *ReleaseRepository) CreateGithubWebhookDelivery(ctx context.Context, d svcmodel.GithubWebhookDelivery) error {
+	args := m.Called(ctx, d)
+	return args.Error(0)
+}
+
+func (m *ReleaseRepository) ReadGithubWebhookDelivery(ctx context.Context, deliveryID string) (svcmodel.GithubWebhookDelivery, error) {
+	args := m.Called(ctx, deliveryID)
+	return args.Get(0).(svcmodel.GithubWebhookDelivery), args.Error(1)
+}
+
+func (m *ReleaseRepository) ListGithubWebhookDeliveries(ctx context.Context, params svcmodel.ListGithubWebhookDeliveriesParams) ([]svcmodel.GithubWebhookDelivery, error) {
+	args := m.Called(ctx, params)
+	return args.Get(0).([]svcmodel.GithubWebhookDelivery), args.Error(1)
+}
+
+func (m *ReleaseRepository) UpdateGithubWebhookDelivery(
+	ctx context.Context,
+	deliveryID string,
+	updateFn func(d svcmodel.GithubWebhookDelivery) (svcmodel.GithubWebhookDelivery, error),
+) error {
+	args := m.Called(ctx, deliveryID, updateFn)
+	return args.Error(0)
+}
+
 func (m *ReleaseRepository) CreateDeployment(ctx context.Context, dpl svcmodel.Deployment) error {
 	args := m.Called(ctx, dpl)
 	return args.Error(0)
@@ -62,7 +121,91 @@ func (m *ReleaseRepository) ReadLastDeploymentForRelease(ctx context.Context, re
 	return args.Get(0).(svcmodel.Deployment), args.Error(1)
 }
 
-func (m *ReleaseRepository) DeleteReleaseByGitTag(ctx context.Context, repo svcmodel.GithubRepo, tagName string) error {
-	args := m.Called(ctx, repo, tagName)
+func (m *ReleaseRepository) ReadDeployment(ctx context.Context, projectID id.Project, deploymentID id.Deployment) (svcmodel.Deployment, error) {
+	args := m.Called(ctx, projectID, deploymentID)
+	return args.Get(0).(svcmodel.Deployment), args.Error(1)
+}
+
+func (m *ReleaseRepository) UpdateDeployment(
+	ctx context.Context,
+	projectID id.Project,
+	deploymentID id.Deployment,
+	updateFn func(d svcmodel.Deployment) (svcmodel.Deployment, error),
+) error {
+	args := m.Called(ctx, projectID, deploymentID, updateFn)
+	return args.Error(0)
+}
+
+func (m *ReleaseRepository) DeleteReleaseForProjectByGitTag(ctx context.Context, projectID id.Project, tagName string) error {
+	args := m.Called(ctx, projectID, tagName)
+	return args.Error(0)
+}
+
+func (m *ReleaseRepository) CreateReleaseAttachment(ctx context.Context, releaseID id.Release, a svcmodel.ReleaseAttachment) error {
+	args := m.Called(ctx, releaseID, a)
+	return args.Error(0)
+}
+
+func (m *ReleaseRepository) ReadReleaseAttachment(ctx context.Context, releaseID id.Release, attachmentID uuid.UUID) (svcmodel.ReleaseAttachment, error) {
+	args := m.Called(ctx, releaseID, attachmentID)
+	return args.Get(0).(svcmodel.ReleaseAttachment), args.Error(1)
+}
+
+func (m *ReleaseRepository) UpdateReleaseAttachment(
+	ctx context.Context,
+	releaseID id.Release,
+	attachmentID uuid.UUID,
+	updateFn func(a svcmodel.ReleaseAttachment) (svcmodel.ReleaseAttachment, error),
+) error {
+	args := m.Called(ctx, releaseID, attachmentID, updateFn)
+	return args.Error(0)
+}
+
+func (m *ReleaseRepository) DeleteReleaseAttachment(ctx context.Context, releaseID id.Release, attachmentID uuid.UUID) (svcmodel.ReleaseAttachment, error) {
+	args := m.Called(ctx, releaseID, attachmentID)
+	return args.Get(0).(svcmodel.ReleaseAttachment), args.Error(1)
+}
+
+func (m *ReleaseRepository) CreateReleasePlan(ctx context.Context, p svcmodel.ReleasePlan) error {
+	args := m.Called(ctx, p)
+	return args.Error(0)
+}
+
+func (m *ReleaseRepository) ReadReleasePlan(ctx context.Context, projectID id.Project, planID id.ReleasePlan) (svcmodel.ReleasePlan, error) {
+	args := m.Called(ctx, projectID, planID)
+	return args.Get(0).(svcmodel.ReleasePlan), args.Error(1)
+}
+
+func (m *ReleaseRepository) ListReleasePlansForProject(
+	ctx context.Context,
+	params svcmodel.ListReleasePlansFilterParams,
+	projectID id.Project,
+) ([]svcmodel.ReleasePlan, error) {
+	args := m.Called(ctx, params, projectID)
+	return args.Get(0).([]svcmodel.ReleasePlan), args.Error(1)
+}
+
+func (m *ReleaseRepository) UpdateReleasePlan(
+	ctx context.Context,
+	projectID id.Project,
+	planID id.ReleasePlan,
+	updateFn func(p svcmodel.ReleasePlan) (svcmodel.ReleasePlan, error),
+) error {
+	args := m.Called(ctx, projectID, planID, updateFn)
+	return args.Error(0)
+}
+
+func (m *ReleaseRepository) DeleteReleasePlan(ctx context.Context, projectID id.Project, planID id.ReleasePlan) error {
+	args := m.Called(ctx, projectID, planID)
+	return args.Error(0)
+}
+
+func (m *ReleaseRepository) ConvertReleasePlan(
+	ctx context.Context,
+	projectID id.Project,
+	planID id.ReleasePlan,
+	convertFn func(p svcmodel.ReleasePlan) (svcmodel.ReleasePlan, svcmodel.Release, error),
+) error {
+	args := m.Called(ctx, projectID, planID, convertFn)
 	return args.Error(0)
 }
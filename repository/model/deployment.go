@@ -9,24 +9,35 @@ import (
 )
 
 type Deployment struct {
-	ID               id.Deployment `db:"id"`
-	DeployedByUserID id.AuthUser   `db:"deployed_by"`
-	DeployedAt       time.Time     `db:"deployed_at"`
+	ID                 id.Deployment        `db:"id"`
+	Status             string               `db:"status"`
+	Approvals          []DeploymentApproval `db:"approvals"`
+	DeployedByUserID   id.AuthUser          `db:"deployed_by"`
+	DeployedAt         time.Time            `db:"deployed_at"`
+	GithubDeploymentID *int64               `db:"github_deployment_id"`
 
 	ReleaseID           id.Release  `db:"release_id"`
 	ReleaseProjectID    id.Project  `db:"release_project_id"`
 	ReleaseTitle        string      `db:"release_title"`
 	ReleaseNotes        string      `db:"release_notes"`
+	ReleaseStatus       string      `db:"release_status"`
 	ReleaseAuthorUserID id.AuthUser `db:"release_created_by"`
 	ReleaseCreatedAt    time.Time   `db:"release_created_at"`
 	ReleaseUpdatedAt    time.Time   `db:"release_updated_at"`
 
-	EnvID         id.Environment `db:"env_id"`
-	EnvProjectID  id.Project     `db:"env_project_id"`
-	EnvName       string         `db:"env_name"`
-	EnvServiceURL string         `db:"env_service_url"`
-	EnvCreatedAt  time.Time      `db:"env_created_at"`
-	EnvUpdatedAt  time.Time      `db:"env_updated_at"`
+	EnvID             id.Environment `db:"env_id"`
+	EnvProjectID      id.Project     `db:"env_project_id"`
+	EnvName           string         `db:"env_name"`
+	EnvServiceURL     string         `db:"env_service_url"`
+	EnvApprovalPolicy ApprovalPolicy `db:"env_approval_policy"`
+	EnvCreatedAt      time.Time      `db:"env_created_at"`
+	EnvUpdatedAt      time.Time      `db:"env_updated_at"`
+}
+
+type DeploymentApproval struct {
+	UserID    id.AuthUser `json:"user_id"`
+	Decision  string      `json:"decision"`
+	CreatedAt time.Time   `json:"created_at"`
 }
 
 func ToSvcDeployment(dpl Deployment) (svcmodel.Deployment, error) {
@@ -35,26 +46,40 @@ func ToSvcDeployment(dpl Deployment) (svcmodel.Deployment, error) {
 		return svcmodel.Deployment{}, err
 	}
 
+	approvals := make([]svcmodel.DeploymentApproval, 0, len(dpl.Approvals))
+	for _, a := range dpl.Approvals {
+		approvals = append(approvals, svcmodel.DeploymentApproval{
+			UserID:    a.UserID,
+			Decision:  svcmodel.DeploymentApprovalDecision(a.Decision),
+			CreatedAt: a.CreatedAt,
+		})
+	}
+
 	return svcmodel.Deployment{
-		ID:               dpl.ID,
-		DeployedByUserID: dpl.DeployedByUserID,
-		DeployedAt:       dpl.DeployedAt,
+		ID:                 dpl.ID,
+		Status:             svcmodel.DeploymentStatus(dpl.Status),
+		Approvals:          approvals,
+		DeployedByUserID:   dpl.DeployedByUserID,
+		DeployedAt:         dpl.DeployedAt,
+		GithubDeploymentID: dpl.GithubDeploymentID,
 		Release: svcmodel.Release{
 			ID:           dpl.ReleaseID,
 			ProjectID:    dpl.ReleaseProjectID,
 			ReleaseTitle: dpl.ReleaseTitle,
 			ReleaseNotes: dpl.ReleaseNotes,
+			Status:       svcmodel.ReleaseStatus(dpl.ReleaseStatus),
 			AuthorUserID: dpl.ReleaseAuthorUserID,
 			CreatedAt:    dpl.ReleaseCreatedAt,
 			UpdatedAt:    dpl.ReleaseUpdatedAt,
 		},
 		Environment: svcmodel.Environment{
-			ID:         dpl.EnvID,
-			ProjectID:  dpl.EnvProjectID,
-			Name:       dpl.EnvName,
-			ServiceURL: *envURL,
-			CreatedAt:  dpl.EnvCreatedAt,
-			UpdatedAt:  dpl.EnvUpdatedAt,
+			ID:             dpl.EnvID,
+			ProjectID:      dpl.EnvProjectID,
+			Name:           dpl.EnvName,
+			ServiceURL:     *envURL,
+			ApprovalPolicy: svcmodel.ApprovalPolicy(dpl.EnvApprovalPolicy),
+			CreatedAt:      dpl.EnvCreatedAt,
+			UpdatedAt:      dpl.EnvUpdatedAt,
 		},
 	}, nil
 }
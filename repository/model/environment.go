@@ -9,12 +9,37 @@ import (
 )
 
 type Environment struct {
-	ID         id.Environment `db:"id"`
-	ProjectID  id.Project     `db:"project_id"`
-	Name       string         `db:"name"`
-	ServiceURL string         `db:"service_url"`
-	CreatedAt  time.Time      `db:"created_at"`
-	UpdatedAt  time.Time      `db:"updated_at"`
+	ID             id.Environment `db:"id"`
+	ProjectID      id.Project     `db:"project_id"`
+	Name           string         `db:"name"`
+	ServiceURL     string         `db:"service_url"`
+	ApprovalPolicy ApprovalPolicy `db:"approval_policy"`
+	CreatedAt      time.Time      `db:"created_at"`
+	UpdatedAt      time.Time      `db:"updated_at"`
+}
+
+type ApprovalPolicy struct {
+	RequiredApprovals int                    `json:"required_approvals"`
+	ApproverUserIDs   []id.User              `json:"approver_user_ids"`
+	ApproverRoles     []svcmodel.ProjectRole `json:"approver_roles"`
+}
+
+func ToApprovalPolicy(p svcmodel.ApprovalPolicy) ApprovalPolicy {
+	// Empty slices are stored instead of null values
+	userIDs := p.ApproverUserIDs
+	if userIDs == nil {
+		userIDs = []id.User{}
+	}
+	roles := p.ApproverRoles
+	if roles == nil {
+		roles = []svcmodel.ProjectRole{}
+	}
+
+	return ApprovalPolicy{
+		RequiredApprovals: p.RequiredApprovals,
+		ApproverUserIDs:   userIDs,
+		ApproverRoles:     roles,
+	}
 }
 
 func ToSvcEnvironment(e Environment) (svcmodel.Environment, error) {
@@ -24,12 +49,13 @@ func ToSvcEnvironment(e Environment) (svcmodel.Environment, error) {
 	}
 
 	return svcmodel.Environment{
-		ID:         e.ID,
-		ProjectID:  e.ProjectID,
-		Name:       e.Name,
-		ServiceURL: *u,
-		CreatedAt:  e.CreatedAt,
-		UpdatedAt:  e.UpdatedAt,
+		ID:             e.ID,
+		ProjectID:      e.ProjectID,
+		Name:           e.Name,
+		ServiceURL:     *u,
+		ApprovalPolicy: svcmodel.ApprovalPolicy(e.ApprovalPolicy),
+		CreatedAt:      e.CreatedAt,
+		UpdatedAt:      e.UpdatedAt,
 	}, nil
 }
 
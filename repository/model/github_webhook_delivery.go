@@ -0,0 +1,46 @@
+package model
+
+import (
+	"time"
+
+	svcmodel "release-manager/service/model"
+)
+
+type GithubWebhookDelivery struct {
+	DeliveryID     string     `db:"delivery_id"`
+	Event          string     `db:"event"`
+	RepoFullName   string     `db:"repo_full_name"`
+	Payload        []byte     `db:"payload"`
+	Signature      string     `db:"signature"`
+	SignatureValid bool       `db:"signature_valid"`
+	Status         string     `db:"status"`
+	Error          *string    `db:"error"`
+	ReplayCount    int        `db:"replay_count"`
+	ReceivedAt     time.Time  `db:"received_at"`
+	ProcessedAt    *time.Time `db:"processed_at"`
+}
+
+func ToSvcGithubWebhookDelivery(d GithubWebhookDelivery) svcmodel.GithubWebhookDelivery {
+	return svcmodel.GithubWebhookDelivery{
+		DeliveryID:     d.DeliveryID,
+		Event:          d.Event,
+		RepoFullName:   d.RepoFullName,
+		RawPayload:     d.Payload,
+		Signature:      d.Signature,
+		SignatureValid: d.SignatureValid,
+		Status:         svcmodel.GithubWebhookDeliveryStatus(d.Status),
+		Error:          d.Error,
+		ReplayCount:    d.ReplayCount,
+		ReceivedAt:     d.ReceivedAt,
+		ProcessedAt:    d.ProcessedAt,
+	}
+}
+
+func ToSvcGithubWebhookDeliveries(deliveries []GithubWebhookDelivery) []svcmodel.GithubWebhookDelivery {
+	d := make([]svcmodel.GithubWebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		d = append(d, ToSvcGithubWebhookDelivery(delivery))
+	}
+
+	return d
+}
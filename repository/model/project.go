@@ -17,6 +17,16 @@ type Project struct {
 	ReleaseNotificationConfig ReleaseNotificationConfig `db:"release_notification_config"`
 	GithubOwnerSlug           sql.NullString            `db:"github_owner_slug"`
 	GithubRepoSlug            sql.NullString            `db:"github_repo_slug"`
+	GithubWebhookID           sql.NullInt64             `db:"github_webhook_id"`
+	GithubWebhookSecret       sql.NullString            `db:"github_webhook_secret"`
+	GitlabProjectID           sql.NullInt64             `db:"gitlab_project_id"`
+	GitlabProjectPath         sql.NullString            `db:"gitlab_project_path"`
+	GitlabProjectURL          sql.NullString            `db:"gitlab_project_url"`
+	BitbucketWorkspace        sql.NullString            `db:"bitbucket_workspace"`
+	BitbucketRepoSlug         sql.NullString            `db:"bitbucket_repo_slug"`
+	BitbucketRepoURL          sql.NullString            `db:"bitbucket_repo_url"`
+	VersionTagPrefix          string                    `db:"version_tag_prefix"`
+	ReleaseNotesTemplate      ReleaseNotesTemplate      `db:"release_notes_template"`
 	CreatedAt                 time.Time                 `db:"created_at"`
 	UpdatedAt                 time.Time                 `db:"updated_at"`
 }
@@ -28,6 +38,67 @@ type ReleaseNotificationConfig struct {
 	ShowReleaseNotes   bool   `json:"show_release_notes"`
 	ShowLastDeployment bool   `json:"show_last_deployment"`
 	ShowSourceCode     bool   `json:"show_source_code"`
+	ShowLinkedItems    bool   `json:"show_linked_items"`
+}
+
+type ReleaseNotesTemplate struct {
+	Sections []ReleaseNotesTemplateSection `json:"sections"`
+}
+
+type ReleaseNotesTemplateSection struct {
+	Title    string `json:"title"`
+	Required bool   `json:"required"`
+}
+
+func ToReleaseNotesTemplate(t svcmodel.ReleaseNotesTemplate) ReleaseNotesTemplate {
+	sections := make([]ReleaseNotesTemplateSection, 0, len(t.Sections))
+	for _, s := range t.Sections {
+		sections = append(sections, ReleaseNotesTemplateSection(s))
+	}
+
+	return ReleaseNotesTemplate{
+		Sections: sections,
+	}
+}
+
+func ToSvcReleaseNotesTemplate(t ReleaseNotesTemplate) svcmodel.ReleaseNotesTemplate {
+	sections := make([]svcmodel.ReleaseNotesTemplateSection, 0, len(t.Sections))
+	for _, s := range t.Sections {
+		sections = append(sections, svcmodel.ReleaseNotesTemplateSection(s))
+	}
+
+	return svcmodel.ReleaseNotesTemplate{
+		Sections: sections,
+	}
+}
+
+func ToGithubWebhook(w *svcmodel.GithubRepoWebhook) (webhookID *int64, secret *string) {
+	if w == nil {
+		return nil, nil
+	}
+
+	s := string(w.Secret)
+	return &w.ID, &s
+}
+
+// ToGitlabRepo returns nil values if the GitLab repo is not set, so they are stored as NULL.
+func ToGitlabRepo(r *svcmodel.GitlabRepo) (projectID *int64, path, repoURL *string) {
+	if r == nil {
+		return nil, nil, nil
+	}
+
+	u := r.URL.String()
+	return &r.ProjectID, &r.Path, &u
+}
+
+// ToBitbucketRepo returns nil values if the Bitbucket repo is not set, so they are stored as NULL.
+func ToBitbucketRepo(r *svcmodel.BitbucketRepo) (workspace, repoSlug, repoURL *string) {
+	if r == nil {
+		return nil, nil, nil
+	}
+
+	u := r.URL.String()
+	return &r.Workspace, &r.RepoSlug, &u
 }
 
 type githubRepoURLGeneratorFunc func(ownerSlug, repoSlug string) (url.URL, error)
@@ -47,12 +118,53 @@ func ToSvcProject(p Project, urlGenerator githubRepoURLGeneratorFunc) (svcmodel.
 		}
 	}
 
+	var webhook *svcmodel.GithubRepoWebhook
+	if p.GithubWebhookID.Valid && p.GithubWebhookSecret.Valid {
+		webhook = &svcmodel.GithubRepoWebhook{
+			ID:     p.GithubWebhookID.Int64,
+			Secret: svcmodel.GithubWebhookSecret(p.GithubWebhookSecret.String),
+		}
+	}
+
+	var gitlabRepo *svcmodel.GitlabRepo
+	if p.GitlabProjectID.Valid && p.GitlabProjectPath.Valid && p.GitlabProjectURL.Valid {
+		repoURL, err := url.Parse(p.GitlabProjectURL.String)
+		if err != nil {
+			return svcmodel.Project{}, fmt.Errorf("parsing gitlab project URL: %w", err)
+		}
+
+		gitlabRepo = &svcmodel.GitlabRepo{
+			URL:       *repoURL,
+			ProjectID: p.GitlabProjectID.Int64,
+			Path:      p.GitlabProjectPath.String,
+		}
+	}
+
+	var bitbucketRepo *svcmodel.BitbucketRepo
+	if p.BitbucketWorkspace.Valid && p.BitbucketRepoSlug.Valid && p.BitbucketRepoURL.Valid {
+		repoURL, err := url.Parse(p.BitbucketRepoURL.String)
+		if err != nil {
+			return svcmodel.Project{}, fmt.Errorf("parsing bitbucket repo URL: %w", err)
+		}
+
+		bitbucketRepo = &svcmodel.BitbucketRepo{
+			URL:       *repoURL,
+			Workspace: p.BitbucketWorkspace.String,
+			RepoSlug:  p.BitbucketRepoSlug.String,
+		}
+	}
+
 	return svcmodel.Project{
 		ID:                        p.ID,
 		Name:                      p.Name,
 		SlackChannelID:            p.SlackChannelID,
 		ReleaseNotificationConfig: svcmodel.ReleaseNotificationConfig(p.ReleaseNotificationConfig),
 		GithubRepo:                repo,
+		GithubWebhook:             webhook,
+		GitlabRepo:                gitlabRepo,
+		BitbucketRepo:             bitbucketRepo,
+		VersionTagPrefix:          p.VersionTagPrefix,
+		ReleaseNotesTemplate:      ToSvcReleaseNotesTemplate(p.ReleaseNotesTemplate),
 		CreatedAt:                 p.CreatedAt,
 		UpdatedAt:                 p.UpdatedAt,
 	}, nil
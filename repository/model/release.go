@@ -17,15 +17,51 @@ type Release struct {
 	ProjectID    id.Project  `db:"project_id"`
 	ReleaseTitle string      `db:"release_title"`
 	ReleaseNotes string      `db:"release_notes"`
+	Status       string      `db:"status"`
 	AuthorUserID id.AuthUser `db:"created_by"`
 	GitTagName   string      `db:"git_tag_name"`
+	// Version is parsed from the git tag name when the release is created,
+	// VersionSortKey is used only for sorting and filtering in the database.
+	Version        sql.NullString `db:"version"`
+	VersionSortKey sql.NullString `db:"version_sort_key"`
 	// GithubRepoSlug and GithubOwnerSlug are fetched from the project
 	// and are used to generate the tag URL
-	GithubRepoSlug  sql.NullString      `db:"github_repo_slug"`
-	GithubOwnerSlug sql.NullString      `db:"github_owner_slug"`
-	Attachments     []ReleaseAttachment `db:"attachments"`
-	CreatedAt       time.Time           `db:"created_at"`
-	UpdatedAt       time.Time           `db:"updated_at"`
+	GithubRepoSlug   sql.NullString `db:"github_repo_slug"`
+	GithubOwnerSlug  sql.NullString `db:"github_owner_slug"`
+	GithubDraft      bool           `db:"github_draft"`
+	GithubPrerelease bool           `db:"github_prerelease"`
+	GithubMakeLatest sql.NullString `db:"github_make_latest"`
+	// GitlabProjectURL is fetched from the project and is used to generate the tag URL if the GitLab repo is linked
+	GitlabProjectURL sql.NullString `db:"gitlab_project_url"`
+	// BitbucketRepoURL is fetched from the project and is used to generate the tag URL if the Bitbucket repo is linked
+	BitbucketRepoURL sql.NullString `db:"bitbucket_repo_url"`
+	// ReleaseNotesTemplate is fetched from the project and is used to validate release notes
+	ReleaseNotesTemplate ReleaseNotesTemplate `db:"release_notes_template"`
+	Attachments          []ReleaseAttachment  `db:"attachments"`
+	LinkedItems          *ReleaseLinkedItems  `db:"linked_items"`
+	CreatedAt            time.Time            `db:"created_at"`
+	UpdatedAt            time.Time            `db:"updated_at"`
+}
+
+// ToReleaseVersion returns the version and its sort key to be stored along with the release.
+// Both are nil if the release has no semantic version.
+func ToReleaseVersion(v *svcmodel.Version) (version, sortKey *string) {
+	if v == nil {
+		return nil, nil
+	}
+
+	s, k := v.String(), v.SortKey()
+	return &s, &k
+}
+
+// ToGithubMakeLatest returns nil if make latest is not set, so it is stored as NULL.
+func ToGithubMakeLatest(m svcmodel.GithubMakeLatest) *string {
+	if m == "" {
+		return nil
+	}
+
+	s := string(m)
+	return &s
 }
 
 type gitTagURLGeneratorFunc func(ownerSlug, repoSlug, tag string) (url.URL, error)
@@ -35,7 +71,7 @@ func ToSvcRelease(
 	tagURLGenerator gitTagURLGeneratorFunc,
 	fileURLGenerator fileURLGeneratorFunc,
 ) (svcmodel.Release, error) {
-	tagURL, err := tagURLGenerator(rls.GithubOwnerSlug.String, rls.GithubRepoSlug.String, rls.GitTagName)
+	tagURL, err := toGitTagURL(rls, tagURLGenerator)
 	if err != nil {
 		return svcmodel.Release{}, fmt.Errorf("generating a git tag URL: %w", err)
 	}
@@ -45,22 +81,68 @@ func ToSvcRelease(
 		return svcmodel.Release{}, fmt.Errorf("converting release attachments to service model: %w", err)
 	}
 
+	linkedItems, err := ToSvcReleaseLinkedItems(rls.LinkedItems)
+	if err != nil {
+		return svcmodel.Release{}, fmt.Errorf("converting release linked items to service model: %w", err)
+	}
+
+	var version *svcmodel.Version
+	if rls.Version.Valid {
+		v, err := svcmodel.ParseVersion(rls.Version.String)
+		if err != nil {
+			return svcmodel.Release{}, fmt.Errorf("parsing release version: %w", err)
+		}
+
+		version = &v
+	}
+
 	return svcmodel.Release{
 		ID:           rls.ID,
 		ProjectID:    rls.ProjectID,
 		ReleaseTitle: rls.ReleaseTitle,
 		ReleaseNotes: rls.ReleaseNotes,
+		Status:       svcmodel.ReleaseStatus(rls.Status),
 		Tag: svcmodel.GitTag{
 			Name: rls.GitTagName,
 			URL:  tagURL,
 		},
+		Version:       version,
+		NotesTemplate: ToSvcReleaseNotesTemplate(rls.ReleaseNotesTemplate),
+		GithubOptions: svcmodel.GithubReleaseOptions{
+			Draft:      rls.GithubDraft,
+			Prerelease: rls.GithubPrerelease,
+			MakeLatest: svcmodel.GithubMakeLatest(rls.GithubMakeLatest.String),
+		},
 		AuthorUserID: rls.AuthorUserID,
 		Attachments:  attachments,
+		LinkedItems:  linkedItems,
 		CreatedAt:    rls.CreatedAt,
 		UpdatedAt:    rls.UpdatedAt,
 	}, nil
 }
 
+// toGitTagURL generates the URL of the tag on the provider of the repo linked to the project of the release.
+func toGitTagURL(rls Release, tagURLGenerator gitTagURLGeneratorFunc) (url.URL, error) {
+	switch {
+	case rls.GitlabProjectURL.Valid:
+		repoURL, err := url.Parse(rls.GitlabProjectURL.String)
+		if err != nil {
+			return url.URL{}, fmt.Errorf("parsing gitlab project URL: %w", err)
+		}
+
+		return svcmodel.GitlabRepo{URL: *repoURL}.TagURL(rls.GitTagName), nil
+	case rls.BitbucketRepoURL.Valid:
+		repoURL, err := url.Parse(rls.BitbucketRepoURL.String)
+		if err != nil {
+			return url.URL{}, fmt.Errorf("parsing bitbucket repo URL: %w", err)
+		}
+
+		return svcmodel.BitbucketRepo{URL: *repoURL}.TagURL(rls.GitTagName), nil
+	default:
+		return tagURLGenerator(rls.GithubOwnerSlug.String, rls.GithubRepoSlug.String, rls.GitTagName)
+	}
+}
+
 func ToSvcReleases(
 	releases []Release,
 	tagURLGenerator gitTagURLGeneratorFunc,
@@ -79,16 +161,45 @@ func ToSvcReleases(
 	return r, nil
 }
 
+// ReleaseAttachment is read either directly from the release_attachments table
+// or as a JSON aggregate when reading a release.
 type ReleaseAttachment struct {
-	ID        uuid.UUID `json:"attachment_id"`
-	Name      string    `json:"name"`
-	FilePath  string    `json:"file_path"`
-	CreatedAt time.Time `json:"created_at"`
+	ID   uuid.UUID `json:"attachment_id" db:"attachment_id"`
+	Name string    `json:"name" db:"name"`
+	// FilePath is empty and ExternalURL is set for attachments which are not in the storage
+	FilePath    string    `json:"file_path" db:"file_path"`
+	ExternalURL *string   `json:"external_url" db:"external_url"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+}
+
+// ToReleaseAttachmentLocation returns the file path and the external URL to be stored, only one of them is set.
+func ToReleaseAttachmentLocation(a svcmodel.ReleaseAttachment) (filePath, externalURL *string) {
+	if a.ExternalURL != nil {
+		u := a.ExternalURL.String()
+		return nil, &u
+	}
+
+	return &a.FilePath, nil
 }
 
 type fileURLGeneratorFunc func(filePath string) (url.URL, error)
 
 func ToSvcReleaseAttachment(a ReleaseAttachment, fileURLGenerator fileURLGeneratorFunc) (svcmodel.ReleaseAttachment, error) {
+	if a.ExternalURL != nil {
+		u, err := url.Parse(*a.ExternalURL)
+		if err != nil {
+			return svcmodel.ReleaseAttachment{}, fmt.Errorf("parsing an external URL: %w", err)
+		}
+
+		return svcmodel.ReleaseAttachment{
+			ID:          a.ID,
+			Name:        a.Name,
+			URL:         *u,
+			ExternalURL: u,
+			CreatedAt:   a.CreatedAt,
+		}, nil
+	}
+
 	u, err := fileURLGenerator(a.FilePath)
 	if err != nil {
 		return svcmodel.ReleaseAttachment{}, fmt.Errorf("generating a file URL: %w", err)
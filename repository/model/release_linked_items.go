@@ -0,0 +1,113 @@
+package model
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	svcmodel "release-manager/service/model"
+)
+
+// ReleaseLinkedItems is stored as JSON along with the release, it is NULL until the items are synced.
+type ReleaseLinkedItems struct {
+	PreviousGitTagName string              `json:"previous_git_tag_name"`
+	PullRequests       []LinkedPullRequest `json:"pull_requests"`
+	Issues             []LinkedIssue       `json:"issues"`
+	SyncedAt           time.Time           `json:"synced_at"`
+}
+
+type LinkedPullRequest struct {
+	Number      int       `json:"number"`
+	Title       string    `json:"title"`
+	AuthorLogin string    `json:"author_login"`
+	URL         string    `json:"url"`
+	MergedAt    time.Time `json:"merged_at"`
+}
+
+type LinkedIssue struct {
+	Number      int       `json:"number"`
+	Title       string    `json:"title"`
+	AuthorLogin string    `json:"author_login"`
+	URL         string    `json:"url"`
+	ClosedAt    time.Time `json:"closed_at"`
+}
+
+func ToReleaseLinkedItems(i *svcmodel.ReleaseLinkedItems) *ReleaseLinkedItems {
+	if i == nil {
+		return nil
+	}
+
+	prs := make([]LinkedPullRequest, 0, len(i.PullRequests))
+	for _, pr := range i.PullRequests {
+		prs = append(prs, LinkedPullRequest{
+			Number:      pr.Number,
+			Title:       pr.Title,
+			AuthorLogin: pr.AuthorLogin,
+			URL:         pr.URL.String(),
+			MergedAt:    pr.MergedAt,
+		})
+	}
+
+	issues := make([]LinkedIssue, 0, len(i.Issues))
+	for _, issue := range i.Issues {
+		issues = append(issues, LinkedIssue{
+			Number:      issue.Number,
+			Title:       issue.Title,
+			AuthorLogin: issue.AuthorLogin,
+			URL:         issue.URL.String(),
+			ClosedAt:    issue.ClosedAt,
+		})
+	}
+
+	return &ReleaseLinkedItems{
+		PreviousGitTagName: i.PreviousGitTagName,
+		PullRequests:       prs,
+		Issues:             issues,
+		SyncedAt:           i.SyncedAt,
+	}
+}
+
+func ToSvcReleaseLinkedItems(i *ReleaseLinkedItems) (*svcmodel.ReleaseLinkedItems, error) {
+	if i == nil {
+		return nil, nil
+	}
+
+	prs := make([]svcmodel.GithubPullRequest, 0, len(i.PullRequests))
+	for _, pr := range i.PullRequests {
+		u, err := url.Parse(pr.URL)
+		if err != nil {
+			return nil, fmt.Errorf("parsing pull request URL: %w", err)
+		}
+
+		prs = append(prs, svcmodel.GithubPullRequest{
+			Number:      pr.Number,
+			Title:       pr.Title,
+			AuthorLogin: pr.AuthorLogin,
+			URL:         *u,
+			MergedAt:    pr.MergedAt,
+		})
+	}
+
+	issues := make([]svcmodel.GithubIssue, 0, len(i.Issues))
+	for _, issue := range i.Issues {
+		u, err := url.Parse(issue.URL)
+		if err != nil {
+			return nil, fmt.Errorf("parsing issue URL: %w", err)
+		}
+
+		issues = append(issues, svcmodel.GithubIssue{
+			Number:      issue.Number,
+			Title:       issue.Title,
+			AuthorLogin: issue.AuthorLogin,
+			URL:         *u,
+			ClosedAt:    issue.ClosedAt,
+		})
+	}
+
+	return &svcmodel.ReleaseLinkedItems{
+		PreviousGitTagName: i.PreviousGitTagName,
+		PullRequests:       prs,
+		Issues:             issues,
+		SyncedAt:           i.SyncedAt,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package model
+
+import (
+	"time"
+
+	"release-manager/pkg/id"
+	svcmodel "release-manager/service/model"
+)
+
+type ReleasePlan struct {
+	ID           id.ReleasePlan         `db:"id"`
+	ProjectID    id.Project             `db:"project_id"`
+	Title        string                 `db:"title"`
+	TargetDate   time.Time              `db:"target_date"`
+	OwnerUserID  *id.User               `db:"owner_user_id"`
+	ScopeItems   []ReleasePlanScopeItem `db:"scope_items"`
+	ReleaseID    *id.Release            `db:"release_id"`
+	AuthorUserID id.AuthUser            `db:"created_by"`
+	CreatedAt    time.Time              `db:"created_at"`
+	UpdatedAt    time.Time              `db:"updated_at"`
+}
+
+type ReleasePlanScopeItem struct {
+	Title  string `json:"title"`
+	Status string `json:"status"`
+}
+
+func ToReleasePlanScopeItems(items []svcmodel.ReleasePlanScopeItem) []ReleasePlanScopeItem {
+	scopeItems := make([]ReleasePlanScopeItem, 0, len(items))
+	for _, item := range items {
+		scopeItems = append(scopeItems, ReleasePlanScopeItem{
+			Title:  item.Title,
+			Status: string(item.Status),
+		})
+	}
+
+	return scopeItems
+}
+
+func ToSvcReleasePlan(p ReleasePlan) svcmodel.ReleasePlan {
+	scopeItems := make([]svcmodel.ReleasePlanScopeItem, 0, len(p.ScopeItems))
+	for _, item := range p.ScopeItems {
+		scopeItems = append(scopeItems, svcmodel.ReleasePlanScopeItem{
+			Title:  item.Title,
+			Status: svcmodel.ReleasePlanScopeItemStatus(item.Status),
+		})
+	}
+
+	return svcmodel.ReleasePlan{
+		ID:           p.ID,
+		ProjectID:    p.ProjectID,
+		Title:        p.Title,
+		TargetDate:   p.TargetDate,
+		OwnerUserID:  p.OwnerUserID,
+		ScopeItems:   scopeItems,
+		ReleaseID:    p.ReleaseID,
+		AuthorUserID: p.AuthorUserID,
+		CreatedAt:    p.CreatedAt,
+		UpdatedAt:    p.UpdatedAt,
+	}
+}
+
+func ToSvcReleasePlans(plans []ReleasePlan) []svcmodel.ReleasePlan {
+	p := make([]svcmodel.ReleasePlan, 0, len(plans))
+	for _, plan := range plans {
+		p = append(p, ToSvcReleasePlan(plan))
+	}
+
+	return p
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"time"
+
+	"release-manager/pkg/id"
+	svcmodel "release-manager/service/model"
+
+	"github.com/google/uuid"
+)
+
+type ReleaseRevision struct {
+	ID                 uuid.UUID   `db:"id"`
+	ReleaseID          id.Release  `db:"release_id"`
+	Number             int         `db:"revision_number"`
+	ReleaseTitleBefore string      `db:"release_title_before"`
+	ReleaseNotesBefore string      `db:"release_notes_before"`
+	ReleaseTitleAfter  string      `db:"release_title_after"`
+	ReleaseNotesAfter  string      `db:"release_notes_after"`
+	AuthorUserID       id.AuthUser `db:"created_by"`
+	CreatedAt          time.Time   `db:"created_at"`
+}
+
+func ToSvcReleaseRevision(r ReleaseRevision) svcmodel.ReleaseRevision {
+	return svcmodel.ReleaseRevision{
+		ID:        r.ID,
+		ReleaseID: r.ReleaseID,
+		Number:    r.Number,
+		Before: svcmodel.ReleaseContent{
+			ReleaseTitle: r.ReleaseTitleBefore,
+			ReleaseNotes: r.ReleaseNotesBefore,
+		},
+		After: svcmodel.ReleaseContent{
+			ReleaseTitle: r.ReleaseTitleAfter,
+			ReleaseNotes: r.ReleaseNotesAfter,
+		},
+		AuthorUserID: r.AuthorUserID,
+		CreatedAt:    r.CreatedAt,
+	}
+}
+
+func ToSvcReleaseRevisions(revisions []ReleaseRevision) []svcmodel.ReleaseRevision {
+	r := make([]svcmodel.ReleaseRevision, 0, len(revisions))
+	for _, revision := range revisions {
+		r = append(r, ToSvcReleaseRevision(revision))
+	}
+
+	return r
+}
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+
+	"release-manager/pkg/id"
+	svcmodel "release-manager/service/model"
+)
+
+type ReleaseSearchHit struct {
+	ReleaseID      id.Release `db:"release_id"`
+	ProjectID      id.Project `db:"project_id"`
+	ProjectName    string     `db:"project_name"`
+	ReleaseTitle   string     `db:"release_title"`
+	GitTagName     string     `db:"git_tag_name"`
+	Status         string     `db:"status"`
+	CreatedAt      time.Time  `db:"created_at"`
+	Rank           float64    `db:"rank"`
+	TitleHighlight string     `db:"title_highlight"`
+	NotesSnippet   string     `db:"notes_snippet"`
+}
+
+func ToSvcReleaseSearchHits(hits []ReleaseSearchHit) []svcmodel.ReleaseSearchHit {
+	h := make([]svcmodel.ReleaseSearchHit, 0, len(hits))
+	for _, hit := range hits {
+		h = append(h, svcmodel.ReleaseSearchHit{
+			ReleaseID:      hit.ReleaseID,
+			ProjectID:      hit.ProjectID,
+			ProjectName:    hit.ProjectName,
+			ReleaseTitle:   hit.ReleaseTitle,
+			GitTagName:     hit.GitTagName,
+			Status:         svcmodel.ReleaseStatus(hit.Status),
+			CreatedAt:      hit.CreatedAt,
+			Rank:           hit.Rank,
+			TitleHighlight: svcmodel.ToReleaseSearchHighlightHTML(hit.TitleHighlight),
+			NotesSnippet:   svcmodel.ToReleaseSearchHighlightHTML(hit.NotesSnippet),
+		})
+	}
+	return h
+}
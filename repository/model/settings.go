@@ -12,6 +12,8 @@ const (
 	keyDefaultReleaseMessage = "default_release_message"
 	keySlack                 = "slack"
 	keyGithub                = "github"
+	keyGitlab                = "gitlab"
+	keyBitbucket             = "bitbucket"
 )
 
 // SettingsValue represents a key-value pair for settings in the database table.
@@ -29,6 +31,27 @@ type GithubSettings struct {
 	Enabled       bool                         `json:"enabled"`
 	Token         svcmodel.GithubToken         `json:"token"`
 	WebhookSecret svcmodel.GithubWebhookSecret `json:"webhook_secret"`
+	App           GithubAppSettings            `json:"app"`
+}
+
+type GitlabSettings struct {
+	Enabled       bool                         `json:"enabled"`
+	BaseURL       string                       `json:"base_url"`
+	Token         svcmodel.GitlabToken         `json:"token"`
+	WebhookSecret svcmodel.GitlabWebhookSecret `json:"webhook_secret"`
+}
+
+type BitbucketSettings struct {
+	Enabled       bool                            `json:"enabled"`
+	BaseURL       string                          `json:"base_url"`
+	Token         svcmodel.BitbucketToken         `json:"token"`
+	WebhookSecret svcmodel.BitbucketWebhookSecret `json:"webhook_secret"`
+}
+
+type GithubAppSettings struct {
+	AppID          int64                        `json:"app_id"`
+	InstallationID int64                        `json:"installation_id"`
+	PrivateKey     svcmodel.GithubAppPrivateKey `json:"private_key"`
 }
 
 func ToSettingsValues(s svcmodel.Settings) ([]SettingsValue, error) {
@@ -46,12 +69,22 @@ func ToSettingsValues(s svcmodel.Settings) ([]SettingsValue, error) {
 	if err != nil {
 		return nil, err
 	}
-	github, err := toSettingsValue(keyGithub, GithubSettings(s.Github))
+	github, err := toSettingsValue(keyGithub, toGithubSettings(s.Github))
 	if err != nil {
 		return nil, err
 	}
 
-	return append(sv, orgName, rlsMessage, slack, github), nil
+	gitlab, err := toSettingsValue(keyGitlab, GitlabSettings(s.Gitlab))
+	if err != nil {
+		return nil, err
+	}
+
+	bitbucket, err := toSettingsValue(keyBitbucket, BitbucketSettings(s.Bitbucket))
+	if err != nil {
+		return nil, err
+	}
+
+	return append(sv, orgName, rlsMessage, slack, github, gitlab, bitbucket), nil
 }
 
 func toSettingsValue(key string, v any) (SettingsValue, error) {
@@ -67,6 +100,8 @@ func ToSvcSettings(sv []SettingsValue) (svcmodel.Settings, error) {
 	var s svcmodel.Settings
 	var slackSettings SlackSettings
 	var githubSettings GithubSettings
+	var gitlabSettings GitlabSettings
+	var bitbucketSettings BitbucketSettings
 
 	for _, settingsValue := range sv {
 		switch settingsValue.Key {
@@ -86,13 +121,41 @@ func ToSvcSettings(sv []SettingsValue) (svcmodel.Settings, error) {
 			if err := json.Unmarshal(settingsValue.Value, &githubSettings); err != nil {
 				return svcmodel.Settings{}, err
 			}
+		case keyGitlab:
+			if err := json.Unmarshal(settingsValue.Value, &gitlabSettings); err != nil {
+				return svcmodel.Settings{}, err
+			}
+		case keyBitbucket:
+			if err := json.Unmarshal(settingsValue.Value, &bitbucketSettings); err != nil {
+				return svcmodel.Settings{}, err
+			}
 		default:
 			return svcmodel.Settings{}, fmt.Errorf("unknown key: %s", settingsValue.Key)
 		}
 	}
 
 	s.Slack = svcmodel.SlackSettings(slackSettings)
-	s.Github = svcmodel.GithubSettings(githubSettings)
+	s.Github = toSvcGithubSettings(githubSettings)
+	s.Gitlab = svcmodel.GitlabSettings(gitlabSettings)
+	s.Bitbucket = svcmodel.BitbucketSettings(bitbucketSettings)
 
 	return s, nil
 }
+
+func toGithubSettings(s svcmodel.GithubSettings) GithubSettings {
+	return GithubSettings{
+		Enabled:       s.Enabled,
+		Token:         s.Token,
+		WebhookSecret: s.WebhookSecret,
+		App:           GithubAppSettings(s.App),
+	}
+}
+
+func toSvcGithubSettings(s GithubSettings) svcmodel.GithubSettings {
+	return svcmodel.GithubSettings{
+		Enabled:       s.Enabled,
+		Token:         s.Token,
+		WebhookSecret: s.WebhookSecret,
+		App:           svcmodel.GithubAppSettings(s.App),
+	}
+}
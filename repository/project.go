@@ -19,6 +19,8 @@ const (
 	uniqueEnvironmentNamePerProjectConstraintName = "unique_environment_name_per_project"
 	uniqueInvitationPerProjectConstraintName      = "unique_invitation_per_project"
 	uniqueGithubRepoConstraintName                = "unique_github_repo"
+	uniqueGitlabProjectConstraintName             = "unique_gitlab_project"
+	uniqueBitbucketRepoConstraintName             = "unique_bitbucket_repo"
 )
 
 type ProjectRepository struct {
@@ -41,6 +43,8 @@ func (r *ProjectRepository) CreateProjectWithOwner(ctx context.Context, p svcmod
 			"slackChannelID": p.SlackChannelID,
 			// convert to db model in order to correctly save the struct to json field
 			"releaseNotificationConfig": model.ReleaseNotificationConfig(p.ReleaseNotificationConfig),
+			"versionTagPrefix":          p.VersionTagPrefix,
+			"releaseNotesTemplate":      model.ToReleaseNotesTemplate(p.ReleaseNotesTemplate),
 			"createdAt":                 p.CreatedAt,
 			"updatedAt":                 p.UpdatedAt,
 		}); err != nil {
@@ -66,11 +70,31 @@ func (r *ProjectRepository) ReadProject(ctx context.Context, id id.Project) (svc
 }
 
 func (r *ProjectRepository) ListProjects(ctx context.Context) ([]svcmodel.Project, error) {
-	return r.listProjects(ctx, query.ListProjects, nil)
+	return r.listProjects(ctx, r.dbpool, query.ListProjects, nil)
 }
 
 func (r *ProjectRepository) ListProjectsForUser(ctx context.Context, userID id.AuthUser) ([]svcmodel.Project, error) {
-	return r.listProjects(ctx, query.ListProjectsForUser, pgx.NamedArgs{"userID": userID})
+	return r.listProjects(ctx, r.dbpool, query.ListProjectsForUser, pgx.NamedArgs{"userID": userID})
+}
+
+func (r *ProjectRepository) ListProjectsByGithubRepo(ctx context.Context, repo svcmodel.GithubRepo) ([]svcmodel.Project, error) {
+	return r.listProjects(ctx, r.dbpool, query.ListProjectsByGithubRepo, pgx.NamedArgs{
+		"ownerSlug": repo.OwnerSlug,
+		"repoSlug":  repo.RepoSlug,
+	})
+}
+
+func (r *ProjectRepository) ListProjectsByGitlabRepo(ctx context.Context, gitlabProjectID int64) ([]svcmodel.Project, error) {
+	return r.listProjects(ctx, r.dbpool, query.ListProjectsByGitlabRepo, pgx.NamedArgs{
+		"gitlabProjectID": gitlabProjectID,
+	})
+}
+
+func (r *ProjectRepository) ListProjectsByBitbucketRepo(ctx context.Context, workspace, repoSlug string) ([]svcmodel.Project, error) {
+	return r.listProjects(ctx, r.dbpool, query.ListProjectsByBitbucketRepo, pgx.NamedArgs{
+		"workspace": workspace,
+		"repoSlug":  repoSlug,
+	})
 }
 
 func (r *ProjectRepository) DeleteProject(ctx context.Context, id id.Project) error {
@@ -102,6 +126,13 @@ func (r *ProjectRepository) UpdateProject(
 			return err
 		}
 
+		if err := r.validateVersionTagPrefixOverlap(ctx, tx, p); err != nil {
+			return err
+		}
+
+		webhookID, webhookSecret := model.ToGithubWebhook(p.GithubWebhook)
+		gitlabProjectID, gitlabProjectPath, gitlabProjectURL := model.ToGitlabRepo(p.GitlabRepo)
+		bitbucketWorkspace, bitbucketRepoSlug, bitbucketRepoURL := model.ToBitbucketRepo(p.BitbucketRepo)
 		if _, err := tx.Exec(ctx, query.UpdateProject, pgx.NamedArgs{
 			"id":             p.ID,
 			"name":           p.Name,
@@ -110,11 +141,27 @@ func (r *ProjectRepository) UpdateProject(
 			"releaseNotificationConfig": model.ReleaseNotificationConfig(p.ReleaseNotificationConfig),
 			"githubOwnerSlug":           p.GithubOwnerSlug(),
 			"githubRepoSlug":            p.GithubRepoSlug(),
+			"githubWebhookID":           webhookID,
+			"githubWebhookSecret":       webhookSecret,
+			"gitlabProjectID":           gitlabProjectID,
+			"gitlabProjectPath":         gitlabProjectPath,
+			"gitlabProjectURL":          gitlabProjectURL,
+			"bitbucketWorkspace":        bitbucketWorkspace,
+			"bitbucketRepoSlug":         bitbucketRepoSlug,
+			"bitbucketRepoURL":          bitbucketRepoURL,
+			"versionTagPrefix":          p.VersionTagPrefix,
+			"releaseNotesTemplate":      model.ToReleaseNotesTemplate(p.ReleaseNotesTemplate),
 			"updatedAt":                 p.UpdatedAt,
 		}); err != nil {
 			if helper.IsUniqueConstraintViolation(err, uniqueGithubRepoConstraintName) {
 				return svcerrors.NewProjectGithubRepoAlreadyUsedError().Wrap(err)
 			}
+			if helper.IsUniqueConstraintViolation(err, uniqueGitlabProjectConstraintName) {
+				return svcerrors.NewProjectGitlabRepoAlreadyUsedError().Wrap(err)
+			}
+			if helper.IsUniqueConstraintViolation(err, uniqueBitbucketRepoConstraintName) {
+				return svcerrors.NewProjectBitbucketRepoAlreadyUsedError().Wrap(err)
+			}
 
 			return fmt.Errorf("updating project: %w", err)
 		}
@@ -123,14 +170,56 @@ func (r *ProjectRepository) UpdateProject(
 	})
 }
 
+// validateVersionTagPrefixOverlap checks the version tag prefix of the project against the other projects sharing its git repo.
+// The repo is locked until the transaction ends, so projects linking the same repo concurrently are checked one after another.
+func (r *ProjectRepository) validateVersionTagPrefixOverlap(ctx context.Context, tx pgx.Tx, p svcmodel.Project) error {
+	var (
+		lockKey   string
+		listQuery string
+		args      pgx.NamedArgs
+	)
+	switch {
+	case p.IsGithubRepoSet():
+		lockKey = fmt.Sprintf("github:%s/%s", p.GithubRepo.OwnerSlug, p.GithubRepo.RepoSlug)
+		listQuery = query.ListProjectsByGithubRepo
+		args = pgx.NamedArgs{"ownerSlug": p.GithubRepo.OwnerSlug, "repoSlug": p.GithubRepo.RepoSlug}
+	case p.IsGitlabRepoSet():
+		lockKey = fmt.Sprintf("gitlab:%d", p.GitlabRepo.ProjectID)
+		listQuery = query.ListProjectsByGitlabRepo
+		args = pgx.NamedArgs{"gitlabProjectID": p.GitlabRepo.ProjectID}
+	case p.IsBitbucketRepoSet():
+		lockKey = fmt.Sprintf("bitbucket:%s/%s", p.BitbucketRepo.Workspace, p.BitbucketRepo.RepoSlug)
+		listQuery = query.ListProjectsByBitbucketRepo
+		args = pgx.NamedArgs{"workspace": p.BitbucketRepo.Workspace, "repoSlug": p.BitbucketRepo.RepoSlug}
+	default:
+		return nil
+	}
+
+	if _, err := tx.Exec(ctx, query.LockGitRepo, pgx.NamedArgs{"key": lockKey}); err != nil {
+		return fmt.Errorf("locking git repo: %w", err)
+	}
+
+	projects, err := r.listProjects(ctx, tx, listQuery, args)
+	if err != nil {
+		return fmt.Errorf("listing projects sharing git repo: %w", err)
+	}
+
+	if err := p.ValidateVersionTagPrefixOverlap(projects); err != nil {
+		return svcerrors.NewProjectVersionTagPrefixOverlapError().Wrap(err).WithMessage(err.Error())
+	}
+
+	return nil
+}
+
 func (r *ProjectRepository) CreateEnvironment(ctx context.Context, e svcmodel.Environment) error {
 	if _, err := r.dbpool.Exec(ctx, query.CreateEnvironment, pgx.NamedArgs{
-		"id":         e.ID,
-		"projectID":  e.ProjectID,
-		"name":       e.Name,
-		"serviceURL": e.ServiceURL.String(),
-		"createdAt":  e.CreatedAt,
-		"updatedAt":  e.UpdatedAt,
+		"id":             e.ID,
+		"projectID":      e.ProjectID,
+		"name":           e.Name,
+		"serviceURL":     e.ServiceURL.String(),
+		"approvalPolicy": model.ToApprovalPolicy(e.ApprovalPolicy),
+		"createdAt":      e.CreatedAt,
+		"updatedAt":      e.UpdatedAt,
 	}); err != nil {
 		if helper.IsUniqueConstraintViolation(err, uniqueEnvironmentNamePerProjectConstraintName) {
 			return svcerrors.NewEnvironmentDuplicateNameError().Wrap(err)
@@ -160,6 +249,13 @@ func (r *ProjectRepository) ListEnvironmentsForProject(ctx context.Context, proj
 	return model.ToSvcEnvironments(e)
 }
 
+// ListReleaseGitTagNamesForProject returns names of git tags used by releases of the project.
+func (r *ProjectRepository) ListReleaseGitTagNamesForProject(ctx context.Context, projectID id.Project) ([]string, error) {
+	return helper.ListValues[string](ctx, r.dbpool, query.ListReleaseGitTagNamesForProject, pgx.NamedArgs{
+		"projectID": projectID,
+	})
+}
+
 func (r *ProjectRepository) DeleteEnvironment(ctx context.Context, projectID id.Project, envID id.Environment) error {
 	result, err := r.dbpool.Exec(ctx, query.DeleteEnvironment, pgx.NamedArgs{
 		"envID":     envID,
@@ -197,10 +293,11 @@ func (r *ProjectRepository) UpdateEnvironment(
 		}
 
 		if _, err = tx.Exec(ctx, query.UpdateEnvironment, pgx.NamedArgs{
-			"envID":      env.ID,
-			"name":       env.Name,
-			"serviceURL": env.ServiceURL.String(),
-			"updatedAt":  env.UpdatedAt,
+			"envID":          env.ID,
+			"name":           env.Name,
+			"serviceURL":     env.ServiceURL.String(),
+			"approvalPolicy": model.ToApprovalPolicy(env.ApprovalPolicy),
+			"updatedAt":      env.UpdatedAt,
 		}); err != nil {
 			if helper.IsUniqueConstraintViolation(err, uniqueEnvironmentNamePerProjectConstraintName) {
 				return svcerrors.NewEnvironmentDuplicateNameError().Wrap(err)
@@ -418,8 +515,8 @@ func (r *ProjectRepository) readProject(ctx context.Context, q helper.Querier, q
 	return model.ToSvcProject(p, r.githubURLGenerator.GenerateRepoURL)
 }
 
-func (r *ProjectRepository) listProjects(ctx context.Context, query string, args pgx.NamedArgs) ([]svcmodel.Project, error) {
-	p, err := helper.ListValues[model.Project](ctx, r.dbpool, query, args)
+func (r *ProjectRepository) listProjects(ctx context.Context, q helper.Querier, query string, args pgx.NamedArgs) ([]svcmodel.Project, error) {
+	p, err := helper.ListValues[model.Project](ctx, q, query, args)
 	if err != nil {
 		return nil, err
 	}
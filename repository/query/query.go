@@ -12,14 +12,54 @@ var (
 	ReadRelease string
 	//go:embed scripts/read_release_for_project.sql
 	ReadReleaseForProject string
+	//go:embed scripts/read_last_published_release.sql
+	ReadLastPublishedRelease string
+	//go:embed scripts/read_previous_published_release.sql
+	ReadPreviousPublishedRelease string
 	//go:embed scripts/delete_release.sql
 	DeleteRelease string
-	//go:embed scripts/delete_release_by_git_tag.sql
-	DeleteReleaseByGitTag string
+	//go:embed scripts/delete_release_for_project_by_git_tag.sql
+	DeleteReleaseForProjectByGitTag string
 	//go:embed scripts/list_releases_for_project.sql
 	ListReleasesForProject string
+	//go:embed scripts/list_release_git_tag_names_for_project.sql
+	ListReleaseGitTagNamesForProject string
 	//go:embed scripts/update_release.sql
 	UpdateRelease string
+	//go:embed scripts/create_release_attachment.sql
+	CreateReleaseAttachment string
+	//go:embed scripts/read_release_attachment.sql
+	ReadReleaseAttachment string
+	//go:embed scripts/update_release_attachment.sql
+	UpdateReleaseAttachment string
+	//go:embed scripts/delete_release_attachment.sql
+	DeleteReleaseAttachment string
+	//go:embed scripts/create_release_plan.sql
+	CreateReleasePlan string
+	//go:embed scripts/read_release_plan.sql
+	ReadReleasePlan string
+	//go:embed scripts/list_release_plans_for_project.sql
+	ListReleasePlansForProject string
+	//go:embed scripts/update_release_plan.sql
+	UpdateReleasePlan string
+	//go:embed scripts/delete_release_plan.sql
+	DeleteReleasePlan string
+	//go:embed scripts/search_releases.sql
+	SearchReleases string
+	//go:embed scripts/create_release_revision.sql
+	CreateReleaseRevision string
+	//go:embed scripts/read_release_revision.sql
+	ReadReleaseRevision string
+	//go:embed scripts/list_release_revisions.sql
+	ListReleaseRevisions string
+	//go:embed scripts/create_github_webhook_delivery.sql
+	CreateGithubWebhookDelivery string
+	//go:embed scripts/read_github_webhook_delivery.sql
+	ReadGithubWebhookDelivery string
+	//go:embed scripts/list_github_webhook_deliveries.sql
+	ListGithubWebhookDeliveries string
+	//go:embed scripts/update_github_webhook_delivery.sql
+	UpdateGithubWebhookDelivery string
 
 	//go:embed scripts/read_user.sql
 	ReadUser string
@@ -45,6 +85,14 @@ var (
 	ListProjects string
 	//go:embed scripts/list_projects_for_user.sql
 	ListProjectsForUser string
+	//go:embed scripts/list_projects_by_github_repo.sql
+	ListProjectsByGithubRepo string
+	//go:embed scripts/list_projects_by_gitlab_repo.sql
+	ListProjectsByGitlabRepo string
+	//go:embed scripts/list_projects_by_bitbucket_repo.sql
+	ListProjectsByBitbucketRepo string
+	//go:embed scripts/lock_git_repo.sql
+	LockGitRepo string
 
 	//go:embed scripts/read_invitation_by_hash.sql
 	ReadInvitationByHash string
@@ -91,6 +139,12 @@ var (
 	ListDeploymentsForProject string
 	//go:embed scripts/read_last_deployment_for_release.sql
 	ReadLastDeploymentForRelease string
+	//go:embed scripts/read_deployment.sql
+	ReadDeployment string
+	//go:embed scripts/update_deployment.sql
+	UpdateDeployment string
+	//go:embed scripts/create_deployment_approval.sql
+	CreateDeploymentApproval string
 )
 
 func AppendForUpdate(query string) string {
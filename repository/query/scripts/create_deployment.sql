@@ -1,2 +1,2 @@
-INSERT INTO deployments (id, release_id, environment_id, deployed_by, deployed_at)
-VALUES (@id, @releaseID, @environmentID, @deployedBy, @deployedAt)
+INSERT INTO deployments (id, release_id, environment_id, status, deployed_by, deployed_at, github_deployment_id)
+VALUES (@id, @releaseID, @environmentID, @status, @deployedBy, @deployedAt, @githubDeploymentID)
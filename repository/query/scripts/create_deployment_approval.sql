@@ -0,0 +1,3 @@
+INSERT INTO deployment_approvals (deployment_id, user_id, decision, created_at)
+VALUES (@deploymentID, @userID, @decision, @createdAt)
+ON CONFLICT (deployment_id, user_id) DO NOTHING
@@ -1,2 +1,2 @@
-INSERT INTO environments (id, project_id, name, service_url, created_at, updated_at)
-VALUES (@id, @projectID, @name, @serviceURL, @createdAt, @updatedAt)
+INSERT INTO environments (id, project_id, name, service_url, approval_policy, created_at, updated_at)
+VALUES (@id, @projectID, @name, @serviceURL, @approvalPolicy, @createdAt, @updatedAt)
@@ -0,0 +1,39 @@
+-- A delivery which is received again is processed only if its previous processing failed,
+-- GitHub keeps the delivery ID when a delivery is redelivered. Only a delivery with a valid signature
+-- replaces the stored payload, so a forged request cannot overwrite the payload used for replays.
+INSERT INTO github_webhook_deliveries (
+    delivery_id,
+    event,
+    repo_full_name,
+    payload,
+    signature,
+    signature_valid,
+    status,
+    error,
+    replay_count,
+    received_at,
+    processed_at
+)
+VALUES (
+    @deliveryID,
+    @event,
+    @repoFullName,
+    @payload,
+    @signature,
+    @signatureValid,
+    @status,
+    @error,
+    @replayCount,
+    @receivedAt,
+    @processedAt
+)
+ON CONFLICT (delivery_id) DO UPDATE
+SET
+    payload = EXCLUDED.payload,
+    signature = EXCLUDED.signature,
+    signature_valid = EXCLUDED.signature_valid,
+    status = EXCLUDED.status,
+    error = NULL,
+    replay_count = github_webhook_deliveries.replay_count + 1,
+    processed_at = NULL
+WHERE github_webhook_deliveries.status = 'failed' AND EXCLUDED.signature_valid
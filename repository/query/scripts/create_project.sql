@@ -1,2 +1,2 @@
-INSERT INTO projects (id, name, slack_channel_id, release_notification_config, created_at, updated_at)
-VALUES (@id, @name, @slackChannelID, @releaseNotificationConfig, @createdAt, @updatedAt)
+INSERT INTO projects (id, name, slack_channel_id, release_notification_config, version_tag_prefix, release_notes_template, created_at, updated_at)
+VALUES (@id, @name, @slackChannelID, @releaseNotificationConfig, @versionTagPrefix, @releaseNotesTemplate, @createdAt, @updatedAt)
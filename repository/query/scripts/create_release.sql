@@ -1,2 +1,2 @@
-INSERT INTO releases (id, project_id, release_title, release_notes, git_tag_name, created_by, created_at, updated_at)
-VALUES (@id, @projectID, @releaseTitle, @releaseNotes, @gitTagName, @createdBy, @createdAt, @updatedAt)
+INSERT INTO releases (id, project_id, release_title, release_notes, status, git_tag_name, version, version_sort_key, github_draft, github_prerelease, github_make_latest, created_by, created_at, updated_at)
+VALUES (@id, @projectID, @releaseTitle, @releaseNotes, @status, @gitTagName, @version, @versionSortKey, @githubDraft, @githubPrerelease, @githubMakeLatest, @createdBy, @createdAt, @updatedAt)
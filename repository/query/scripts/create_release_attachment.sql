@@ -0,0 +1,2 @@
+INSERT INTO release_attachments (release_id, attachment_id, name, file_path, external_url, created_at)
+VALUES (@releaseID, @attachmentID, @name, @filePath, @externalURL, @createdAt)
@@ -0,0 +1,2 @@
+INSERT INTO release_plans (id, project_id, title, target_date, owner_user_id, scope_items, release_id, created_by, created_at, updated_at)
+VALUES (@id, @projectID, @title, @targetDate, @ownerUserID, @scopeItems, @releaseID, @createdBy, @createdAt, @updatedAt)
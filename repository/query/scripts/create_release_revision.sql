@@ -0,0 +1,25 @@
+-- Revisions are numbered per release, the release row is locked while it is updated,
+-- so concurrent updates of the same release cannot get the same number.
+INSERT INTO release_revisions (
+    id,
+    release_id,
+    revision_number,
+    release_title_before,
+    release_notes_before,
+    release_title_after,
+    release_notes_after,
+    created_by,
+    created_at
+)
+SELECT
+    @id,
+    @releaseID,
+    COALESCE(MAX(rr.revision_number), 0) + 1,
+    @releaseTitleBefore,
+    @releaseNotesBefore,
+    @releaseTitleAfter,
+    @releaseNotesAfter,
+    @createdBy,
+    @createdAt
+FROM release_revisions rr
+WHERE rr.release_id = @releaseID
@@ -0,0 +1,2 @@
+DELETE FROM release_attachments
+WHERE release_id = @releaseID AND attachment_id = @attachmentID
@@ -0,0 +1,4 @@
+DELETE FROM releases
+WHERE
+    project_id = @projectID AND
+    git_tag_name = @gitTagName
@@ -0,0 +1,2 @@
+DELETE FROM release_plans
+WHERE id = @planID AND project_id = @projectID
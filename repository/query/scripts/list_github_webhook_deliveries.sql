@@ -0,0 +1,7 @@
+SELECT *
+FROM github_webhook_deliveries
+WHERE
+    (@status::text IS NULL OR status = @status) AND
+    (@event::text IS NULL OR event = @event)
+ORDER BY received_at DESC
+LIMIT @limit
@@ -0,0 +1,6 @@
+SELECT *
+FROM projects
+WHERE
+    bitbucket_workspace = @workspace AND
+    bitbucket_repo_slug = @repoSlug
+ORDER BY name
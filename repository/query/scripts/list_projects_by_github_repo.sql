@@ -0,0 +1,6 @@
+SELECT *
+FROM projects
+WHERE
+    github_owner_slug = @ownerSlug AND
+    github_repo_slug = @repoSlug
+ORDER BY name
@@ -0,0 +1,4 @@
+SELECT *
+FROM projects
+WHERE gitlab_project_id = @gitlabProjectID
+ORDER BY name
@@ -0,0 +1,3 @@
+SELECT git_tag_name
+FROM releases
+WHERE project_id = @projectID
@@ -0,0 +1,9 @@
+SELECT *
+FROM release_plans
+WHERE
+    project_id = @projectID AND
+    (@ownerUserID::uuid IS NULL OR owner_user_id = @ownerUserID) AND
+    (@targetDateFrom::date IS NULL OR target_date >= @targetDateFrom) AND
+    (@targetDateTo::date IS NULL OR target_date <= @targetDateTo) AND
+    (@converted::boolean IS NULL OR (release_id IS NOT NULL) = @converted)
+ORDER BY target_date, created_at
@@ -0,0 +1,4 @@
+SELECT *
+FROM release_revisions
+WHERE release_id = @releaseID
+ORDER BY revision_number DESC
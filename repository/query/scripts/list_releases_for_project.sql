@@ -2,22 +2,78 @@ SELECT
     r.*,
     p.github_owner_slug,
     p.github_repo_slug,
+    p.gitlab_project_url,
+    p.bitbucket_repo_url,
+    p.release_notes_template,
     COALESCE(
-        JSON_AGG(
-            JSON_BUILD_OBJECT(
-                'attachment_id', ra.attachment_id,
-                'name', ra.name,
-                'file_path', ra.file_path,
-                'created_at', ra.created_at
-            )
-        ) FILTER (WHERE ra.release_id IS NOT NULL),
+        (
+            SELECT
+                JSON_AGG(
+                    JSON_BUILD_OBJECT(
+                        'attachment_id', ra.attachment_id,
+                        'name', ra.name,
+                        'file_path', ra.file_path,
+                        'external_url', ra.external_url,
+                        'created_at', ra.created_at
+                    )
+                )
+            FROM release_attachments ra
+            WHERE ra.release_id = r.id
+        ),
         '[]'
     ) AS attachments
 FROM releases r
 JOIN projects p
     ON r.project_id = p.id
-LEFT JOIN release_attachments ra
-    ON ra.release_id = r.id
-WHERE r.project_id = @projectID
-GROUP BY r.id, p.github_owner_slug, p.github_repo_slug
-ORDER BY r.created_at DESC
+WHERE
+    r.project_id = @projectID AND
+    (@minVersionSortKey::text IS NULL OR r.version_sort_key >= @minVersionSortKey) AND
+    (@maxVersionSortKey::text IS NULL OR r.version_sort_key <= @maxVersionSortKey) AND
+    (@createdFrom::timestamptz IS NULL OR r.created_at >= @createdFrom) AND
+    (@createdTo::timestamptz IS NULL OR r.created_at <= @createdTo) AND
+    (@authorUserID::uuid IS NULL OR r.created_by = @authorUserID) AND
+    (@gitTagPrefix::text IS NULL OR STARTS_WITH(r.git_tag_name, @gitTagPrefix)) AND
+    (
+        @deployedToEnvironmentID::uuid IS NULL OR
+        EXISTS (
+            SELECT 1
+            FROM deployments d
+            WHERE
+                d.release_id = r.id AND
+                d.environment_id = @deployedToEnvironmentID AND
+                d.status = 'deployed'
+        )
+    ) AND
+    -- Keyset pagination, releases after the cursor in the sort order are listed.
+    -- Releases without a version are placed last in both sort orders when sorted by version.
+    (
+        @cursorReleaseID::uuid IS NULL OR
+        CASE
+            WHEN @sortBy = 'version' AND @cursorVersionSortKey::text IS NOT NULL THEN
+                r.version_sort_key IS NULL OR
+                (@sortOrder = 'desc' AND r.version_sort_key < @cursorVersionSortKey) OR
+                (@sortOrder = 'asc' AND r.version_sort_key > @cursorVersionSortKey) OR
+                (
+                    r.version_sort_key = @cursorVersionSortKey AND (
+                        (@sortOrder = 'desc' AND (r.created_at, r.id) < (@cursorCreatedAt::timestamptz, @cursorReleaseID)) OR
+                        (@sortOrder = 'asc' AND (r.created_at, r.id) > (@cursorCreatedAt::timestamptz, @cursorReleaseID))
+                    )
+                )
+            WHEN @sortBy = 'version' THEN
+                r.version_sort_key IS NULL AND (
+                    (@sortOrder = 'desc' AND (r.created_at, r.id) < (@cursorCreatedAt::timestamptz, @cursorReleaseID)) OR
+                    (@sortOrder = 'asc' AND (r.created_at, r.id) > (@cursorCreatedAt::timestamptz, @cursorReleaseID))
+                )
+            ELSE
+                (@sortOrder = 'desc' AND (r.created_at, r.id) < (@cursorCreatedAt::timestamptz, @cursorReleaseID)) OR
+                (@sortOrder = 'asc' AND (r.created_at, r.id) > (@cursorCreatedAt::timestamptz, @cursorReleaseID))
+        END
+    )
+ORDER BY
+    CASE WHEN @sortBy = 'version' AND @sortOrder = 'desc' THEN r.version_sort_key END DESC NULLS LAST,
+    CASE WHEN @sortBy = 'version' AND @sortOrder = 'asc' THEN r.version_sort_key END ASC NULLS LAST,
+    CASE WHEN @sortOrder = 'desc' THEN r.created_at END DESC,
+    CASE WHEN @sortOrder = 'asc' THEN r.created_at END ASC,
+    CASE WHEN @sortOrder = 'desc' THEN r.id END DESC,
+    CASE WHEN @sortOrder = 'asc' THEN r.id END ASC
+LIMIT @limit
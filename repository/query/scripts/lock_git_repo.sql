@@ -0,0 +1,2 @@
+-- The lock is released when the transaction ends, projects sharing the git repo are updated one at a time.
+SELECT pg_advisory_xact_lock(hashtext(@key))
@@ -0,0 +1,40 @@
+SELECT
+    d.id,
+    d.status,
+    d.deployed_by,
+    d.deployed_at,
+    d.github_deployment_id,
+    r.id AS release_id,
+    r.project_id AS release_project_id,
+    r.release_title,
+    r.release_notes,
+    r.status AS release_status,
+    r.created_by AS release_created_by,
+    r.created_at AS release_created_at,
+    r.updated_at AS release_updated_at,
+    e.id AS env_id,
+    e.project_id AS env_project_id,
+    e.name AS env_name,
+    e.service_url AS env_service_url,
+    e.approval_policy AS env_approval_policy,
+    e.created_at AS env_created_at,
+    e.updated_at AS env_updated_at,
+    COALESCE(
+        (
+            SELECT json_agg(
+                json_build_object('user_id', a.user_id, 'decision', a.decision, 'created_at', a.created_at)
+                ORDER BY a.created_at
+            )
+            FROM deployment_approvals a
+            WHERE a.deployment_id = d.id
+        ),
+        '[]'::json
+    ) AS approvals
+FROM deployments d
+JOIN releases r
+    ON d.release_id = r.id
+JOIN environments e
+    ON d.environment_id = e.id
+WHERE
+    d.id = @deploymentID AND
+    r.project_id = @projectID
@@ -0,0 +1,3 @@
+SELECT *
+FROM github_webhook_deliveries
+WHERE delivery_id = @deliveryID
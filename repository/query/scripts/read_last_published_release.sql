@@ -0,0 +1,36 @@
+-- Reads the published release with the highest version, releases without a version are skipped.
+WITH attachments AS (
+    SELECT
+        ra.release_id,
+        JSON_AGG(
+                JSON_BUILD_OBJECT(
+                        'attachment_id', ra.attachment_id,
+                        'name', ra.name,
+                        'file_path', ra.file_path,
+                        'external_url', ra.external_url,
+                        'created_at', ra.created_at
+                )
+        ) AS attachments
+    FROM release_attachments ra
+    GROUP BY ra.release_id
+)
+SELECT
+    r.*,
+    p.github_owner_slug,
+    p.github_repo_slug,
+    p.gitlab_project_url,
+    p.bitbucket_repo_url,
+    p.release_notes_template,
+    COALESCE(a.attachments, '[]'::json) AS attachments
+FROM releases r
+JOIN projects p
+  ON r.project_id = p.id
+LEFT JOIN attachments a
+  ON a.release_id = r.id
+-- Deprecated and yanked releases were published before, their versions must not be released again.
+WHERE
+    r.project_id = @projectID AND
+    r.status IN ('published', 'deprecated', 'yanked') AND
+    r.version_sort_key IS NOT NULL
+ORDER BY r.version_sort_key DESC
+LIMIT 1
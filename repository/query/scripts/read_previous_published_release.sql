@@ -0,0 +1,43 @@
+-- Reads the published release preceding the given release, i.e. the one with the highest lower version.
+-- If the given release has no version, the last published release created before it is read.
+WITH attachments AS (
+    SELECT
+        ra.release_id,
+        JSON_AGG(
+                JSON_BUILD_OBJECT(
+                        'attachment_id', ra.attachment_id,
+                        'name', ra.name,
+                        'file_path', ra.file_path,
+                        'external_url', ra.external_url,
+                        'created_at', ra.created_at
+                )
+        ) AS attachments
+    FROM release_attachments ra
+    GROUP BY ra.release_id
+)
+SELECT
+    r.*,
+    p.github_owner_slug,
+    p.github_repo_slug,
+    p.gitlab_project_url,
+    p.bitbucket_repo_url,
+    p.release_notes_template,
+    COALESCE(a.attachments, '[]'::json) AS attachments
+FROM releases r
+JOIN projects p
+  ON r.project_id = p.id
+LEFT JOIN attachments a
+  ON a.release_id = r.id
+-- Deprecated and yanked releases were published before, so they can precede the release.
+WHERE
+    r.project_id = @projectID AND
+    r.id <> @releaseID AND
+    r.status IN ('published', 'deprecated', 'yanked') AND
+    (
+        (@versionSortKey::text IS NOT NULL AND r.version_sort_key < @versionSortKey) OR
+        (@versionSortKey::text IS NULL AND r.created_at < @createdAt)
+    )
+ORDER BY
+    CASE WHEN @versionSortKey::text IS NOT NULL THEN r.version_sort_key END DESC NULLS LAST,
+    r.created_at DESC
+LIMIT 1
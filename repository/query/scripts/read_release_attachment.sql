@@ -0,0 +1,10 @@
+SELECT
+    attachment_id,
+    name,
+    COALESCE(file_path, '') AS file_path,
+    external_url,
+    created_at
+FROM release_attachments
+WHERE
+    release_id = @releaseID AND
+    attachment_id = @attachmentID
@@ -12,6 +12,7 @@ WITH attachments AS (
                         'attachment_id', ra.attachment_id,
                         'name', ra.name,
                         'file_path', ra.file_path,
+                        'external_url', ra.external_url,
                         'created_at', ra.created_at
                 )
         ) AS attachments
@@ -22,6 +23,9 @@ SELECT
     r.*,
     p.github_owner_slug,
     p.github_repo_slug,
+    p.gitlab_project_url,
+    p.bitbucket_repo_url,
+    p.release_notes_template,
     COALESCE(a.attachments, '[]'::json) AS attachments
 FROM releases r
 JOIN projects p
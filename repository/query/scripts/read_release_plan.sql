@@ -0,0 +1,3 @@
+SELECT *
+FROM release_plans
+WHERE id = @planID AND project_id = @projectID
@@ -0,0 +1,5 @@
+SELECT *
+FROM release_revisions
+WHERE
+    release_id = @releaseID AND
+    revision_number = @revisionNumber
@@ -0,0 +1,34 @@
+WITH search AS (
+    SELECT websearch_to_tsquery('english', @query) AS query
+)
+SELECT
+    r.id AS release_id,
+    r.project_id,
+    p.name AS project_name,
+    r.release_title,
+    r.git_tag_name,
+    r.status,
+    r.created_at,
+    ts_rank_cd(public.release_search_vector(r.git_tag_name, r.release_title, r.release_notes), search.query) AS rank,
+    -- Matched words are delimited by control characters, the text is escaped as HTML by the service
+    ts_headline(
+        'english',
+        translate(r.release_title, chr(2) || chr(3), ''),
+        search.query,
+        'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', HighlightAll=true'
+    ) AS title_highlight,
+    ts_headline(
+        'english',
+        translate(r.release_notes, chr(2) || chr(3), ''),
+        search.query,
+        'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', MaxWords=35, MinWords=15, MaxFragments=2, FragmentDelimiter=" ... "'
+    ) AS notes_snippet
+FROM releases r
+JOIN projects p
+    ON r.project_id = p.id
+CROSS JOIN search
+WHERE
+    r.project_id = ANY(@projectIDs::uuid[]) AND
+    public.release_search_vector(r.git_tag_name, r.release_title, r.release_notes) @@ search.query
+ORDER BY rank DESC, r.created_at DESC
+LIMIT @limit
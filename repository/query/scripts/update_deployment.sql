@@ -0,0 +1,7 @@
+UPDATE deployments
+SET
+    status = @status,
+    deployed_at = @deployedAt,
+    github_deployment_id = @githubDeploymentID
+WHERE
+    id = @deploymentID
@@ -2,6 +2,7 @@ UPDATE environments
 SET
     name = @name,
     service_url = @serviceURL,
+    approval_policy = @approvalPolicy,
     updated_at = @updatedAt
 WHERE
     id = @envID
@@ -0,0 +1,8 @@
+UPDATE github_webhook_deliveries
+SET
+    status = @status,
+    error = @error,
+    replay_count = @replayCount,
+    processed_at = @processedAt
+WHERE
+    delivery_id = @deliveryID
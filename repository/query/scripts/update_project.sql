@@ -5,5 +5,15 @@ SET
     release_notification_config = @releaseNotificationConfig,
     github_owner_slug = @githubOwnerSlug,
     github_repo_slug = @githubRepoSlug,
+    github_webhook_id = @githubWebhookID,
+    github_webhook_secret = @githubWebhookSecret,
+    gitlab_project_id = @gitlabProjectID,
+    gitlab_project_path = @gitlabProjectPath,
+    gitlab_project_url = @gitlabProjectURL,
+    bitbucket_workspace = @bitbucketWorkspace,
+    bitbucket_repo_slug = @bitbucketRepoSlug,
+    bitbucket_repo_url = @bitbucketRepoURL,
+    version_tag_prefix = @versionTagPrefix,
+    release_notes_template = @releaseNotesTemplate,
     updated_at = @updatedAt
 WHERE id = @id
@@ -2,6 +2,11 @@ UPDATE releases
 SET
     release_title = @releaseTitle,
     release_notes = @releaseNotes,
+    status = @status,
+    github_draft = @githubDraft,
+    github_prerelease = @githubPrerelease,
+    github_make_latest = @githubMakeLatest,
+    linked_items = @linkedItems,
     updated_at = @updatedAt
 WHERE
     id = @releaseID
@@ -0,0 +1,9 @@
+UPDATE release_attachments
+SET
+    name = @name,
+    file_path = @filePath,
+    external_url = @externalURL,
+    created_at = @createdAt
+WHERE
+    release_id = @releaseID AND
+    attachment_id = @attachmentID
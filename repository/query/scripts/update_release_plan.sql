@@ -0,0 +1,10 @@
+UPDATE release_plans
+SET
+    title = @title,
+    target_date = @targetDate,
+    owner_user_id = @ownerUserID,
+    scope_items = @scopeItems,
+    release_id = @releaseID,
+    updated_at = @updatedAt
+WHERE
+    id = @planID
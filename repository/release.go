@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"release-manager/pkg/id"
 	"release-manager/repository/helper"
@@ -11,12 +12,14 @@ import (
 	svcerrors "release-manager/service/errors"
 	svcmodel "release-manager/service/model"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 const (
 	uniqueGitTagPerProjectConstraintName = "unique_git_tag_per_project"
+	uniqueFilePathConstraintName         = "unique_file_path"
 )
 
 type ReleaseRepository struct {
@@ -38,24 +41,24 @@ func NewReleaseRepository(
 }
 
 func (r *ReleaseRepository) CreateRelease(ctx context.Context, rls svcmodel.Release) error {
-	if _, err := r.dbpool.Exec(ctx, query.CreateRelease, pgx.NamedArgs{
-		"id":           rls.ID,
-		"projectID":    rls.ProjectID,
-		"releaseTitle": rls.ReleaseTitle,
-		"releaseNotes": rls.ReleaseNotes,
-		"gitTagName":   rls.Tag.Name,
-		"createdBy":    rls.AuthorUserID,
-		"createdAt":    rls.CreatedAt,
-		"updatedAt":    rls.UpdatedAt,
-	}); err != nil {
-		if helper.IsUniqueConstraintViolation(err, uniqueGitTagPerProjectConstraintName) {
-			return svcerrors.NewReleaseGitTagAlreadyUsedError().Wrap(err)
+	return r.createRelease(ctx, r.dbpool, rls)
+}
+
+// ImportRelease creates the release together with its attachments in a single transaction.
+func (r *ReleaseRepository) ImportRelease(ctx context.Context, rls svcmodel.Release) error {
+	return helper.RunTransaction(ctx, r.dbpool, func(tx pgx.Tx) error {
+		if err := r.createRelease(ctx, tx, rls); err != nil {
+			return fmt.Errorf("creating release: %w", err)
 		}
 
-		return err
-	}
+		for _, a := range rls.Attachments {
+			if err := r.createReleaseAttachment(ctx, tx, rls.ID, a); err != nil {
+				return fmt.Errorf("creating release attachment: %w", err)
+			}
+		}
 
-	return nil
+		return nil
+	})
 }
 
 func (r *ReleaseRepository) ReadRelease(ctx context.Context, releaseID id.Release) (svcmodel.Release, error) {
@@ -71,10 +74,29 @@ func (r *ReleaseRepository) ReadReleaseForProject(ctx context.Context, projectID
 	})
 }
 
+// ReadLastPublishedRelease reads the published release with the highest version.
+func (r *ReleaseRepository) ReadLastPublishedRelease(ctx context.Context, projectID id.Project) (svcmodel.Release, error) {
+	return r.readRelease(ctx, r.dbpool, query.ReadLastPublishedRelease, pgx.NamedArgs{
+		"projectID": projectID,
+	})
+}
+
+func (r *ReleaseRepository) ReadPreviousPublishedRelease(ctx context.Context, rls svcmodel.Release) (svcmodel.Release, error) {
+	_, versionSortKey := model.ToReleaseVersion(rls.Version)
+
+	return r.readRelease(ctx, r.dbpool, query.ReadPreviousPublishedRelease, pgx.NamedArgs{
+		"projectID":      rls.ProjectID,
+		"releaseID":      rls.ID,
+		"versionSortKey": versionSortKey,
+		"createdAt":      rls.CreatedAt,
+	})
+}
+
+// UpdateRelease updates the release, the revision returned by updateFn (if any) is stored in the same transaction.
 func (r *ReleaseRepository) UpdateRelease(
 	ctx context.Context,
 	releaseID id.Release,
-	updateFn func(r svcmodel.Release) (svcmodel.Release, error),
+	updateFn func(r svcmodel.Release) (svcmodel.Release, *svcmodel.ReleaseRevision, error),
 ) error {
 	return helper.RunTransaction(ctx, r.dbpool, func(tx pgx.Tx) error {
 		rls, err := r.readRelease(ctx, tx, query.AppendForUpdate(query.ReadRelease), pgx.NamedArgs{
@@ -84,28 +106,38 @@ func (r *ReleaseRepository) UpdateRelease(
 			return fmt.Errorf("reading release: %w", err)
 		}
 
-		rls, err = updateFn(rls)
+		rls, rev, err := updateFn(rls)
 		if err != nil {
 			return err
 		}
 
 		if _, err = tx.Exec(ctx, query.UpdateRelease, pgx.NamedArgs{
-			"releaseID":    rls.ID,
-			"releaseTitle": rls.ReleaseTitle,
-			"releaseNotes": rls.ReleaseNotes,
-			"updatedAt":    rls.UpdatedAt,
+			"releaseID":        rls.ID,
+			"releaseTitle":     rls.ReleaseTitle,
+			"releaseNotes":     rls.ReleaseNotes,
+			"status":           rls.Status,
+			"githubDraft":      rls.GithubOptions.Draft,
+			"githubPrerelease": rls.GithubOptions.Prerelease,
+			"githubMakeLatest": model.ToGithubMakeLatest(rls.GithubOptions.MakeLatest),
+			"linkedItems":      model.ToReleaseLinkedItems(rls.LinkedItems),
+			"updatedAt":        rls.UpdatedAt,
 		}); err != nil {
 			return fmt.Errorf("updating release: %w", err)
 		}
 
+		if rev != nil {
+			if err := r.createReleaseRevision(ctx, tx, *rev); err != nil {
+				return fmt.Errorf("creating release revision: %w", err)
+			}
+		}
+
 		return nil
 	})
 }
 
-func (r *ReleaseRepository) DeleteReleaseByGitTag(ctx context.Context, repo svcmodel.GithubRepo, tagName string) error {
-	return r.deleteRelease(ctx, r.dbpool, query.DeleteReleaseByGitTag, pgx.NamedArgs{
-		"ownerSlug":  repo.OwnerSlug,
-		"repoSlug":   repo.RepoSlug,
+func (r *ReleaseRepository) DeleteReleaseForProjectByGitTag(ctx context.Context, projectID id.Project, tagName string) error {
+	return r.deleteRelease(ctx, r.dbpool, query.DeleteReleaseForProjectByGitTag, pgx.NamedArgs{
+		"projectID":  projectID,
 		"gitTagName": tagName,
 	})
 }
@@ -116,24 +148,87 @@ func (r *ReleaseRepository) DeleteRelease(ctx context.Context, releaseID id.Rele
 	})
 }
 
-func (r *ReleaseRepository) ListReleasesForProject(ctx context.Context, projectID id.Project) ([]svcmodel.Release, error) {
+func (r *ReleaseRepository) ListReleasesForProject(
+	ctx context.Context,
+	params svcmodel.ListReleasesFilterParams,
+	projectID id.Project,
+) (svcmodel.ReleasePage, error) {
+	_, minVersionSortKey := model.ToReleaseVersion(params.MinVersion)
+	_, maxVersionSortKey := model.ToReleaseVersion(params.MaxVersion)
+
+	// One release more than the limit is read to find out if there is a next page
+	var limit *int
+	if params.Limit != nil {
+		l := *params.Limit + 1
+		limit = &l
+	}
+
+	var (
+		cursorReleaseID      *id.Release
+		cursorCreatedAt      *time.Time
+		cursorVersionSortKey *string
+	)
+	if params.Cursor != nil {
+		cursorReleaseID = &params.Cursor.ReleaseID
+		cursorCreatedAt = &params.Cursor.CreatedAt
+		_, cursorVersionSortKey = model.ToReleaseVersion(params.Cursor.Version)
+	}
+
+	// Filter params and the cursor are optional and can be nil
 	releases, err := helper.ListValues[model.Release](ctx, r.dbpool, query.ListReleasesForProject, pgx.NamedArgs{
-		"projectID": projectID,
+		"projectID":               projectID,
+		"sortBy":                  params.SortByOrDefault(),
+		"sortOrder":               params.SortOrderOrDefault(),
+		"minVersionSortKey":       minVersionSortKey,
+		"maxVersionSortKey":       maxVersionSortKey,
+		"createdFrom":             params.CreatedFrom,
+		"createdTo":               params.CreatedTo,
+		"authorUserID":            params.AuthorUserID,
+		"gitTagPrefix":            params.GitTagPrefix,
+		"deployedToEnvironmentID": params.DeployedToEnvironmentID,
+		"cursorReleaseID":         cursorReleaseID,
+		"cursorCreatedAt":         cursorCreatedAt,
+		"cursorVersionSortKey":    cursorVersionSortKey,
+		"limit":                   limit,
+	})
+	if err != nil {
+		return svcmodel.ReleasePage{}, err
+	}
+
+	rls, err := model.ToSvcReleases(releases, r.githubURLGenerator.GenerateGitTagURL, r.fileURLGenerator.GenerateFileURL)
+	if err != nil {
+		return svcmodel.ReleasePage{}, err
+	}
+
+	return svcmodel.NewReleasePage(rls, params), nil
+}
+
+func (r *ReleaseRepository) SearchReleases(
+	ctx context.Context,
+	params svcmodel.SearchReleasesParams,
+	projectIDs []id.Project,
+) ([]svcmodel.ReleaseSearchHit, error) {
+	hits, err := helper.ListValues[model.ReleaseSearchHit](ctx, r.dbpool, query.SearchReleases, pgx.NamedArgs{
+		"query":      params.Query,
+		"projectIDs": projectIDs,
+		"limit":      params.Limit,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return model.ToSvcReleases(releases, r.githubURLGenerator.GenerateGitTagURL, r.fileURLGenerator.GenerateFileURL)
+	return model.ToSvcReleaseSearchHits(hits), nil
 }
 
 func (r *ReleaseRepository) CreateDeployment(ctx context.Context, dpl svcmodel.Deployment) error {
 	if _, err := r.dbpool.Exec(ctx, query.CreateDeployment, pgx.NamedArgs{
-		"id":            dpl.ID,
-		"releaseID":     dpl.Release.ID,
-		"environmentID": dpl.Environment.ID,
-		"deployedBy":    dpl.DeployedByUserID,
-		"deployedAt":    dpl.DeployedAt,
+		"id":                 dpl.ID,
+		"releaseID":          dpl.Release.ID,
+		"environmentID":      dpl.Environment.ID,
+		"status":             dpl.Status,
+		"deployedBy":         dpl.DeployedByUserID,
+		"deployedAt":         dpl.DeployedAt,
+		"githubDeploymentID": dpl.GithubDeploymentID,
 	}); err != nil {
 		return err
 	}
@@ -143,15 +238,20 @@ func (r *ReleaseRepository) CreateDeployment(ctx context.Context, dpl svcmodel.D
 
 func (r *ReleaseRepository) ListDeploymentsForProject(ctx context.Context, params svcmodel.ListDeploymentsFilterParams, projectID id.Project) ([]svcmodel.Deployment, error) {
 	listQuery := query.ListDeploymentsForProject
+	status := params.Status
 	if params.LatestOnly != nil && *params.LatestOnly {
 		listQuery = query.AppendLimit(listQuery, 1)
+		// Pending and rejected deployments were never deployed, so they cannot be the latest deployment
+		deployed := svcmodel.DeploymentStatusDeployed
+		status = &deployed
 	}
 
-	// Release and Environment IDs are filter params that are optional and can be nil
+	// Release and Environment IDs and status are filter params that are optional and can be nil
 	dpls, err := helper.ListValues[model.Deployment](ctx, r.dbpool, listQuery, pgx.NamedArgs{
 		"projectID": projectID,
 		"releaseID": params.ReleaseID,
 		"envID":     params.EnvironmentID,
+		"status":    status,
 	})
 	if err != nil {
 		return nil, err
@@ -175,6 +275,169 @@ func (r *ReleaseRepository) ReadLastDeploymentForRelease(ctx context.Context, re
 	return model.ToSvcDeployment(dpl)
 }
 
+func (r *ReleaseRepository) ReadDeployment(ctx context.Context, projectID id.Project, deploymentID id.Deployment) (svcmodel.Deployment, error) {
+	return r.readDeployment(ctx, r.dbpool, query.ReadDeployment, pgx.NamedArgs{
+		"projectID":    projectID,
+		"deploymentID": deploymentID,
+	})
+}
+
+func (r *ReleaseRepository) UpdateDeployment(
+	ctx context.Context,
+	projectID id.Project,
+	deploymentID id.Deployment,
+	updateFn func(d svcmodel.Deployment) (svcmodel.Deployment, error),
+) error {
+	return helper.RunTransaction(ctx, r.dbpool, func(tx pgx.Tx) error {
+		dpl, err := r.readDeployment(ctx, tx, query.AppendForUpdate(query.ReadDeployment), pgx.NamedArgs{
+			"projectID":    projectID,
+			"deploymentID": deploymentID,
+		})
+		if err != nil {
+			return fmt.Errorf("reading deployment: %w", err)
+		}
+
+		dpl, err = updateFn(dpl)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, query.UpdateDeployment, pgx.NamedArgs{
+			"deploymentID":       dpl.ID,
+			"status":             dpl.Status,
+			"deployedAt":         dpl.DeployedAt,
+			"githubDeploymentID": dpl.GithubDeploymentID,
+		}); err != nil {
+			return fmt.Errorf("updating deployment: %w", err)
+		}
+
+		// Approvals are only ever added, existing ones are skipped by the query
+		for _, a := range dpl.Approvals {
+			if _, err := tx.Exec(ctx, query.CreateDeploymentApproval, pgx.NamedArgs{
+				"deploymentID": dpl.ID,
+				"userID":       a.UserID,
+				"decision":     a.Decision,
+				"createdAt":    a.CreatedAt,
+			}); err != nil {
+				return fmt.Errorf("creating deployment approval: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *ReleaseRepository) CreateReleaseAttachment(ctx context.Context, releaseID id.Release, a svcmodel.ReleaseAttachment) error {
+	return r.createReleaseAttachment(ctx, r.dbpool, releaseID, a)
+}
+
+func (r *ReleaseRepository) ReadReleaseAttachment(ctx context.Context, releaseID id.Release, attachmentID uuid.UUID) (svcmodel.ReleaseAttachment, error) {
+	return r.readReleaseAttachment(ctx, r.dbpool, query.ReadReleaseAttachment, pgx.NamedArgs{
+		"releaseID":    releaseID,
+		"attachmentID": attachmentID,
+	})
+}
+
+func (r *ReleaseRepository) UpdateReleaseAttachment(
+	ctx context.Context,
+	releaseID id.Release,
+	attachmentID uuid.UUID,
+	updateFn func(a svcmodel.ReleaseAttachment) (svcmodel.ReleaseAttachment, error),
+) error {
+	return helper.RunTransaction(ctx, r.dbpool, func(tx pgx.Tx) error {
+		a, err := r.readReleaseAttachment(ctx, tx, query.AppendForUpdate(query.ReadReleaseAttachment), pgx.NamedArgs{
+			"releaseID":    releaseID,
+			"attachmentID": attachmentID,
+		})
+		if err != nil {
+			return fmt.Errorf("reading release attachment: %w", err)
+		}
+
+		a, err = updateFn(a)
+		if err != nil {
+			return err
+		}
+
+		filePath, externalURL := model.ToReleaseAttachmentLocation(a)
+		if _, err = tx.Exec(ctx, query.UpdateReleaseAttachment, pgx.NamedArgs{
+			"releaseID":    releaseID,
+			"attachmentID": a.ID,
+			"name":         a.Name,
+			"filePath":     filePath,
+			"externalURL":  externalURL,
+			"createdAt":    a.CreatedAt,
+		}); err != nil {
+			return fmt.Errorf("updating release attachment: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// DeleteReleaseAttachment deletes the attachment and returns it, so its file can be deleted after the commit.
+func (r *ReleaseRepository) DeleteReleaseAttachment(
+	ctx context.Context,
+	releaseID id.Release,
+	attachmentID uuid.UUID,
+) (svcmodel.ReleaseAttachment, error) {
+	var a svcmodel.ReleaseAttachment
+	err := helper.RunTransaction(ctx, r.dbpool, func(tx pgx.Tx) error {
+		var err error
+		a, err = r.readReleaseAttachment(ctx, tx, query.AppendForUpdate(query.ReadReleaseAttachment), pgx.NamedArgs{
+			"releaseID":    releaseID,
+			"attachmentID": attachmentID,
+		})
+		if err != nil {
+			return fmt.Errorf("reading release attachment: %w", err)
+		}
+
+		if _, err := tx.Exec(ctx, query.DeleteReleaseAttachment, pgx.NamedArgs{
+			"releaseID":    releaseID,
+			"attachmentID": attachmentID,
+		}); err != nil {
+			return fmt.Errorf("deleting release attachment: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return svcmodel.ReleaseAttachment{}, err
+	}
+
+	return a, nil
+}
+
+func (r *ReleaseRepository) readDeployment(ctx context.Context, q helper.Querier, query string, args pgx.NamedArgs) (svcmodel.Deployment, error) {
+	dpl, err := helper.ReadValue[model.Deployment](ctx, q, query, args)
+	if err != nil {
+		if helper.IsNotFound(err) {
+			return svcmodel.Deployment{}, svcerrors.NewDeploymentNotFoundError().Wrap(err)
+		}
+
+		return svcmodel.Deployment{}, err
+	}
+
+	return model.ToSvcDeployment(dpl)
+}
+
+func (r *ReleaseRepository) readReleaseAttachment(
+	ctx context.Context,
+	q helper.Querier,
+	query string,
+	args pgx.NamedArgs,
+) (svcmodel.ReleaseAttachment, error) {
+	a, err := helper.ReadValue[model.ReleaseAttachment](ctx, q, query, args)
+	if err != nil {
+		if helper.IsNotFound(err) {
+			return svcmodel.ReleaseAttachment{}, svcerrors.NewReleaseAttachmentNotFoundError().Wrap(err)
+		}
+
+		return svcmodel.ReleaseAttachment{}, err
+	}
+
+	return model.ToSvcReleaseAttachment(a, r.fileURLGenerator.GenerateFileURL)
+}
+
 func (r *ReleaseRepository) readRelease(ctx context.Context, q helper.Querier, query string, args pgx.NamedArgs) (svcmodel.Release, error) {
 	rls, err := helper.ReadValue[model.Release](ctx, q, query, args)
 	if err != nil {
@@ -188,6 +451,66 @@ func (r *ReleaseRepository) readRelease(ctx context.Context, q helper.Querier, q
 	return model.ToSvcRelease(rls, r.githubURLGenerator.GenerateGitTagURL, r.fileURLGenerator.GenerateFileURL)
 }
 
+func (r *ReleaseRepository) createReleaseAttachment(
+	ctx context.Context,
+	e helper.ExecExecutor,
+	releaseID id.Release,
+	a svcmodel.ReleaseAttachment,
+) error {
+	filePath, externalURL := model.ToReleaseAttachmentLocation(a)
+	if _, err := e.Exec(ctx, query.CreateReleaseAttachment, pgx.NamedArgs{
+		"releaseID":    releaseID,
+		"attachmentID": a.ID,
+		"name":         a.Name,
+		"filePath":     filePath,
+		"externalURL":  externalURL,
+		"createdAt":    a.CreatedAt,
+	}); err != nil {
+		if helper.IsUniqueConstraintViolation(err, uniqueFilePathConstraintName) {
+			return svcerrors.NewReleaseAttachmentInvalidError().Wrap(err).WithMessage("Attachment file path is already used")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (r *ReleaseRepository) createRelease(ctx context.Context, e helper.ExecExecutor, rls svcmodel.Release) error {
+	version, versionSortKey := model.ToReleaseVersion(rls.Version)
+
+	// Releases created by GitHub webhooks have no author
+	var createdBy *id.AuthUser
+	if !rls.AuthorUserID.IsNil() {
+		createdBy = &rls.AuthorUserID
+	}
+
+	if _, err := e.Exec(ctx, query.CreateRelease, pgx.NamedArgs{
+		"id":               rls.ID,
+		"projectID":        rls.ProjectID,
+		"releaseTitle":     rls.ReleaseTitle,
+		"releaseNotes":     rls.ReleaseNotes,
+		"status":           rls.Status,
+		"gitTagName":       rls.Tag.Name,
+		"version":          version,
+		"versionSortKey":   versionSortKey,
+		"githubDraft":      rls.GithubOptions.Draft,
+		"githubPrerelease": rls.GithubOptions.Prerelease,
+		"githubMakeLatest": model.ToGithubMakeLatest(rls.GithubOptions.MakeLatest),
+		"createdBy":        createdBy,
+		"createdAt":        rls.CreatedAt,
+		"updatedAt":        rls.UpdatedAt,
+	}); err != nil {
+		if helper.IsUniqueConstraintViolation(err, uniqueGitTagPerProjectConstraintName) {
+			return svcerrors.NewReleaseGitTagAlreadyUsedError().Wrap(err)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
 func (r *ReleaseRepository) deleteRelease(ctx context.Context, e helper.ExecExecutor, query string, args pgx.NamedArgs) error {
 	result, err := e.Exec(ctx, query, args)
 	if err != nil {
@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"release-manager/pkg/id"
+	"release-manager/repository/helper"
+	"release-manager/repository/model"
+	"release-manager/repository/query"
+	svcerrors "release-manager/service/errors"
+	svcmodel "release-manager/service/model"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *ReleaseRepository) CreateReleasePlan(ctx context.Context, p svcmodel.ReleasePlan) error {
+	if _, err := r.dbpool.Exec(ctx, query.CreateReleasePlan, pgx.NamedArgs{
+		"id":          p.ID,
+		"projectID":   p.ProjectID,
+		"title":       p.Title,
+		"targetDate":  p.TargetDate,
+		"ownerUserID": p.OwnerUserID,
+		"scopeItems":  model.ToReleasePlanScopeItems(p.ScopeItems),
+		"releaseID":   p.ReleaseID,
+		"createdBy":   p.AuthorUserID,
+		"createdAt":   p.CreatedAt,
+		"updatedAt":   p.UpdatedAt,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *ReleaseRepository) ReadReleasePlan(ctx context.Context, projectID id.Project, planID id.ReleasePlan) (svcmodel.ReleasePlan, error) {
+	return r.readReleasePlan(ctx, r.dbpool, query.ReadReleasePlan, pgx.NamedArgs{
+		"projectID": projectID,
+		"planID":    planID,
+	})
+}
+
+func (r *ReleaseRepository) ListReleasePlansForProject(
+	ctx context.Context,
+	params svcmodel.ListReleasePlansFilterParams,
+	projectID id.Project,
+) ([]svcmodel.ReleasePlan, error) {
+	// All filter params are optional and can be nil
+	plans, err := helper.ListValues[model.ReleasePlan](ctx, r.dbpool, query.ListReleasePlansForProject, pgx.NamedArgs{
+		"projectID":      projectID,
+		"ownerUserID":    params.OwnerUserID,
+		"targetDateFrom": params.TargetDateFrom,
+		"targetDateTo":   params.TargetDateTo,
+		"converted":      params.Converted,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ToSvcReleasePlans(plans), nil
+}
+
+func (r *ReleaseRepository) UpdateReleasePlan(
+	ctx context.Context,
+	projectID id.Project,
+	planID id.ReleasePlan,
+	updateFn func(p svcmodel.ReleasePlan) (svcmodel.ReleasePlan, error),
+) error {
+	return helper.RunTransaction(ctx, r.dbpool, func(tx pgx.Tx) error {
+		p, err := r.readReleasePlan(ctx, tx, query.AppendForUpdate(query.ReadReleasePlan), pgx.NamedArgs{
+			"projectID": projectID,
+			"planID":    planID,
+		})
+		if err != nil {
+			return fmt.Errorf("reading release plan: %w", err)
+		}
+
+		p, err = updateFn(p)
+		if err != nil {
+			return err
+		}
+
+		return r.updateReleasePlan(ctx, tx, p)
+	})
+}
+
+func (r *ReleaseRepository) DeleteReleasePlan(ctx context.Context, projectID id.Project, planID id.ReleasePlan) error {
+	result, err := r.dbpool.Exec(ctx, query.DeleteReleasePlan, pgx.NamedArgs{
+		"projectID": projectID,
+		"planID":    planID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return svcerrors.NewReleasePlanNotFoundError()
+	}
+
+	return nil
+}
+
+func (r *ReleaseRepository) ConvertReleasePlan(
+	ctx context.Context,
+	projectID id.Project,
+	planID id.ReleasePlan,
+	convertFn func(p svcmodel.ReleasePlan) (svcmodel.ReleasePlan, svcmodel.Release, error),
+) error {
+	return helper.RunTransaction(ctx, r.dbpool, func(tx pgx.Tx) error {
+		p, err := r.readReleasePlan(ctx, tx, query.AppendForUpdate(query.ReadReleasePlan), pgx.NamedArgs{
+			"projectID": projectID,
+			"planID":    planID,
+		})
+		if err != nil {
+			return fmt.Errorf("reading release plan: %w", err)
+		}
+
+		p, rls, err := convertFn(p)
+		if err != nil {
+			return err
+		}
+
+		if err := r.createRelease(ctx, tx, rls); err != nil {
+			return fmt.Errorf("creating release: %w", err)
+		}
+
+		return r.updateReleasePlan(ctx, tx, p)
+	})
+}
+
+func (r *ReleaseRepository) updateReleasePlan(ctx context.Context, e helper.ExecExecutor, p svcmodel.ReleasePlan) error {
+	if _, err := e.Exec(ctx, query.UpdateReleasePlan, pgx.NamedArgs{
+		"planID":      p.ID,
+		"title":       p.Title,
+		"targetDate":  p.TargetDate,
+		"ownerUserID": p.OwnerUserID,
+		"scopeItems":  model.ToReleasePlanScopeItems(p.ScopeItems),
+		"releaseID":   p.ReleaseID,
+		"updatedAt":   p.UpdatedAt,
+	}); err != nil {
+		return fmt.Errorf("updating release plan: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ReleaseRepository) readReleasePlan(ctx context.Context, q helper.Querier, query string, args pgx.NamedArgs) (svcmodel.ReleasePlan, error) {
+	p, err := helper.ReadValue[model.ReleasePlan](ctx, q, query, args)
+	if err != nil {
+		if helper.IsNotFound(err) {
+			return svcmodel.ReleasePlan{}, svcerrors.NewReleasePlanNotFoundError().Wrap(err)
+		}
+
+		return svcmodel.ReleasePlan{}, err
+	}
+
+	return model.ToSvcReleasePlan(p), nil
+}
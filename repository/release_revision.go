@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+
+	"release-manager/pkg/id"
+	"release-manager/repository/helper"
+	"release-manager/repository/model"
+	"release-manager/repository/query"
+	svcerrors "release-manager/service/errors"
+	svcmodel "release-manager/service/model"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *ReleaseRepository) ReadReleaseRevision(ctx context.Context, releaseID id.Release, revisionNumber int) (svcmodel.ReleaseRevision, error) {
+	rev, err := helper.ReadValue[model.ReleaseRevision](ctx, r.dbpool, query.ReadReleaseRevision, pgx.NamedArgs{
+		"releaseID":      releaseID,
+		"revisionNumber": revisionNumber,
+	})
+	if err != nil {
+		if helper.IsNotFound(err) {
+			return svcmodel.ReleaseRevision{}, svcerrors.NewReleaseRevisionNotFoundError().Wrap(err)
+		}
+
+		return svcmodel.ReleaseRevision{}, err
+	}
+
+	return model.ToSvcReleaseRevision(rev), nil
+}
+
+func (r *ReleaseRepository) ListReleaseRevisions(ctx context.Context, releaseID id.Release) ([]svcmodel.ReleaseRevision, error) {
+	revisions, err := helper.ListValues[model.ReleaseRevision](ctx, r.dbpool, query.ListReleaseRevisions, pgx.NamedArgs{
+		"releaseID": releaseID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ToSvcReleaseRevisions(revisions), nil
+}
+
+func (r *ReleaseRepository) createReleaseRevision(ctx context.Context, e helper.ExecExecutor, rev svcmodel.ReleaseRevision) error {
+	if _, err := e.Exec(ctx, query.CreateReleaseRevision, pgx.NamedArgs{
+		"id":                 rev.ID,
+		"releaseID":          rev.ReleaseID,
+		"releaseTitleBefore": rev.Before.ReleaseTitle,
+		"releaseNotesBefore": rev.Before.ReleaseNotes,
+		"releaseTitleAfter":  rev.After.ReleaseTitle,
+		"releaseNotesAfter":  rev.After.ReleaseNotes,
+		"createdBy":          rev.AuthorUserID,
+		"createdAt":          rev.CreatedAt,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -43,6 +43,20 @@ func (c *Client) SendProjectInvitationEmailAsync(
 	c.sendEmailAsync(ctx, parsedTmpl.Subject, parsedTmpl.Text, parsedTmpl.HTML, recipient)
 }
 
+func (c *Client) SendDeploymentApprovalRequestEmailAsync(
+	ctx context.Context,
+	data model.DeploymentApprovalRequestEmailData,
+	recipients []string,
+) {
+	parsedTmpl, err := ParseDeploymentApprovalRequestTemplate(data, c.clientSvcCfg)
+	if err != nil {
+		slog.Error("parsing deployment approval request template", "error", err)
+		return
+	}
+
+	c.sendEmailAsync(ctx, parsedTmpl.Subject, parsedTmpl.Text, parsedTmpl.HTML, recipients...)
+}
+
 func (c *Client) sendEmailAsync(ctx context.Context, subject, text, html string, recipients ...string) {
 	req := NewEmailRequestBuilder(c.resendCfg).
 		SetRecipients(recipients).
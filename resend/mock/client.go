@@ -15,3 +15,7 @@ type Client struct {
 func (c *Client) SendProjectInvitationEmailAsync(ctx context.Context, data model.ProjectInvitationEmailData, recipient string) {
 	c.Called(ctx, data, recipient)
 }
+
+func (c *Client) SendDeploymentApprovalRequestEmailAsync(ctx context.Context, data model.DeploymentApprovalRequestEmailData, recipients []string) {
+	c.Called(ctx, data, recipients)
+}
@@ -10,8 +10,12 @@ import (
 	svcmodel "release-manager/service/model"
 )
 
-//go:embed templates/project_invitation.tmpl
-var projectInvitationTmpl string
+var (
+	//go:embed templates/project_invitation.tmpl
+	projectInvitationTmpl string
+	//go:embed templates/deployment_approval_request.tmpl
+	deploymentApprovalRequestTmpl string
+)
 
 type ParsedTemplate struct {
 	Subject string
@@ -33,8 +37,31 @@ func ParseProjectInvitationTemplate(data svcmodel.ProjectInvitationEmailData, cl
 		"signUpLink":  fmt.Sprintf("%s/%s", clientSvcCfg.URL, clientSvcCfg.SignUpRoute),
 	}
 
+	return executeTemplate(tmpl, templateData)
+}
+
+func ParseDeploymentApprovalRequestTemplate(
+	data svcmodel.DeploymentApprovalRequestEmailData,
+	clientSvcCfg config.ClientServiceConfig,
+) (ParsedTemplate, error) {
+	tmpl, err := template.New("deployment_approval_request").Parse(deploymentApprovalRequestTmpl)
+	if err != nil {
+		return ParsedTemplate{}, fmt.Errorf("parsing template: %w", err)
+	}
+
+	templateData := map[string]string{
+		"projectName":     data.ProjectName,
+		"releaseTitle":    data.ReleaseTitle,
+		"environmentName": data.EnvironmentName,
+		"siteLink":        clientSvcCfg.URL,
+	}
+
+	return executeTemplate(tmpl, templateData)
+}
+
+func executeTemplate(tmpl *template.Template, templateData map[string]string) (ParsedTemplate, error) {
 	subject := new(bytes.Buffer)
-	err = tmpl.ExecuteTemplate(subject, "subject", templateData)
+	err := tmpl.ExecuteTemplate(subject, "subject", templateData)
 	if err != nil {
 		return ParsedTemplate{}, fmt.Errorf("executing subject template: %w", err)
 	}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	svcerrors "release-manager/service/errors"
+	"release-manager/service/model"
+)
+
+// HandleBitbucketWebhook deletes the releases of the tags deleted by a push to the Bitbucket repo linked to a project.
+// Created tags are ignored, since release notes cannot be generated for Bitbucket repos.
+// A deleted tag which has no release is skipped, so a push can delete any tags.
+// Each tag is routed by its prefix to one of the projects sharing the repo.
+func (s *ReleaseService) HandleBitbucketWebhook(ctx context.Context, input model.BitbucketWebhookInput) error {
+	bitbucket, err := s.settingsGetter.GetBitbucketSettings(ctx)
+	if err != nil {
+		return fmt.Errorf("getting bitbucket settings: %w", err)
+	}
+
+	if !bitbucket.Enabled {
+		return svcerrors.NewBitbucketIntegrationNotEnabledError()
+	}
+
+	output, err := s.bitbucketManager.ParseTagWebhook(input, bitbucket.WebhookSecret)
+	if err != nil {
+		return fmt.Errorf("parsing webhook push event: %w", err)
+	}
+
+	if len(output.DeletedTagNames) == 0 {
+		return nil
+	}
+
+	for _, tagName := range output.DeletedTagNames {
+		p, err := s.projectGetter.GetProjectByBitbucketRepo(ctx, output.Repo, tagName)
+		if err != nil {
+			return fmt.Errorf("getting project by bitbucket repo: %w", err)
+		}
+
+		if err := s.repo.DeleteReleaseForProjectByGitTag(ctx, p.ID, tagName); err != nil &&
+			!svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeReleaseNotFound) {
+			return fmt.Errorf("deleting release by git tag: %w", err)
+		}
+	}
+
+	return nil
+}
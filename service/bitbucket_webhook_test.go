@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	bitbucket "release-manager/bitbucket/mock"
+	github "release-manager/github/mock"
+	gitlab "release-manager/gitlab/mock"
+	repo "release-manager/repository/mock"
+	resend "release-manager/resend/mock"
+	svcerrors "release-manager/service/errors"
+	svc "release-manager/service/mock"
+	"release-manager/service/model"
+	slack "release-manager/slack/mock"
+	storage "release-manager/storage/mock"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReleaseService_HandleBitbucketWebhook(t *testing.T) {
+	settings := model.BitbucketSettings{
+		Enabled:       true,
+		Token:         "token",
+		WebhookSecret: "secret",
+	}
+	bitbucketRepo := model.BitbucketRepo{Workspace: "workspace", RepoSlug: "repo"}
+	project := model.Project{BitbucketRepo: &bitbucketRepo}
+
+	testCases := []struct {
+		name      string
+		mockSetup func(*svc.SettingsService, *svc.ProjectService, *bitbucket.Client, *repo.ReleaseRepository)
+		wantErr   bool
+	}{
+		{
+			name: "Releases are deleted for deleted tags",
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, bitbucketClient *bitbucket.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetBitbucketSettings", mock.Anything).Return(settings, nil)
+				bitbucketClient.On("ParseTagWebhook", mock.Anything, settings.WebhookSecret).Return(model.BitbucketTagWebhookOutput{
+					Repo:            bitbucketRepo,
+					DeletedTagNames: []string{"v1.0.0", "v1.1.0"},
+				}, nil)
+				projectSvc.On("GetProjectByBitbucketRepo", mock.Anything, bitbucketRepo, "v1.0.0").Return(project, nil)
+				projectSvc.On("GetProjectByBitbucketRepo", mock.Anything, bitbucketRepo, "v1.1.0").Return(project, nil)
+				releaseRepo.On("DeleteReleaseForProjectByGitTag", mock.Anything, project.ID, "v1.0.0").Return(nil)
+				releaseRepo.On("DeleteReleaseForProjectByGitTag", mock.Anything, project.ID, "v1.1.0").Return(svcerrors.NewReleaseNotFoundError())
+			},
+			wantErr: false,
+		},
+		{
+			name: "Push without deleted tags is ignored",
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, bitbucketClient *bitbucket.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetBitbucketSettings", mock.Anything).Return(settings, nil)
+				bitbucketClient.On("ParseTagWebhook", mock.Anything, settings.WebhookSecret).Return(model.BitbucketTagWebhookOutput{Repo: bitbucketRepo}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Invalid webhook signature",
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, bitbucketClient *bitbucket.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetBitbucketSettings", mock.Anything).Return(settings, nil)
+				bitbucketClient.On("ParseTagWebhook", mock.Anything, settings.WebhookSecret).Return(model.BitbucketTagWebhookOutput{}, svcerrors.NewInvalidBitbucketWebhookError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "Project not found",
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, bitbucketClient *bitbucket.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetBitbucketSettings", mock.Anything).Return(settings, nil)
+				bitbucketClient.On("ParseTagWebhook", mock.Anything, settings.WebhookSecret).Return(model.BitbucketTagWebhookOutput{
+					Repo:            bitbucketRepo,
+					DeletedTagNames: []string{"v1.0.0"},
+				}, nil)
+				projectSvc.On("GetProjectByBitbucketRepo", mock.Anything, bitbucketRepo, "v1.0.0").Return(model.Project{}, svcerrors.NewProjectNotFoundError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "Bitbucket integration not enabled",
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, bitbucketClient *bitbucket.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetBitbucketSettings", mock.Anything).Return(model.BitbucketSettings{Enabled: false}, nil)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authSvc := new(svc.AuthorizationService)
+			projectSvc := new(svc.ProjectService)
+			settingsSvc := new(svc.SettingsService)
+			releaseRepo := new(repo.ReleaseRepository)
+			slackClient := new(slack.Client)
+			githubClient := new(github.Client)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
+
+			tc.mockSetup(settingsSvc, projectSvc, bitbucketClient, releaseRepo)
+
+			err := service.HandleBitbucketWebhook(context.TODO(), model.BitbucketWebhookInput{Event: model.BitbucketCloudPushEvent, Signature: "sha256=signature"})
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			settingsSvc.AssertExpectations(t)
+			projectSvc.AssertExpectations(t)
+			bitbucketClient.AssertExpectations(t)
+			releaseRepo.AssertExpectations(t)
+		})
+	}
+}
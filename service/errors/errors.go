@@ -24,6 +24,7 @@ var (
 	ErrCodeGithubIntegrationNotEnabled     = "ERR_GITHUB_INTEGRATION_NOT_ENABLED"
 	ErrCodeGithubClientUnauthorized        = "ERR_GITHUB_CLIENT_UNAUTHORIZED"
 	ErrCodeGithubClientForbidden           = "ERR_GITHUB_CLIENT_FORBIDDEN"
+	ErrCodeGithubRateLimited               = "ERR_GITHUB_RATE_LIMITED"
 	ErrCodeGithubRepoNotSetForProject      = "ERR_GITHUB_REPO_NOT_SET_FOR_PROJECT"
 	ErrCodeGithubRepoNotFound              = "ERR_GITHUB_REPO_NOT_FOUND"
 	ErrCodeGithubRepoInvalidURL            = "ERR_GITHUB_REPO_INVALID_URL"
@@ -40,10 +41,55 @@ var (
 	ErrCodeReleaseGitTagAlreadyUsed        = "ERR_RELEASE_GIT_TAG_ALREADY_USED"
 	ErrCodeDeploymentInvalid               = "ERR_DEPLOYMENT_INVALID"
 	ErrCodeDeploymentNotFound              = "ERR_DEPLOYMENT_NOT_FOUND"
+	ErrCodeProjectGithubRepoChanged        = "ERR_PROJECT_GITHUB_REPO_CHANGED"
 	ErrCodeProjectGithubRepoAlreadyUsed    = "ERR_PROJECT_GITHUB_REPO_ALREADY_USED"
 	ErrCodeGithubNotesInvalidInput         = "ERR_GITHUB_NOTES_INVALID_INPUT"
 	ErrCodeAdminUserCannotBeDeleted        = "ERR_ADMIN_USER_CANNOT_BE_DELETED"
 	ErrCodeInvalidGithubTagDeletionWebhook = "ERR_INVALID_GITHUB_TAG_DELETION_WEBHOOK"
+	ErrCodeInvalidGithubTagCreationWebhook = "ERR_INVALID_GITHUB_TAG_CREATION_WEBHOOK"
+	ErrCodeGithubWebhookDeliveryInvalid    = "ERR_GITHUB_WEBHOOK_DELIVERY_INVALID"
+	ErrCodeGithubWebhookDeliveryIgnored    = "ERR_GITHUB_WEBHOOK_DELIVERY_IGNORED"
+	ErrCodeGithubWebhookDeliveryNotFound   = "ERR_GITHUB_WEBHOOK_DELIVERY_NOT_FOUND"
+	ErrCodeGithubWebhookDeliveryProcessed  = "ERR_GITHUB_WEBHOOK_DELIVERY_PROCESSED"
+	ErrCodeGithubWebhookEventNotSupported  = "ERR_GITHUB_WEBHOOK_EVENT_NOT_SUPPORTED"
+	ErrCodeGithubWebhookNotFound           = "ERR_GITHUB_WEBHOOK_NOT_FOUND"
+	ErrCodeGithubAppCredentialsInvalid     = "ERR_GITHUB_APP_CREDENTIALS_INVALID"
+	ErrCodeGithubAppInstallationNotFound   = "ERR_GITHUB_APP_INSTALLATION_NOT_FOUND"
+	ErrCodeReleaseAttachmentInvalid        = "ERR_RELEASE_ATTACHMENT_INVALID"
+	ErrCodeReleaseAttachmentNotFound       = "ERR_RELEASE_ATTACHMENT_NOT_FOUND"
+	ErrCodeReleaseAttachmentTooLarge       = "ERR_RELEASE_ATTACHMENT_TOO_LARGE"
+	ErrCodeReleaseNotPublished             = "ERR_RELEASE_NOT_PUBLISHED"
+	ErrCodeDeploymentApproverNotAllowed    = "ERR_DEPLOYMENT_APPROVER_NOT_ALLOWED"
+	ErrCodeReleasePlanInvalid              = "ERR_RELEASE_PLAN_INVALID"
+	ErrCodeReleasePlanNotFound             = "ERR_RELEASE_PLAN_NOT_FOUND"
+	ErrCodeReleaseVersionNotIncreased      = "ERR_RELEASE_VERSION_NOT_INCREASED"
+	ErrCodeReleaseRevisionNotFound         = "ERR_RELEASE_REVISION_NOT_FOUND"
+	ErrCodeGitTagAlreadyExists             = "ERR_GIT_TAG_ALREADY_EXISTS"
+	ErrCodeGitTagTargetNotFound            = "ERR_GIT_TAG_TARGET_NOT_FOUND"
+	ErrCodeGitTagListInvalid               = "ERR_GIT_TAG_LIST_INVALID"
+	ErrCodePreviousReleaseNotFound         = "ERR_PREVIOUS_RELEASE_NOT_FOUND"
+	ErrCodeGitRepoNotSetForProject         = "ERR_GIT_REPO_NOT_SET_FOR_PROJECT"
+	ErrCodeGitlabIntegrationNotEnabled     = "ERR_GITLAB_INTEGRATION_NOT_ENABLED"
+	ErrCodeGitlabClientUnauthorized        = "ERR_GITLAB_CLIENT_UNAUTHORIZED"
+	ErrCodeGitlabClientForbidden           = "ERR_GITLAB_CLIENT_FORBIDDEN"
+	ErrCodeGitlabRepoNotSetForProject      = "ERR_GITLAB_REPO_NOT_SET_FOR_PROJECT"
+	ErrCodeGitlabRepoNotFound              = "ERR_GITLAB_REPO_NOT_FOUND"
+	ErrCodeGitlabRepoInvalidURL            = "ERR_GITLAB_REPO_INVALID_URL"
+	ErrCodeGitlabReleaseNotFound           = "ERR_GITLAB_RELEASE_NOT_FOUND"
+	ErrCodeProjectGitlabRepoAlreadyUsed    = "ERR_PROJECT_GITLAB_REPO_ALREADY_USED"
+	ErrCodeInvalidGitlabTagPushWebhook     = "ERR_INVALID_GITLAB_TAG_PUSH_WEBHOOK"
+	ErrCodeGitlabWebhookEventNotSupported  = "ERR_GITLAB_WEBHOOK_EVENT_NOT_SUPPORTED"
+	ErrCodeGitRepoOperationNotSupported    = "ERR_GIT_REPO_OPERATION_NOT_SUPPORTED"
+	ErrCodeBitbucketIntegrationNotEnabled  = "ERR_BITBUCKET_INTEGRATION_NOT_ENABLED"
+	ErrCodeBitbucketClientUnauthorized     = "ERR_BITBUCKET_CLIENT_UNAUTHORIZED"
+	ErrCodeBitbucketClientForbidden        = "ERR_BITBUCKET_CLIENT_FORBIDDEN"
+	ErrCodeBitbucketRepoNotSetForProject   = "ERR_BITBUCKET_REPO_NOT_SET_FOR_PROJECT"
+	ErrCodeBitbucketRepoNotFound           = "ERR_BITBUCKET_REPO_NOT_FOUND"
+	ErrCodeBitbucketRepoInvalidURL         = "ERR_BITBUCKET_REPO_INVALID_URL"
+	ErrCodeProjectBitbucketRepoAlreadyUsed = "ERR_PROJECT_BITBUCKET_REPO_ALREADY_USED"
+	ErrCodeInvalidBitbucketWebhook         = "ERR_INVALID_BITBUCKET_WEBHOOK"
+	ErrCodeBitbucketEventNotSupported      = "ERR_BITBUCKET_WEBHOOK_EVENT_NOT_SUPPORTED"
+	ErrCodeProjectVersionTagPrefixOverlap  = "ERR_PROJECT_VERSION_TAG_PREFIX_OVERLAP"
 )
 
 type Error struct {
@@ -209,6 +255,13 @@ func NewGithubClientForbiddenError() *Error {
 	}
 }
 
+func NewGithubRateLimitedError() *Error {
+	return &Error{
+		Code:    ErrCodeGithubRateLimited,
+		Message: "Request cannot be processed because the GitHub API rate limit was exceeded, try again later.",
+	}
+}
+
 func NewGithubRepoNotFoundError() *Error {
 	return &Error{
 		Code:    ErrCodeGithubRepoNotFound,
@@ -279,6 +332,27 @@ func NewGitTagNotFoundError() *Error {
 	}
 }
 
+func NewGitTagAlreadyExistsError() *Error {
+	return &Error{
+		Code:    ErrCodeGitTagAlreadyExists,
+		Message: "Git tag already exists",
+	}
+}
+
+func NewGitTagTargetNotFoundError() *Error {
+	return &Error{
+		Code:    ErrCodeGitTagTargetNotFound,
+		Message: "Branch or commit to tag not found",
+	}
+}
+
+func NewGitTagListInvalidError() *Error {
+	return &Error{
+		Code:    ErrCodeGitTagListInvalid,
+		Message: "Invalid parameters for listing git tags",
+	}
+}
+
 func NewGithubReleaseNotFoundError() *Error {
 	return &Error{
 		Code:    ErrCodeGithubReleaseNotFound,
@@ -293,6 +367,20 @@ func NewReleaseGitTagAlreadyUsedError() *Error {
 	}
 }
 
+func NewReleaseVersionNotIncreasedError() *Error {
+	return &Error{
+		Code:    ErrCodeReleaseVersionNotIncreased,
+		Message: "Release version must be greater than the version of the last published release",
+	}
+}
+
+func NewReleaseRevisionNotFoundError() *Error {
+	return &Error{
+		Code:    ErrCodeReleaseRevisionNotFound,
+		Message: "Release revision not found",
+	}
+}
+
 func NewDeploymentInvalidError() *Error {
 	return &Error{
 		Code:    ErrCodeDeploymentInvalid,
@@ -307,10 +395,17 @@ func NewDeploymentNotFoundError() *Error {
 	}
 }
 
+func NewProjectGithubRepoChangedError() *Error {
+	return &Error{
+		Code:    ErrCodeProjectGithubRepoChanged,
+		Message: "Github repo of the project was changed meanwhile, try again.",
+	}
+}
+
 func NewProjectGithubRepoAlreadyUsedError() *Error {
 	return &Error{
 		Code:    ErrCodeProjectGithubRepoAlreadyUsed,
-		Message: "Github repo is already used for another project.",
+		Message: "Github repo is already used for another project with the same version tag prefix.",
 	}
 }
 
@@ -335,6 +430,280 @@ func NewInvalidGithubTagDeletionWebhookError() *Error {
 	}
 }
 
+func NewInvalidGithubTagCreationWebhookError() *Error {
+	return &Error{
+		Code:    ErrCodeInvalidGithubTagCreationWebhook,
+		Message: "Invalid Github webhook for tag created event",
+	}
+}
+
+func NewGithubWebhookDeliveryInvalidError() *Error {
+	return &Error{
+		Code:    ErrCodeGithubWebhookDeliveryInvalid,
+		Message: "Invalid Github webhook delivery",
+	}
+}
+
+// NewGithubWebhookDeliveryIgnoredError is returned for deliveries which are acknowledged without processing, e.g. pings or branch events.
+func NewGithubWebhookDeliveryIgnoredError() *Error {
+	return &Error{
+		Code:    ErrCodeGithubWebhookDeliveryIgnored,
+		Message: "Github webhook delivery is ignored",
+	}
+}
+
+func NewGithubWebhookDeliveryNotFoundError() *Error {
+	return &Error{
+		Code:    ErrCodeGithubWebhookDeliveryNotFound,
+		Message: "Github webhook delivery not found",
+	}
+}
+
+func NewGithubWebhookDeliveryProcessedError() *Error {
+	return &Error{
+		Code:    ErrCodeGithubWebhookDeliveryProcessed,
+		Message: "Github webhook delivery has already been processed",
+	}
+}
+
+func NewGithubWebhookEventNotSupportedError() *Error {
+	return &Error{
+		Code:    ErrCodeGithubWebhookEventNotSupported,
+		Message: "Github webhook event is not supported",
+	}
+}
+
+func NewGithubWebhookNotFoundError() *Error {
+	return &Error{
+		Code:    ErrCodeGithubWebhookNotFound,
+		Message: "Github webhook not found",
+	}
+}
+
+func NewGithubAppCredentialsInvalidError() *Error {
+	return &Error{
+		Code:    ErrCodeGithubAppCredentialsInvalid,
+		Message: "Github app credentials are invalid, installation token cannot be created.",
+	}
+}
+
+func NewGithubAppInstallationNotFoundError() *Error {
+	return &Error{
+		Code:    ErrCodeGithubAppInstallationNotFound,
+		Message: "Github app installation not found",
+	}
+}
+
+func NewReleaseAttachmentInvalidError() *Error {
+	return &Error{
+		Code:    ErrCodeReleaseAttachmentInvalid,
+		Message: "Invalid release attachment",
+	}
+}
+
+func NewReleaseAttachmentNotFoundError() *Error {
+	return &Error{
+		Code:    ErrCodeReleaseAttachmentNotFound,
+		Message: "Release attachment not found",
+	}
+}
+
+func NewReleaseAttachmentTooLargeError() *Error {
+	return &Error{
+		Code:    ErrCodeReleaseAttachmentTooLarge,
+		Message: "Release attachment exceeds the maximum allowed file size",
+	}
+}
+
+func NewReleaseNotPublishedError() *Error {
+	return &Error{
+		Code:    ErrCodeReleaseNotPublished,
+		Message: "Release is not published",
+	}
+}
+
+func NewDeploymentApproverNotAllowedError() *Error {
+	return &Error{
+		Code:    ErrCodeDeploymentApproverNotAllowed,
+		Message: "User is not allowed to approve deployments to the environment",
+	}
+}
+
+func NewReleasePlanInvalidError() *Error {
+	return &Error{
+		Code:    ErrCodeReleasePlanInvalid,
+		Message: "Invalid release plan",
+	}
+}
+
+func NewReleasePlanNotFoundError() *Error {
+	return &Error{
+		Code:    ErrCodeReleasePlanNotFound,
+		Message: "Release plan not found",
+	}
+}
+
+func NewPreviousReleaseNotFoundError() *Error {
+	return &Error{
+		Code:    ErrCodePreviousReleaseNotFound,
+		Message: "No published release precedes the release",
+	}
+}
+
+func NewGitRepoNotSetForProjectError() *Error {
+	return &Error{
+		Code:    ErrCodeGitRepoNotSetForProject,
+		Message: "No Github, Gitlab or Bitbucket repo is set for the project.",
+	}
+}
+
+func NewGitlabIntegrationNotEnabledError() *Error {
+	return &Error{
+		Code:    ErrCodeGitlabIntegrationNotEnabled,
+		Message: "Gitlab integration is not enabled.",
+	}
+}
+
+func NewGitlabClientUnauthorizedError() *Error {
+	return &Error{
+		Code:    ErrCodeGitlabClientUnauthorized,
+		Message: "Request to the GitLab API cannot be processed because the client is not properly authenticated (invalid or expired token).",
+	}
+}
+
+func NewGitlabClientForbiddenError() *Error {
+	return &Error{
+		Code:    ErrCodeGitlabClientForbidden,
+		Message: "Request cannot be processed because the client does not have permission to access the specified resource via GitLab API.",
+	}
+}
+
+func NewGitlabRepoNotSetForProjectError() *Error {
+	return &Error{
+		Code:    ErrCodeGitlabRepoNotSetForProject,
+		Message: "Gitlab repo is not set for the project.",
+	}
+}
+
+func NewGitlabRepoNotFoundError() *Error {
+	return &Error{
+		Code:    ErrCodeGitlabRepoNotFound,
+		Message: "Gitlab repo not found among accessible repos.",
+	}
+}
+
+func NewGitlabRepoInvalidURLError() *Error {
+	return &Error{
+		Code:    ErrCodeGitlabRepoInvalidURL,
+		Message: "Invalid Gitlab repo URL.",
+	}
+}
+
+func NewGitlabReleaseNotFoundError() *Error {
+	return &Error{
+		Code:    ErrCodeGitlabReleaseNotFound,
+		Message: "Gitlab release not found",
+	}
+}
+
+func NewProjectGitlabRepoAlreadyUsedError() *Error {
+	return &Error{
+		Code:    ErrCodeProjectGitlabRepoAlreadyUsed,
+		Message: "Gitlab repo is already used by another project with the same version tag prefix",
+	}
+}
+
+func NewInvalidGitlabTagPushWebhookError() *Error {
+	return &Error{
+		Code:    ErrCodeInvalidGitlabTagPushWebhook,
+		Message: "Invalid Gitlab webhook for tag push event",
+	}
+}
+
+func NewGitlabWebhookEventNotSupportedError() *Error {
+	return &Error{
+		Code:    ErrCodeGitlabWebhookEventNotSupported,
+		Message: "Gitlab webhook event is not supported",
+	}
+}
+
+func NewGitRepoOperationNotSupportedError() *Error {
+	return &Error{
+		Code:    ErrCodeGitRepoOperationNotSupported,
+		Message: "Operation is not supported for the git repo of the project",
+	}
+}
+
+func NewBitbucketIntegrationNotEnabledError() *Error {
+	return &Error{
+		Code:    ErrCodeBitbucketIntegrationNotEnabled,
+		Message: "Bitbucket integration is not enabled.",
+	}
+}
+
+func NewBitbucketClientUnauthorizedError() *Error {
+	return &Error{
+		Code:    ErrCodeBitbucketClientUnauthorized,
+		Message: "Request to the Bitbucket API cannot be processed because the client is not properly authenticated (invalid or expired token).",
+	}
+}
+
+func NewBitbucketClientForbiddenError() *Error {
+	return &Error{
+		Code:    ErrCodeBitbucketClientForbidden,
+		Message: "Request cannot be processed because the client does not have permission to access the specified resource via Bitbucket API.",
+	}
+}
+
+func NewBitbucketRepoNotSetForProjectError() *Error {
+	return &Error{
+		Code:    ErrCodeBitbucketRepoNotSetForProject,
+		Message: "Bitbucket repo is not set for the project.",
+	}
+}
+
+func NewBitbucketRepoNotFoundError() *Error {
+	return &Error{
+		Code:    ErrCodeBitbucketRepoNotFound,
+		Message: "Bitbucket repo not found among accessible repos.",
+	}
+}
+
+func NewBitbucketRepoInvalidURLError() *Error {
+	return &Error{
+		Code:    ErrCodeBitbucketRepoInvalidURL,
+		Message: "Invalid Bitbucket repo URL.",
+	}
+}
+
+func NewProjectBitbucketRepoAlreadyUsedError() *Error {
+	return &Error{
+		Code:    ErrCodeProjectBitbucketRepoAlreadyUsed,
+		Message: "Bitbucket repo is already used by another project with the same version tag prefix",
+	}
+}
+
+func NewInvalidBitbucketWebhookError() *Error {
+	return &Error{
+		Code:    ErrCodeInvalidBitbucketWebhook,
+		Message: "Invalid Bitbucket webhook",
+	}
+}
+
+func NewBitbucketEventNotSupportedError() *Error {
+	return &Error{
+		Code:    ErrCodeBitbucketEventNotSupported,
+		Message: "Bitbucket webhook event is not supported",
+	}
+}
+
+func NewProjectVersionTagPrefixOverlapError() *Error {
+	return &Error{
+		Code:    ErrCodeProjectVersionTagPrefixOverlap,
+		Message: "Version tag prefix overlaps with the prefix of another project sharing the git repo",
+	}
+}
+
 func IsErrorWithCode(err error, code string) bool {
 	var svcErr *Error
 	if errors.As(err, &svcErr) {
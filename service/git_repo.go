@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	svcerrors "release-manager/service/errors"
+	"release-manager/service/model"
+)
+
+// gitRepo is the repo linked to a project, bound to the credentials of its provider.
+// Operations available on all providers go through it, GitHub only features use githubManager directly.
+type gitRepo interface {
+	ReadTag(ctx context.Context, tagName string) (model.GitTag, error)
+	ReadTags(ctx context.Context) ([]model.RepoGitTag, error)
+	CreateTag(ctx context.Context, tagName, target, message string) (model.GitTag, error)
+	DeleteTag(ctx context.Context, tagName string) error
+	UpsertRelease(ctx context.Context, rls model.Release) error
+	DeleteReleaseByTag(ctx context.Context, tag model.GitTag) error
+	GenerateReleaseNotes(ctx context.Context, input model.GitReleaseNotesInput) (model.GitReleaseNotes, error)
+}
+
+// gitRepoResolver returns the repo of the project from the provider the project is linked to.
+type gitRepoResolver struct {
+	settingsGetter settingsGetter
+	githubManager  githubManager
+	gitlabManager  gitlabManager
+	// bitbucketManager only reads tags, Bitbucket has no releases
+	bitbucketManager bitbucketManager
+}
+
+func newGitRepoResolver(
+	settingsGetter settingsGetter,
+	githubManager githubManager,
+	gitlabManager gitlabManager,
+	bitbucketManager bitbucketManager,
+) gitRepoResolver {
+	return gitRepoResolver{
+		settingsGetter:   settingsGetter,
+		githubManager:    githubManager,
+		gitlabManager:    gitlabManager,
+		bitbucketManager: bitbucketManager,
+	}
+}
+
+// resolve fails if the project has no repo, or if the integration of the provider is not enabled.
+func (r gitRepoResolver) resolve(ctx context.Context, p model.Project) (gitRepo, error) {
+	switch {
+	case p.IsBitbucketRepoSet():
+		inst, err := r.settingsGetter.GetBitbucketInstance(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("getting bitbucket instance: %w", err)
+		}
+
+		return bitbucketRepo{manager: r.bitbucketManager, inst: inst, repo: *p.BitbucketRepo}, nil
+	case p.IsGitlabRepoSet():
+		inst, err := r.settingsGetter.GetGitlabInstance(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("getting gitlab instance: %w", err)
+		}
+
+		return gitlabRepo{manager: r.gitlabManager, inst: inst, repo: *p.GitlabRepo}, nil
+	case p.IsGithubRepoSet():
+		tkn, err := r.settingsGetter.GetGithubToken(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("getting github token: %w", err)
+		}
+
+		return githubRepo{manager: r.githubManager, tkn: tkn, repo: *p.GithubRepo}, nil
+	default:
+		return nil, svcerrors.NewGitRepoNotSetForProjectError()
+	}
+}
+
+type githubRepo struct {
+	manager githubManager
+	tkn     model.GithubToken
+	repo    model.GithubRepo
+}
+
+func (r githubRepo) ReadTag(ctx context.Context, tagName string) (model.GitTag, error) {
+	return r.manager.ReadTag(ctx, r.tkn, r.repo, tagName)
+}
+
+func (r githubRepo) ReadTags(ctx context.Context) ([]model.RepoGitTag, error) {
+	return r.manager.ReadTagsForRepo(ctx, r.tkn, r.repo)
+}
+
+func (r githubRepo) CreateTag(ctx context.Context, tagName, target, message string) (model.GitTag, error) {
+	return r.manager.CreateTag(ctx, r.tkn, r.repo, tagName, target, message)
+}
+
+func (r githubRepo) DeleteTag(ctx context.Context, tagName string) error {
+	return r.manager.DeleteTag(ctx, r.tkn, r.repo, tagName)
+}
+
+func (r githubRepo) UpsertRelease(ctx context.Context, rls model.Release) error {
+	return r.manager.UpsertRelease(ctx, r.tkn, r.repo, rls)
+}
+
+// DeleteReleaseByTag does not fail if the tag has no release on GitHub.
+func (r githubRepo) DeleteReleaseByTag(ctx context.Context, tag model.GitTag) error {
+	if err := r.manager.DeleteReleaseByTag(ctx, r.tkn, r.repo, tag); err != nil &&
+		!svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGithubReleaseNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+func (r githubRepo) GenerateReleaseNotes(ctx context.Context, input model.GitReleaseNotesInput) (model.GitReleaseNotes, error) {
+	return r.manager.GenerateReleaseNotes(ctx, r.tkn, r.repo, input)
+}
+
+type gitlabRepo struct {
+	manager gitlabManager
+	inst    model.GitlabInstance
+	repo    model.GitlabRepo
+}
+
+func (r gitlabRepo) ReadTag(ctx context.Context, tagName string) (model.GitTag, error) {
+	return r.manager.ReadTag(ctx, r.inst, r.repo, tagName)
+}
+
+func (r gitlabRepo) ReadTags(ctx context.Context) ([]model.RepoGitTag, error) {
+	return r.manager.ReadTagsForRepo(ctx, r.inst, r.repo)
+}
+
+func (r gitlabRepo) CreateTag(ctx context.Context, tagName, target, message string) (model.GitTag, error) {
+	return r.manager.CreateTag(ctx, r.inst, r.repo, tagName, target, message)
+}
+
+func (r gitlabRepo) DeleteTag(ctx context.Context, tagName string) error {
+	return r.manager.DeleteTag(ctx, r.inst, r.repo, tagName)
+}
+
+func (r gitlabRepo) UpsertRelease(ctx context.Context, rls model.Release) error {
+	return r.manager.UpsertRelease(ctx, r.inst, r.repo, rls)
+}
+
+// DeleteReleaseByTag does not fail if the tag has no release on GitLab.
+func (r gitlabRepo) DeleteReleaseByTag(ctx context.Context, tag model.GitTag) error {
+	if err := r.manager.DeleteReleaseByTag(ctx, r.inst, r.repo, tag); err != nil &&
+		!svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGitlabReleaseNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+func (r gitlabRepo) GenerateReleaseNotes(ctx context.Context, input model.GitReleaseNotesInput) (model.GitReleaseNotes, error) {
+	return r.manager.GenerateReleaseNotes(ctx, r.inst, r.repo, input)
+}
+
+// bitbucketRepo reads tags of the repo, creating tags and anything related to releases is not supported.
+type bitbucketRepo struct {
+	manager bitbucketManager
+	inst    model.BitbucketInstance
+	repo    model.BitbucketRepo
+}
+
+func (r bitbucketRepo) ReadTag(ctx context.Context, tagName string) (model.GitTag, error) {
+	return r.manager.ReadTag(ctx, r.inst, r.repo, tagName)
+}
+
+func (r bitbucketRepo) ReadTags(ctx context.Context) ([]model.RepoGitTag, error) {
+	return r.manager.ReadTagsForRepo(ctx, r.inst, r.repo)
+}
+
+func (r bitbucketRepo) CreateTag(context.Context, string, string, string) (model.GitTag, error) {
+	return model.GitTag{}, svcerrors.NewGitRepoOperationNotSupportedError().WithMessage("Tags cannot be created in Bitbucket repos")
+}
+
+func (r bitbucketRepo) DeleteTag(context.Context, string) error {
+	return svcerrors.NewGitRepoOperationNotSupportedError().WithMessage("Tags cannot be deleted in Bitbucket repos")
+}
+
+func (r bitbucketRepo) UpsertRelease(context.Context, model.Release) error {
+	return svcerrors.NewGitRepoOperationNotSupportedError().WithMessage("Bitbucket repos do not have releases")
+}
+
+// DeleteReleaseByTag does nothing, since Bitbucket repos do not have releases.
+func (r bitbucketRepo) DeleteReleaseByTag(context.Context, model.GitTag) error {
+	return nil
+}
+
+func (r bitbucketRepo) GenerateReleaseNotes(context.Context, model.GitReleaseNotesInput) (model.GitReleaseNotes, error) {
+	return model.GitReleaseNotes{}, svcerrors.NewGitRepoOperationNotSupportedError().WithMessage("Release notes cannot be generated for Bitbucket repos")
+}
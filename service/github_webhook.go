@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"release-manager/pkg/id"
+	svcerrors "release-manager/service/errors"
+	"release-manager/service/model"
+)
+
+const (
+	githubWebhookCreateEvent = "create"
+	githubWebhookDeleteEvent = "delete"
+	// githubWebhookPingEvent is sent by GitHub when the webhook is registered
+	githubWebhookPingEvent = "ping"
+)
+
+// HandleGithubWebhook records the delivery and processes it according to its event.
+// A delivery with an invalid signature is rejected without being stored, so anyone reaching the endpoint cannot fill the delivery log.
+// A delivery which was already received is ignored, unless its processing failed.
+// The outcome of the processing is stored with the delivery, so failed deliveries can be replayed.
+// Pings and events of branches are acknowledged without processing and stored as ignored.
+func (s *ReleaseService) HandleGithubWebhook(ctx context.Context, input model.GithubWebhookDeliveryInput) error {
+	github, err := s.settingsGetter.GetGithubSettings(ctx)
+	if err != nil {
+		return fmt.Errorf("getting github settings: %w", err)
+	}
+
+	if !github.Enabled {
+		return svcerrors.NewGithubIntegrationNotEnabledError()
+	}
+
+	secret, err := s.getGithubWebhookSecret(ctx, github, input.RawPayload, input.Signature)
+	if err != nil {
+		return err
+	}
+
+	info := s.githubManager.ReadWebhookDeliveryInfo(input, secret)
+	if !info.SignatureValid {
+		return svcerrors.NewGithubWebhookDeliveryInvalidError().WithMessage("delivery signature is invalid")
+	}
+
+	d, err := model.NewGithubWebhookDelivery(input, info)
+	if err != nil {
+		return svcerrors.NewGithubWebhookDeliveryInvalidError().Wrap(err).WithMessage(err.Error())
+	}
+
+	if err := s.repo.CreateGithubWebhookDelivery(ctx, d); err != nil {
+		if svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGithubWebhookDeliveryProcessed) {
+			return nil
+		}
+
+		return fmt.Errorf("creating github webhook delivery: %w", err)
+	}
+
+	processErr := s.processGithubWebhookDelivery(ctx, d)
+	if _, err := s.finishGithubWebhookDelivery(ctx, d.DeliveryID, processErr); err != nil {
+		return err
+	}
+
+	if svcerrors.IsErrorWithCode(processErr, svcerrors.ErrCodeGithubWebhookDeliveryIgnored) {
+		return nil
+	}
+
+	return processErr
+}
+
+func (s *ReleaseService) ListGithubWebhookDeliveries(
+	ctx context.Context,
+	params model.ListGithubWebhookDeliveriesParams,
+	authUserID id.AuthUser,
+) ([]model.GithubWebhookDelivery, error) {
+	if err := s.authGuard.AuthorizeUserRoleAdmin(ctx, authUserID); err != nil {
+		return nil, fmt.Errorf("authorizing user role: %w", err)
+	}
+
+	if err := params.Validate(); err != nil {
+		return nil, svcerrors.NewGithubWebhookDeliveryInvalidError().Wrap(err).WithMessage(err.Error())
+	}
+
+	deliveries, err := s.repo.ListGithubWebhookDeliveries(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("listing github webhook deliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// ReplayGithubWebhookDelivery processes the stored payload of the delivery again.
+// A failed processing does not return an error, the outcome is returned with the delivery.
+func (s *ReleaseService) ReplayGithubWebhookDelivery(
+	ctx context.Context,
+	deliveryID string,
+	authUserID id.AuthUser,
+) (model.GithubWebhookDelivery, error) {
+	if err := s.authGuard.AuthorizeUserRoleAdmin(ctx, authUserID); err != nil {
+		return model.GithubWebhookDelivery{}, fmt.Errorf("authorizing user role: %w", err)
+	}
+
+	var delivery model.GithubWebhookDelivery
+	if err := s.repo.UpdateGithubWebhookDelivery(ctx, deliveryID, func(d model.GithubWebhookDelivery) (model.GithubWebhookDelivery, error) {
+		d.StartReplay()
+		delivery = d
+		return d, nil
+	}); err != nil {
+		return model.GithubWebhookDelivery{}, fmt.Errorf("updating github webhook delivery: %w", err)
+	}
+
+	return s.finishGithubWebhookDelivery(ctx, deliveryID, s.processGithubWebhookDelivery(ctx, delivery))
+}
+
+func (s *ReleaseService) processGithubWebhookDelivery(ctx context.Context, d model.GithubWebhookDelivery) error {
+	switch d.Event {
+	case githubWebhookCreateEvent:
+		return s.CreateDraftReleaseOnGitTagCreation(ctx, d.ToTagCreationWebhookInput())
+	case githubWebhookDeleteEvent:
+		return s.DeleteReleaseOnGitTagRemoval(ctx, d.ToTagDeletionWebhookInput())
+	case githubWebhookPingEvent:
+		return svcerrors.NewGithubWebhookDeliveryIgnoredError().WithMessage("ping event is not processed")
+	default:
+		return svcerrors.NewGithubWebhookEventNotSupportedError().WithMessage(fmt.Sprintf("event %q is not supported", d.Event))
+	}
+}
+
+func (s *ReleaseService) finishGithubWebhookDelivery(
+	ctx context.Context,
+	deliveryID string,
+	processErr error,
+) (model.GithubWebhookDelivery, error) {
+	var delivery model.GithubWebhookDelivery
+	if err := s.repo.UpdateGithubWebhookDelivery(ctx, deliveryID, func(d model.GithubWebhookDelivery) (model.GithubWebhookDelivery, error) {
+		if svcerrors.IsErrorWithCode(processErr, svcerrors.ErrCodeGithubWebhookDeliveryIgnored) {
+			d.Ignore()
+		} else {
+			d.Finish(processErr)
+		}
+		delivery = d
+		return d, nil
+	}); err != nil {
+		return model.GithubWebhookDelivery{}, fmt.Errorf("updating github webhook delivery: %w", err)
+	}
+
+	return delivery, nil
+}
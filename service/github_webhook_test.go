@@ -0,0 +1,363 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	bitbucket "release-manager/bitbucket/mock"
+	github "release-manager/github/mock"
+	gitlab "release-manager/gitlab/mock"
+	"release-manager/pkg/id"
+	repo "release-manager/repository/mock"
+	resend "release-manager/resend/mock"
+	svcerrors "release-manager/service/errors"
+	svc "release-manager/service/mock"
+	"release-manager/service/model"
+	slack "release-manager/slack/mock"
+	storage "release-manager/storage/mock"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// runGithubWebhookDeliveryUpdate calls the update function with the delivery, so the test can check the result.
+func runGithubWebhookDeliveryUpdate(d model.GithubWebhookDelivery, result *model.GithubWebhookDelivery) func(args mock.Arguments) {
+	return func(args mock.Arguments) {
+		updateFn := args.Get(2).(func(model.GithubWebhookDelivery) (model.GithubWebhookDelivery, error))
+		*result, _ = updateFn(d)
+	}
+}
+
+func TestReleaseService_HandleGithubWebhook(t *testing.T) {
+	settings := model.GithubSettings{
+		Enabled:       true,
+		Token:         "token",
+		WebhookSecret: "secret",
+	}
+	info := model.GithubWebhookDeliveryInfo{
+		RepoFullName:   "owner/repo",
+		SignatureValid: true,
+	}
+
+	testCases := []struct {
+		name       string
+		input      model.GithubWebhookDeliveryInput
+		mockSetup  func(*svc.SettingsService, *svc.ProjectService, *github.Client, *repo.ReleaseRepository)
+		wantStatus model.GithubWebhookDeliveryStatus
+		wantErr    bool
+	}{
+		{
+			name:  "Delete event is processed",
+			input: model.GithubWebhookDeliveryInput{DeliveryID: "1", Event: "delete"},
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, githubClient *github.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGithubSettings", mock.Anything).Return(settings, nil)
+				githubClient.On("ReadWebhookRepo", mock.Anything).Return(model.GithubRepo{}, false)
+				githubClient.On("ReadWebhookDeliveryInfo", mock.Anything, settings.WebhookSecret).Return(info)
+				releaseRepo.On("CreateGithubWebhookDelivery", mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				githubClient.On("ParseTagDeletionWebhook", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.GithubTagDeletionWebhookOutput{}, nil)
+				projectSvc.On("GetProjectByGithubRepo", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{}, nil)
+				releaseRepo.On("DeleteReleaseForProjectByGitTag", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			wantStatus: model.GithubWebhookDeliveryStatusSucceeded,
+			wantErr:    false,
+		},
+		{
+			name:  "Failed processing is recorded",
+			input: model.GithubWebhookDeliveryInput{DeliveryID: "1", Event: "delete"},
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, githubClient *github.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGithubSettings", mock.Anything).Return(settings, nil)
+				githubClient.On("ReadWebhookRepo", mock.Anything).Return(model.GithubRepo{}, false)
+				githubClient.On("ReadWebhookDeliveryInfo", mock.Anything, settings.WebhookSecret).Return(info)
+				releaseRepo.On("CreateGithubWebhookDelivery", mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				githubClient.On("ParseTagDeletionWebhook", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.GithubTagDeletionWebhookOutput{}, svcerrors.NewInvalidGithubTagDeletionWebhookError())
+			},
+			wantStatus: model.GithubWebhookDeliveryStatusFailed,
+			wantErr:    true,
+		},
+		{
+			name:  "Unsupported event is recorded",
+			input: model.GithubWebhookDeliveryInput{DeliveryID: "1", Event: "push"},
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, githubClient *github.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGithubSettings", mock.Anything).Return(settings, nil)
+				githubClient.On("ReadWebhookRepo", mock.Anything).Return(model.GithubRepo{}, false)
+				githubClient.On("ReadWebhookDeliveryInfo", mock.Anything, settings.WebhookSecret).Return(info)
+				releaseRepo.On("CreateGithubWebhookDelivery", mock.Anything, mock.Anything).Return(nil)
+			},
+			wantStatus: model.GithubWebhookDeliveryStatusFailed,
+			wantErr:    true,
+		},
+		{
+			name:  "Ping event is ignored",
+			input: model.GithubWebhookDeliveryInput{DeliveryID: "1", Event: "ping"},
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, githubClient *github.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGithubSettings", mock.Anything).Return(settings, nil)
+				githubClient.On("ReadWebhookRepo", mock.Anything).Return(model.GithubRepo{}, false)
+				githubClient.On("ReadWebhookDeliveryInfo", mock.Anything, settings.WebhookSecret).Return(info)
+				releaseRepo.On("CreateGithubWebhookDelivery", mock.Anything, mock.Anything).Return(nil)
+			},
+			wantStatus: model.GithubWebhookDeliveryStatusIgnored,
+			wantErr:    false,
+		},
+		{
+			name:  "Branch event is ignored",
+			input: model.GithubWebhookDeliveryInput{DeliveryID: "1", Event: "create"},
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, githubClient *github.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGithubSettings", mock.Anything).Return(settings, nil)
+				githubClient.On("ReadWebhookRepo", mock.Anything).Return(model.GithubRepo{}, false)
+				githubClient.On("ReadWebhookDeliveryInfo", mock.Anything, settings.WebhookSecret).Return(info)
+				releaseRepo.On("CreateGithubWebhookDelivery", mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				githubClient.On("ParseTagCreationWebhook", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.GithubTagCreationWebhookOutput{}, svcerrors.NewGithubWebhookDeliveryIgnoredError())
+			},
+			wantStatus: model.GithubWebhookDeliveryStatusIgnored,
+			wantErr:    false,
+		},
+		{
+			name:  "Duplicate delivery is ignored",
+			input: model.GithubWebhookDeliveryInput{DeliveryID: "1", Event: "delete"},
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, githubClient *github.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGithubSettings", mock.Anything).Return(settings, nil)
+				githubClient.On("ReadWebhookRepo", mock.Anything).Return(model.GithubRepo{}, false)
+				githubClient.On("ReadWebhookDeliveryInfo", mock.Anything, settings.WebhookSecret).Return(info)
+				releaseRepo.On("CreateGithubWebhookDelivery", mock.Anything, mock.Anything).Return(svcerrors.NewGithubWebhookDeliveryProcessedError())
+			},
+			wantErr: false,
+		},
+		{
+			name:  "Delivery with invalid signature is rejected without being stored",
+			input: model.GithubWebhookDeliveryInput{DeliveryID: "1", Event: "delete"},
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, githubClient *github.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGithubSettings", mock.Anything).Return(settings, nil)
+				githubClient.On("ReadWebhookRepo", mock.Anything).Return(model.GithubRepo{}, false)
+				githubClient.On("ReadWebhookDeliveryInfo", mock.Anything, settings.WebhookSecret).Return(model.GithubWebhookDeliveryInfo{RepoFullName: "owner/repo"})
+			},
+			wantErr: true,
+		},
+		{
+			name:  "Missing delivery ID",
+			input: model.GithubWebhookDeliveryInput{Event: "delete"},
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, githubClient *github.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGithubSettings", mock.Anything).Return(settings, nil)
+				githubClient.On("ReadWebhookRepo", mock.Anything).Return(model.GithubRepo{}, false)
+				githubClient.On("ReadWebhookDeliveryInfo", mock.Anything, settings.WebhookSecret).Return(info)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "Github integration not enabled",
+			input: model.GithubWebhookDeliveryInput{DeliveryID: "1", Event: "delete"},
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, githubClient *github.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGithubSettings", mock.Anything).Return(model.GithubSettings{Enabled: false}, nil)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authSvc := new(svc.AuthorizationService)
+			projectSvc := new(svc.ProjectService)
+			settingsSvc := new(svc.SettingsService)
+			releaseRepo := new(repo.ReleaseRepository)
+			slackClient := new(slack.Client)
+			githubClient := new(github.Client)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
+
+			tc.mockSetup(settingsSvc, projectSvc, githubClient, releaseRepo)
+
+			var finished model.GithubWebhookDelivery
+			if tc.wantStatus != "" {
+				releaseRepo.On("UpdateGithubWebhookDelivery", mock.Anything, tc.input.DeliveryID, mock.Anything).
+					Run(runGithubWebhookDeliveryUpdate(model.GithubWebhookDelivery{DeliveryID: tc.input.DeliveryID}, &finished)).
+					Return(nil)
+			}
+
+			err := service.HandleGithubWebhook(context.TODO(), tc.input)
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.wantStatus, finished.Status)
+
+			settingsSvc.AssertExpectations(t)
+			githubClient.AssertExpectations(t)
+			releaseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReleaseService_ListGithubWebhookDeliveries(t *testing.T) {
+	testCases := []struct {
+		name      string
+		params    model.ListGithubWebhookDeliveriesParams
+		mockSetup func(*svc.AuthorizationService, *repo.ReleaseRepository)
+		wantErr   bool
+	}{
+		{
+			name:   "Success",
+			params: model.ListGithubWebhookDeliveriesParams{Limit: model.GithubWebhookDeliveriesDefaultLimit},
+			mockSetup: func(authSvc *svc.AuthorizationService, releaseRepo *repo.ReleaseRepository) {
+				authSvc.On("AuthorizeUserRoleAdmin", mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ListGithubWebhookDeliveries", mock.Anything, mock.Anything).Return([]model.GithubWebhookDelivery{}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:   "Invalid limit",
+			params: model.ListGithubWebhookDeliveriesParams{Limit: 0},
+			mockSetup: func(authSvc *svc.AuthorizationService, releaseRepo *repo.ReleaseRepository) {
+				authSvc.On("AuthorizeUserRoleAdmin", mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:   "Not an admin",
+			params: model.ListGithubWebhookDeliveriesParams{Limit: model.GithubWebhookDeliveriesDefaultLimit},
+			mockSetup: func(authSvc *svc.AuthorizationService, releaseRepo *repo.ReleaseRepository) {
+				authSvc.On("AuthorizeUserRoleAdmin", mock.Anything, mock.Anything).Return(svcerrors.NewInsufficientUserRoleError())
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authSvc := new(svc.AuthorizationService)
+			projectSvc := new(svc.ProjectService)
+			settingsSvc := new(svc.SettingsService)
+			releaseRepo := new(repo.ReleaseRepository)
+			slackClient := new(slack.Client)
+			githubClient := new(github.Client)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
+
+			tc.mockSetup(authSvc, releaseRepo)
+
+			_, err := service.ListGithubWebhookDeliveries(context.TODO(), tc.params, id.AuthUser{})
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			authSvc.AssertExpectations(t)
+			releaseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReleaseService_ReplayGithubWebhookDelivery(t *testing.T) {
+	errMsg := "previous error"
+	failed := model.GithubWebhookDelivery{
+		DeliveryID: "1",
+		Event:      "delete",
+		Status:     model.GithubWebhookDeliveryStatusFailed,
+		Error:      &errMsg,
+	}
+
+	testCases := []struct {
+		name      string
+		mockSetup func(*svc.AuthorizationService, *svc.SettingsService, *svc.ProjectService, *github.Client, *repo.ReleaseRepository)
+		want      model.GithubWebhookDelivery
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			mockSetup: func(authSvc *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, githubClient *github.Client, releaseRepo *repo.ReleaseRepository) {
+				authSvc.On("AuthorizeUserRoleAdmin", mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("UpdateGithubWebhookDelivery", mock.Anything, "1", mock.Anything).Run(func(args mock.Arguments) {
+					updateFn := args.Get(2).(func(model.GithubWebhookDelivery) (model.GithubWebhookDelivery, error))
+					d, _ := updateFn(failed)
+					assert.Equal(t, model.GithubWebhookDeliveryStatusProcessing, d.Status)
+					assert.Equal(t, 1, d.ReplayCount)
+					assert.Nil(t, d.Error)
+				}).Return(nil).Once()
+				settingsSvc.On("GetGithubSettings", mock.Anything).Return(model.GithubSettings{Enabled: true}, nil)
+				githubClient.On("ReadWebhookRepo", mock.Anything).Return(model.GithubRepo{}, false)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				githubClient.On("ParseTagDeletionWebhook", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.GithubTagDeletionWebhookOutput{}, nil)
+				projectSvc.On("GetProjectByGithubRepo", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{}, nil)
+				releaseRepo.On("DeleteReleaseForProjectByGitTag", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("UpdateGithubWebhookDelivery", mock.Anything, "1", mock.Anything).Run(func(args mock.Arguments) {
+					updateFn := args.Get(2).(func(model.GithubWebhookDelivery) (model.GithubWebhookDelivery, error))
+					_, _ = updateFn(model.GithubWebhookDelivery{DeliveryID: "1", Status: model.GithubWebhookDeliveryStatusProcessing})
+				}).Return(nil).Once()
+			},
+			want:    model.GithubWebhookDelivery{DeliveryID: "1", Status: model.GithubWebhookDeliveryStatusSucceeded},
+			wantErr: false,
+		},
+		{
+			name: "Delivery not found",
+			mockSetup: func(authSvc *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, githubClient *github.Client, releaseRepo *repo.ReleaseRepository) {
+				authSvc.On("AuthorizeUserRoleAdmin", mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("UpdateGithubWebhookDelivery", mock.Anything, "1", mock.Anything).Return(svcerrors.NewGithubWebhookDeliveryNotFoundError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error recording outcome",
+			mockSetup: func(authSvc *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, githubClient *github.Client, releaseRepo *repo.ReleaseRepository) {
+				authSvc.On("AuthorizeUserRoleAdmin", mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("UpdateGithubWebhookDelivery", mock.Anything, "1", mock.Anything).
+					Run(runGithubWebhookDeliveryUpdate(model.GithubWebhookDelivery{DeliveryID: "1", Event: "push"}, &model.GithubWebhookDelivery{})).
+					Return(nil).Once()
+				releaseRepo.On("UpdateGithubWebhookDelivery", mock.Anything, "1", mock.Anything).Return(errors.New("db error")).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "Not an admin",
+			mockSetup: func(authSvc *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, githubClient *github.Client, releaseRepo *repo.ReleaseRepository) {
+				authSvc.On("AuthorizeUserRoleAdmin", mock.Anything, mock.Anything).Return(svcerrors.NewInsufficientUserRoleError())
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authSvc := new(svc.AuthorizationService)
+			projectSvc := new(svc.ProjectService)
+			settingsSvc := new(svc.SettingsService)
+			releaseRepo := new(repo.ReleaseRepository)
+			slackClient := new(slack.Client)
+			githubClient := new(github.Client)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
+
+			tc.mockSetup(authSvc, settingsSvc, projectSvc, githubClient, releaseRepo)
+
+			d, err := service.ReplayGithubWebhookDelivery(context.TODO(), "1", id.AuthUser{})
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.want.DeliveryID, d.DeliveryID)
+				assert.Equal(t, tc.want.Status, d.Status)
+				assert.NotNil(t, d.ProcessedAt)
+			}
+
+			authSvc.AssertExpectations(t)
+			settingsSvc.AssertExpectations(t)
+			githubClient.AssertExpectations(t)
+			releaseRepo.AssertExpectations(t)
+		})
+	}
+}
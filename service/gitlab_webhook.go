@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	svcerrors "release-manager/service/errors"
+	"release-manager/service/model"
+)
+
+// HandleGitlabWebhook processes the tag push events of the GitLab project linked to a project.
+// The tag is routed by its prefix to one of the projects sharing the GitLab project.
+// A pushed tag creates a draft release with notes generated against the tag of the last published release,
+// a deleted tag deletes the release of the tag. If the tag already has a release, nothing is created.
+func (s *ReleaseService) HandleGitlabWebhook(ctx context.Context, input model.GitlabWebhookInput) error {
+	gitlab, err := s.settingsGetter.GetGitlabSettings(ctx)
+	if err != nil {
+		return fmt.Errorf("getting gitlab settings: %w", err)
+	}
+
+	if !gitlab.Enabled {
+		return svcerrors.NewGitlabIntegrationNotEnabledError()
+	}
+
+	output, err := s.gitlabManager.ParseTagPushWebhook(input, gitlab.WebhookSecret)
+	if err != nil {
+		return fmt.Errorf("parsing webhook tag push event: %w", err)
+	}
+
+	p, err := s.projectGetter.GetProjectByGitlabRepo(ctx, output.Repo, output.Tag.Name)
+	if err != nil {
+		return fmt.Errorf("getting project by gitlab repo: %w", err)
+	}
+
+	if output.Deleted {
+		if err := s.repo.DeleteReleaseForProjectByGitTag(ctx, p.ID, output.Tag.Name); err != nil {
+			return fmt.Errorf("deleting release by git tag: %w", err)
+		}
+
+		return nil
+	}
+
+	inst, err := s.settingsGetter.GetGitlabInstance(ctx)
+	if err != nil {
+		return fmt.Errorf("getting gitlab instance: %w", err)
+	}
+
+	last, err := s.getLastPublishedRelease(ctx, p.ID)
+	if err != nil {
+		return fmt.Errorf("getting last published release: %w", err)
+	}
+
+	notesInput := model.GitReleaseNotesInput{GitTagName: &output.Tag.Name}
+	if last != nil {
+		notesInput.PreviousGitTagName = &last.Tag.Name
+	}
+
+	notes, err := s.gitlabManager.GenerateReleaseNotes(ctx, inst, *p.GitlabRepo, notesInput)
+	if err != nil {
+		return fmt.Errorf("generating release notes: %w", err)
+	}
+
+	return s.createDraftReleaseForGitTag(ctx, p, output.Tag, notes, last)
+}
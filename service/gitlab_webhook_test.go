@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	bitbucket "release-manager/bitbucket/mock"
+	github "release-manager/github/mock"
+	gitlab "release-manager/gitlab/mock"
+	repo "release-manager/repository/mock"
+	resend "release-manager/resend/mock"
+	svcerrors "release-manager/service/errors"
+	svc "release-manager/service/mock"
+	"release-manager/service/model"
+	slack "release-manager/slack/mock"
+	storage "release-manager/storage/mock"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReleaseService_HandleGitlabWebhook(t *testing.T) {
+	settings := model.GitlabSettings{
+		Enabled:       true,
+		Token:         "token",
+		WebhookSecret: "secret",
+	}
+	gitlabRepo := model.GitlabRepo{ProjectID: 42, Path: "group/project"}
+	project := model.Project{GitlabRepo: &gitlabRepo, VersionTagPrefix: "v"}
+	tag := model.GitTag{Name: "v1.1.0"}
+
+	testCases := []struct {
+		name      string
+		mockSetup func(*svc.SettingsService, *svc.ProjectService, *gitlab.Client, *repo.ReleaseRepository)
+		wantErr   bool
+	}{
+		{
+			name: "Draft release is created for pushed tag",
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, gitlabClient *gitlab.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGitlabSettings", mock.Anything).Return(settings, nil)
+				gitlabClient.On("ParseTagPushWebhook", mock.Anything, settings.WebhookSecret).Return(model.GitlabTagPushWebhookOutput{Repo: gitlabRepo, Tag: tag}, nil)
+				projectSvc.On("GetProjectByGitlabRepo", mock.Anything, gitlabRepo, tag.Name).Return(project, nil)
+				settingsSvc.On("GetGitlabInstance", mock.Anything).Return(model.GitlabInstance{Token: "token"}, nil)
+				releaseRepo.On("ReadLastPublishedRelease", mock.Anything, mock.Anything).Return(model.Release{Tag: model.GitTag{Name: "v1.0.0"}}, nil)
+				gitlabClient.On("GenerateReleaseNotes", mock.Anything, mock.Anything, gitlabRepo, mock.Anything).Return(model.GitReleaseNotes{Title: "v1.1.0", Notes: "notes"}, nil)
+				releaseRepo.On("CreateRelease", mock.Anything, mock.MatchedBy(func(r model.Release) bool {
+					return r.ReleaseTitle == "v1.1.0" && r.ReleaseNotes == "notes" && r.Status == model.ReleaseStatusDraft && r.Tag == tag
+				})).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Tag which already has a release is ignored",
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, gitlabClient *gitlab.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGitlabSettings", mock.Anything).Return(settings, nil)
+				gitlabClient.On("ParseTagPushWebhook", mock.Anything, settings.WebhookSecret).Return(model.GitlabTagPushWebhookOutput{Repo: gitlabRepo, Tag: tag}, nil)
+				projectSvc.On("GetProjectByGitlabRepo", mock.Anything, gitlabRepo, tag.Name).Return(project, nil)
+				settingsSvc.On("GetGitlabInstance", mock.Anything).Return(model.GitlabInstance{Token: "token"}, nil)
+				releaseRepo.On("ReadLastPublishedRelease", mock.Anything, mock.Anything).Return(model.Release{}, svcerrors.NewReleaseNotFoundError())
+				gitlabClient.On("GenerateReleaseNotes", mock.Anything, mock.Anything, gitlabRepo, mock.Anything).Return(model.GitReleaseNotes{Title: "v1.1.0"}, nil)
+				releaseRepo.On("CreateRelease", mock.Anything, mock.Anything).Return(svcerrors.NewReleaseGitTagAlreadyUsedError())
+			},
+			wantErr: false,
+		},
+		{
+			name: "Release is deleted for deleted tag",
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, gitlabClient *gitlab.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGitlabSettings", mock.Anything).Return(settings, nil)
+				gitlabClient.On("ParseTagPushWebhook", mock.Anything, settings.WebhookSecret).Return(model.GitlabTagPushWebhookOutput{Repo: gitlabRepo, Tag: tag, Deleted: true}, nil)
+				projectSvc.On("GetProjectByGitlabRepo", mock.Anything, gitlabRepo, tag.Name).Return(project, nil)
+				releaseRepo.On("DeleteReleaseForProjectByGitTag", mock.Anything, project.ID, tag.Name).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Invalid webhook token",
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, gitlabClient *gitlab.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGitlabSettings", mock.Anything).Return(settings, nil)
+				gitlabClient.On("ParseTagPushWebhook", mock.Anything, settings.WebhookSecret).Return(model.GitlabTagPushWebhookOutput{}, svcerrors.NewInvalidGitlabTagPushWebhookError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "Project not found",
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, gitlabClient *gitlab.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGitlabSettings", mock.Anything).Return(settings, nil)
+				gitlabClient.On("ParseTagPushWebhook", mock.Anything, settings.WebhookSecret).Return(model.GitlabTagPushWebhookOutput{Repo: gitlabRepo, Tag: tag}, nil)
+				projectSvc.On("GetProjectByGitlabRepo", mock.Anything, gitlabRepo, tag.Name).Return(model.Project{}, svcerrors.NewProjectNotFoundError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "Gitlab integration not enabled",
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, gitlabClient *gitlab.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGitlabSettings", mock.Anything).Return(model.GitlabSettings{Enabled: false}, nil)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authSvc := new(svc.AuthorizationService)
+			projectSvc := new(svc.ProjectService)
+			settingsSvc := new(svc.SettingsService)
+			releaseRepo := new(repo.ReleaseRepository)
+			slackClient := new(slack.Client)
+			githubClient := new(github.Client)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
+
+			tc.mockSetup(settingsSvc, projectSvc, gitlabClient, releaseRepo)
+
+			err := service.HandleGitlabWebhook(context.TODO(), model.GitlabWebhookInput{Event: model.GitlabTagPushEvent, Token: "secret"})
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			settingsSvc.AssertExpectations(t)
+			projectSvc.AssertExpectations(t)
+			gitlabClient.AssertExpectations(t)
+			releaseRepo.AssertExpectations(t)
+		})
+	}
+}
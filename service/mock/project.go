@@ -18,6 +18,46 @@ func (m *ProjectService) GetProject(ctx context.Context, projectID id.Project, a
 	return args.Get(0).(model.Project), args.Error(1)
 }
 
+func (m *ProjectService) ListProjects(ctx context.Context, authUserID id.AuthUser) ([]model.Project, error) {
+	args := m.Called(ctx, authUserID)
+	return args.Get(0).([]model.Project), args.Error(1)
+}
+
+func (m *ProjectService) ListProjectsByGithubRepo(ctx context.Context, repo model.GithubRepo) ([]model.Project, error) {
+	args := m.Called(ctx, repo)
+	return args.Get(0).([]model.Project), args.Error(1)
+}
+
+func (m *ProjectService) GetProjectByGithubRepo(ctx context.Context, repo model.GithubRepo, tagName string) (model.Project, error) {
+	args := m.Called(ctx, repo, tagName)
+	return args.Get(0).(model.Project), args.Error(1)
+}
+
+func (m *ProjectService) GetProjectByGitlabRepo(ctx context.Context, repo model.GitlabRepo, tagName string) (model.Project, error) {
+	args := m.Called(ctx, repo, tagName)
+	return args.Get(0).(model.Project), args.Error(1)
+}
+
+func (m *ProjectService) GetProjectByBitbucketRepo(ctx context.Context, repo model.BitbucketRepo, tagName string) (model.Project, error) {
+	args := m.Called(ctx, repo, tagName)
+	return args.Get(0).(model.Project), args.Error(1)
+}
+
+func (m *ProjectService) IsGitRepoShared(ctx context.Context, p model.Project) (bool, error) {
+	args := m.Called(ctx, p)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *ProjectService) GetMember(ctx context.Context, projectID id.Project, userID id.User, authUserID id.AuthUser) (model.ProjectMember, error) {
+	args := m.Called(ctx, projectID, userID, authUserID)
+	return args.Get(0).(model.ProjectMember), args.Error(1)
+}
+
+func (m *ProjectService) ListEnvironmentApprovers(ctx context.Context, projectID id.Project, envID id.Environment, authUserID id.AuthUser) ([]model.ProjectMember, error) {
+	args := m.Called(ctx, projectID, envID, authUserID)
+	return args.Get(0).([]model.ProjectMember), args.Error(1)
+}
+
 func (m *ProjectService) GetEnvironment(ctx context.Context, projectID id.Project, envID id.Environment, authUserID id.AuthUser) (model.Environment, error) {
 	args := m.Called(ctx, projectID, envID, authUserID)
 	return args.Get(0).(model.Environment), args.Error(1)
@@ -31,3 +31,23 @@ func (m *SettingsService) GetGithubSettings(ctx context.Context) (model.GithubSe
 	args := m.Called(ctx)
 	return args.Get(0).(model.GithubSettings), args.Error(1)
 }
+
+func (m *SettingsService) GetGitlabSettings(ctx context.Context) (model.GitlabSettings, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(model.GitlabSettings), args.Error(1)
+}
+
+func (m *SettingsService) GetGitlabInstance(ctx context.Context) (model.GitlabInstance, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(model.GitlabInstance), args.Error(1)
+}
+
+func (m *SettingsService) GetBitbucketSettings(ctx context.Context) (model.BitbucketSettings, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(model.BitbucketSettings), args.Error(1)
+}
+
+func (m *SettingsService) GetBitbucketInstance(ctx context.Context) (model.BitbucketInstance, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(model.BitbucketInstance), args.Error(1)
+}
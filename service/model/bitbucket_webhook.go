@@ -0,0 +1,21 @@
+package model
+
+const (
+	// BitbucketCloudPushEvent is the value of the X-Event-Key header of push webhooks on Bitbucket Cloud.
+	BitbucketCloudPushEvent = "repo:push"
+	// BitbucketServerRefsChangedEvent is the value of the X-Event-Key header of push webhooks on Bitbucket Server.
+	BitbucketServerRefsChangedEvent = "repo:refs_changed"
+)
+
+type BitbucketWebhookInput struct {
+	Event string
+	// Signature is the HMAC of the payload signed with the webhook secret, e.g. sha256=<hex digest>
+	Signature  string
+	RawPayload []byte
+}
+
+// BitbucketTagWebhookOutput contains the tags deleted by a push, a push can change multiple refs at once.
+type BitbucketTagWebhookOutput struct {
+	Repo            BitbucketRepo
+	DeletedTagNames []string
+}
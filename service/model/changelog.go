@@ -0,0 +1,153 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	ChangelogFormatMarkdown       ChangelogFormat = "markdown"
+	ChangelogFormatKeepAChangelog ChangelogFormat = "keep_a_changelog"
+	ChangelogFormatJSON           ChangelogFormat = "json"
+
+	changelogDateLayout = "2006-01-02"
+)
+
+var (
+	errChangelogFormatInvalid = errors.New("invalid changelog format, must be one of: markdown, keep_a_changelog, json")
+)
+
+type ChangelogFormat string
+
+func (f ChangelogFormat) Validate() error {
+	switch f {
+	case ChangelogFormatMarkdown, ChangelogFormatKeepAChangelog, ChangelogFormatJSON:
+		return nil
+	default:
+		return errChangelogFormatInvalid
+	}
+}
+
+type ExportChangelogParams struct {
+	Format ChangelogFormat
+	// MinVersion and MaxVersion are inclusive, releases without a semantic version are skipped if any of them is set.
+	MinVersion *Version
+	MaxVersion *Version
+}
+
+func (p ExportChangelogParams) Validate() error {
+	if err := p.Format.Validate(); err != nil {
+		return err
+	}
+
+	return p.ListReleasesFilterParams().Validate()
+}
+
+// ListReleasesFilterParams returns params for listing releases of the changelog, the highest version first.
+func (p ExportChangelogParams) ListReleasesFilterParams() ListReleasesFilterParams {
+	return ListReleasesFilterParams{
+		SortBy:     ReleaseSortByVersion,
+		MinVersion: p.MinVersion,
+		MaxVersion: p.MaxVersion,
+	}
+}
+
+// Changelog contains releases which were published, the highest version first.
+// Deprecated and yanked releases are kept, since they were shipped before.
+type Changelog struct {
+	Project  Project
+	Format   ChangelogFormat
+	Releases []Release
+}
+
+func NewChangelog(p Project, releases []Release, format ChangelogFormat) Changelog {
+	c := Changelog{
+		Project:  p,
+		Format:   format,
+		Releases: make([]Release, 0, len(releases)),
+	}
+
+	for _, rls := range releases {
+		switch rls.Status {
+		case ReleaseStatusPublished, ReleaseStatusDeprecated, ReleaseStatusYanked:
+			c.Releases = append(c.Releases, rls)
+		}
+	}
+
+	return c
+}
+
+// Markdown renders the changelog as plain Markdown with release titles as headings.
+func (c Changelog) Markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s changelog\n", c.Project.Name)
+
+	for _, rls := range c.Releases {
+		fmt.Fprintf(&b, "\n## %s (%s)\n\n", rls.ReleaseTitle, rls.Tag.Name)
+		fmt.Fprintf(&b, "Released on %s", rls.CreatedAt.Format(changelogDateLayout))
+		switch rls.Status {
+		case ReleaseStatusDeprecated:
+			b.WriteString(", **deprecated**")
+		case ReleaseStatusYanked:
+			b.WriteString(", **yanked**")
+		}
+		b.WriteString("\n")
+
+		writeChangelogNotes(&b, rls.ReleaseNotes)
+	}
+
+	return b.String()
+}
+
+// KeepAChangelog renders the changelog in the format described in https://keepachangelog.com/en/1.1.0/.
+// Links to the compared tags are added if the GitHub repository is set for the project.
+func (c Changelog) KeepAChangelog() string {
+	var b strings.Builder
+	b.WriteString("# Changelog\n\n")
+	b.WriteString("All notable changes to this project will be documented in this file.\n\n")
+	b.WriteString("The format is based on [Keep a Changelog](https://keepachangelog.com/en/1.1.0/),\n")
+	b.WriteString("and this project adheres to [Semantic Versioning](https://semver.org/spec/v2.0.0.html).\n")
+
+	for _, rls := range c.Releases {
+		fmt.Fprintf(&b, "\n## [%s] - %s", changelogVersion(rls), rls.CreatedAt.Format(changelogDateLayout))
+		if rls.Status == ReleaseStatusYanked {
+			b.WriteString(" [YANKED]")
+		}
+		b.WriteString("\n")
+
+		writeChangelogNotes(&b, rls.ReleaseNotes)
+	}
+
+	if !c.Project.IsGithubRepoSet() || len(c.Releases) == 0 {
+		return b.String()
+	}
+
+	b.WriteString("\n")
+	for i, rls := range c.Releases {
+		// The oldest release links to its tag, the rest link to the comparison with the previous release.
+		link := rls.Tag.URL
+		if i+1 < len(c.Releases) {
+			link = *c.Project.GithubRepo.URL.JoinPath("compare", c.Releases[i+1].Tag.Name+"..."+rls.Tag.Name)
+		}
+
+		fmt.Fprintf(&b, "[%s]: %s\n", changelogVersion(rls), link.String())
+	}
+
+	return b.String()
+}
+
+// changelogVersion returns the version of the release, tag name is used for releases without a semantic version.
+func changelogVersion(rls Release) string {
+	if rls.Version != nil {
+		return rls.Version.String()
+	}
+
+	return rls.Tag.Name
+}
+
+func writeChangelogNotes(b *strings.Builder, notes string) {
+	if notes = strings.TrimSpace(notes); notes != "" {
+		fmt.Fprintf(b, "\n%s\n", notes)
+	}
+}
@@ -0,0 +1,131 @@
+package model
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportChangelogParams_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  ExportChangelogParams
+		wantErr bool
+	}{
+		{
+			name:    "Markdown",
+			params:  ExportChangelogParams{Format: ChangelogFormatMarkdown},
+			wantErr: false,
+		},
+		{
+			name: "Keep a Changelog with version range",
+			params: ExportChangelogParams{
+				Format:     ChangelogFormatKeepAChangelog,
+				MinVersion: &Version{Major: 1},
+				MaxVersion: &Version{Major: 2},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Invalid format",
+			params:  ExportChangelogParams{Format: "html"},
+			wantErr: true,
+		},
+		{
+			name: "Inverted version range",
+			params: ExportChangelogParams{
+				Format:     ChangelogFormatJSON,
+				MinVersion: &Version{Major: 2},
+				MaxVersion: &Version{Major: 1},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewChangelog(t *testing.T) {
+	releases := []Release{
+		{ReleaseTitle: "Draft", Status: ReleaseStatusDraft},
+		{ReleaseTitle: "Ready", Status: ReleaseStatusReady},
+		{ReleaseTitle: "Published", Status: ReleaseStatusPublished},
+		{ReleaseTitle: "Deprecated", Status: ReleaseStatusDeprecated},
+		{ReleaseTitle: "Yanked", Status: ReleaseStatusYanked},
+	}
+
+	c := NewChangelog(Project{}, releases, ChangelogFormatMarkdown)
+
+	assert.Equal(t, []Release{releases[2], releases[3], releases[4]}, c.Releases)
+}
+
+func TestChangelog_Render(t *testing.T) {
+	repoURL, _ := url.Parse("https://github.com/owner/repo")
+	firstTagURL, _ := url.Parse("https://github.com/owner/repo/releases/tag/v1.0.0")
+
+	releases := []Release{
+		{
+			ReleaseTitle: "Export",
+			ReleaseNotes: "- Export to CSV\n",
+			Status:       ReleaseStatusPublished,
+			Tag:          GitTag{Name: "v1.1.0"},
+			Version:      &Version{Major: 1, Minor: 1},
+			CreatedAt:    time.Date(2024, 11, 1, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			ReleaseTitle: "First release",
+			Status:       ReleaseStatusYanked,
+			Tag:          GitTag{Name: "v1.0.0", URL: *firstTagURL},
+			Version:      &Version{Major: 1},
+			CreatedAt:    time.Date(2024, 10, 1, 10, 0, 0, 0, time.UTC),
+		},
+	}
+
+	tests := []struct {
+		name   string
+		render func(c Changelog) string
+		want   string
+	}{
+		{
+			name:   "Markdown",
+			render: Changelog.Markdown,
+			want: "# Release Manager changelog\n" +
+				"\n## Export (v1.1.0)\n\nReleased on 2024-11-01\n\n- Export to CSV\n" +
+				"\n## First release (v1.0.0)\n\nReleased on 2024-10-01, **yanked**\n",
+		},
+		{
+			name:   "Keep a Changelog",
+			render: Changelog.KeepAChangelog,
+			want: "# Changelog\n\n" +
+				"All notable changes to this project will be documented in this file.\n\n" +
+				"The format is based on [Keep a Changelog](https://keepachangelog.com/en/1.1.0/),\n" +
+				"and this project adheres to [Semantic Versioning](https://semver.org/spec/v2.0.0.html).\n" +
+				"\n## [1.1.0] - 2024-11-01\n\n- Export to CSV\n" +
+				"\n## [1.0.0] - 2024-10-01 [YANKED]\n" +
+				"\n[1.1.0]: https://github.com/owner/repo/compare/v1.0.0...v1.1.0\n" +
+				"[1.0.0]: https://github.com/owner/repo/releases/tag/v1.0.0\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewChangelog(Project{
+				Name:       "Release Manager",
+				GithubRepo: &GithubRepo{URL: *repoURL, OwnerSlug: "owner", RepoSlug: "repo"},
+			}, releases, ChangelogFormatMarkdown)
+
+			assert.Equal(t, tt.want, tt.render(c))
+		})
+	}
+}
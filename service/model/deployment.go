@@ -7,11 +7,50 @@ import (
 	"release-manager/pkg/id"
 )
 
+const (
+	DeploymentStatusPendingApproval DeploymentStatus = "pending_approval"
+	DeploymentStatusRejected        DeploymentStatus = "rejected"
+	DeploymentStatusDeployed        DeploymentStatus = "deployed"
+
+	DeploymentApprovalDecisionApproved DeploymentApprovalDecision = "approved"
+	DeploymentApprovalDecisionRejected DeploymentApprovalDecision = "rejected"
+
+	GithubDeploymentStateInProgress GithubDeploymentState = "in_progress"
+	GithubDeploymentStateSuccess    GithubDeploymentState = "success"
+	GithubDeploymentStateFailure    GithubDeploymentState = "failure"
+)
+
 var (
-	errReleaseIDRequired     = errors.New("release id is required")
-	errEnvironmentIDRequired = errors.New("environment id is required")
+	errReleaseIDRequired              = errors.New("release id is required")
+	errEnvironmentIDRequired          = errors.New("environment id is required")
+	errDeploymentStatusInvalid        = errors.New("invalid deployment status")
+	errDeploymentNotPendingApproval   = errors.New("deployment is not pending approval")
+	errDeploymentApprovedByRequester  = errors.New("deployment cannot be approved or rejected by the user who requested it")
+	errDeploymentApproverAlreadyVoted = errors.New("user has already approved or rejected the deployment")
+	errDeploymentApproverNotAllowed   = errors.New("user is not an approver for the environment")
+
+	validDeploymentStatuses = map[DeploymentStatus]bool{
+		DeploymentStatusPendingApproval: true,
+		DeploymentStatusRejected:        true,
+		DeploymentStatusDeployed:        true,
+	}
 )
 
+type DeploymentStatus string
+
+func (s DeploymentStatus) Validate() error {
+	if _, exists := validDeploymentStatuses[s]; exists {
+		return nil
+	}
+
+	return errDeploymentStatusInvalid
+}
+
+type DeploymentApprovalDecision string
+
+// GithubDeploymentState is the state of a GitHub deployment status.
+type GithubDeploymentState string
+
 type CreateDeploymentInput struct {
 	ReleaseID     id.Release
 	EnvironmentID id.Environment
@@ -28,25 +67,157 @@ func (i CreateDeploymentInput) Validate() error {
 }
 
 type Deployment struct {
-	ID               id.Deployment
-	Release          Release
-	Environment      Environment
+	ID          id.Deployment
+	Release     Release
+	Environment Environment
+	Status      DeploymentStatus
+	Approvals   []DeploymentApproval
+	// DeployedByUserID is the user who requested the deployment.
 	DeployedByUserID id.AuthUser
-	DeployedAt       time.Time
+	// DeployedAt is the time of the request until the deployment is approved,
+	// then it is the time of the final approval.
+	DeployedAt time.Time
+	// GithubDeploymentID is the ID of the deployment mirrored to the GitHub repo of the project,
+	// nil if the deployment was not mirrored.
+	GithubDeploymentID *int64
+}
+
+type DeploymentApproval struct {
+	UserID    id.AuthUser
+	Decision  DeploymentApprovalDecision
+	CreatedAt time.Time
 }
 
+// NewDeployment creates a deployment, if the environment requires approvals,
+// the deployment is pending until the approval policy is satisfied.
 func NewDeployment(rls Release, env Environment, deployedByUserID id.AuthUser) Deployment {
+	status := DeploymentStatusDeployed
+	if env.ApprovalPolicy.IsApprovalRequired() {
+		status = DeploymentStatusPendingApproval
+	}
+
 	return Deployment{
 		ID:               id.NewDeployment(),
 		Release:          rls,
 		Environment:      env,
+		Status:           status,
+		Approvals:        []DeploymentApproval{},
 		DeployedByUserID: deployedByUserID,
 		DeployedAt:       time.Now(),
 	}
 }
 
+func (d *Deployment) IsPendingApproval() bool {
+	return d.Status == DeploymentStatusPendingApproval
+}
+
+func (d *Deployment) IsMirroredToGithub() bool {
+	return d.GithubDeploymentID != nil
+}
+
+// GithubDeploymentState maps the status to the state of the GitHub deployment,
+// a deployment pending approval is in progress until it is approved or rejected.
+func (d *Deployment) GithubDeploymentState() GithubDeploymentState {
+	switch d.Status {
+	case DeploymentStatusDeployed:
+		return GithubDeploymentStateSuccess
+	case DeploymentStatusRejected:
+		return GithubDeploymentStateFailure
+	default:
+		return GithubDeploymentStateInProgress
+	}
+}
+
+// Approve records the approval, once the number of approvals reaches the number required
+// by the environment approval policy, the deployment is deployed.
+func (d *Deployment) Approve(approver ProjectMember) error {
+	if err := d.addApproval(approver, DeploymentApprovalDecisionApproved); err != nil {
+		return err
+	}
+
+	if d.countApprovals() >= d.Environment.ApprovalPolicy.RequiredApprovals {
+		d.Status = DeploymentStatusDeployed
+		d.DeployedAt = time.Now()
+	}
+
+	return nil
+}
+
+// Reject records the rejection, a single rejection rejects the whole deployment.
+func (d *Deployment) Reject(approver ProjectMember) error {
+	if err := d.addApproval(approver, DeploymentApprovalDecisionRejected); err != nil {
+		return err
+	}
+
+	d.Status = DeploymentStatusRejected
+
+	return nil
+}
+
+func (d *Deployment) addApproval(approver ProjectMember, decision DeploymentApprovalDecision) error {
+	if !d.IsPendingApproval() {
+		return errDeploymentNotPendingApproval
+	}
+
+	userID := id.AuthUser(approver.User.ID)
+	if userID == d.DeployedByUserID {
+		return errDeploymentApprovedByRequester
+	}
+	if !d.Environment.ApprovalPolicy.CanApprove(approver) {
+		return errDeploymentApproverNotAllowed
+	}
+	for _, a := range d.Approvals {
+		if a.UserID == userID {
+			return errDeploymentApproverAlreadyVoted
+		}
+	}
+
+	d.Approvals = append(d.Approvals, DeploymentApproval{
+		UserID:    userID,
+		Decision:  decision,
+		CreatedAt: time.Now(),
+	})
+
+	return nil
+}
+
+func (d *Deployment) countApprovals() int {
+	count := 0
+	for _, a := range d.Approvals {
+		if a.Decision == DeploymentApprovalDecisionApproved {
+			count++
+		}
+	}
+
+	return count
+}
+
 type ListDeploymentsFilterParams struct {
 	ReleaseID     *id.Release
 	EnvironmentID *id.Environment
-	LatestOnly    *bool
+	Status        *DeploymentStatus
+	// LatestOnly returns only the latest deployment which was deployed (not pending or rejected).
+	LatestOnly *bool
+}
+
+func (p ListDeploymentsFilterParams) Validate() error {
+	if p.Status != nil {
+		return p.Status.Validate()
+	}
+
+	return nil
+}
+
+type DeploymentApprovalRequestEmailData struct {
+	ProjectName     string
+	ReleaseTitle    string
+	EnvironmentName string
+}
+
+func NewDeploymentApprovalRequestEmailData(projectName string, dpl Deployment) DeploymentApprovalRequestEmailData {
+	return DeploymentApprovalRequestEmailData{
+		ProjectName:     projectName,
+		ReleaseTitle:    dpl.Release.ReleaseTitle,
+		EnvironmentName: dpl.Environment.Name,
+	}
 }
@@ -5,6 +5,7 @@ import (
 
 	"release-manager/pkg/id"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -54,3 +55,162 @@ func TestCreateDeploymentInput_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestNewDeployment(t *testing.T) {
+	tests := []struct {
+		name       string
+		env        Environment
+		wantStatus DeploymentStatus
+	}{
+		{
+			name:       "No approval required",
+			env:        Environment{},
+			wantStatus: DeploymentStatusDeployed,
+		},
+		{
+			name: "Approval required",
+			env: Environment{
+				ApprovalPolicy: ApprovalPolicy{RequiredApprovals: 1, ApproverRoles: []ProjectRole{ProjectRoleOwner}},
+			},
+			wantStatus: DeploymentStatusPendingApproval,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dpl := NewDeployment(Release{}, tt.env, id.AuthUser(uuid.New()))
+			assert.Equal(t, tt.wantStatus, dpl.Status)
+		})
+	}
+}
+
+func TestDeployment_Approve(t *testing.T) {
+	requesterID := id.AuthUser(uuid.New())
+	approver := ProjectMember{User: User{ID: id.User(uuid.New())}, ProjectRole: ProjectRoleOwner}
+	secondApprover := ProjectMember{User: User{ID: id.User(uuid.New())}, ProjectRole: ProjectRoleOwner}
+
+	newDeployment := func(requiredApprovals int) Deployment {
+		env := Environment{
+			ApprovalPolicy: ApprovalPolicy{RequiredApprovals: requiredApprovals, ApproverRoles: []ProjectRole{ProjectRoleOwner}},
+		}
+		return NewDeployment(Release{}, env, requesterID)
+	}
+
+	tests := []struct {
+		name       string
+		dpl        Deployment
+		approvers  []ProjectMember
+		wantStatus DeploymentStatus
+		wantErr    bool
+	}{
+		{
+			name:       "Single approval deploys",
+			dpl:        newDeployment(1),
+			approvers:  []ProjectMember{approver},
+			wantStatus: DeploymentStatusDeployed,
+		},
+		{
+			name:       "Not enough approvals",
+			dpl:        newDeployment(2),
+			approvers:  []ProjectMember{approver},
+			wantStatus: DeploymentStatusPendingApproval,
+		},
+		{
+			name:       "Two approvals deploy",
+			dpl:        newDeployment(2),
+			approvers:  []ProjectMember{approver, secondApprover},
+			wantStatus: DeploymentStatusDeployed,
+		},
+		{
+			name:      "Same approver twice",
+			dpl:       newDeployment(2),
+			approvers: []ProjectMember{approver, approver},
+			wantErr:   true,
+		},
+		{
+			name:      "Approved by requester",
+			dpl:       newDeployment(1),
+			approvers: []ProjectMember{{User: User{ID: id.User(requesterID)}, ProjectRole: ProjectRoleOwner}},
+			wantErr:   true,
+		},
+		{
+			name:      "Approver without allowed role",
+			dpl:       newDeployment(1),
+			approvers: []ProjectMember{{User: User{ID: id.User(uuid.New())}, ProjectRole: ProjectRoleEditor}},
+			wantErr:   true,
+		},
+		{
+			name:      "Deployment not pending",
+			dpl:       newDeployment(0),
+			approvers: []ProjectMember{approver},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			for _, a := range tt.approvers {
+				if err = tt.dpl.Approve(a); err != nil {
+					break
+				}
+			}
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantStatus, tt.dpl.Status)
+				assert.Len(t, tt.dpl.Approvals, len(tt.approvers))
+			}
+		})
+	}
+}
+
+func TestDeployment_Reject(t *testing.T) {
+	requesterID := id.AuthUser(uuid.New())
+	approver := ProjectMember{User: User{ID: id.User(uuid.New())}, ProjectRole: ProjectRoleOwner}
+	env := Environment{
+		ApprovalPolicy: ApprovalPolicy{RequiredApprovals: 2, ApproverRoles: []ProjectRole{ProjectRoleOwner}},
+	}
+
+	dpl := NewDeployment(Release{}, env, requesterID)
+	assert.NoError(t, dpl.Reject(approver))
+	assert.Equal(t, DeploymentStatusRejected, dpl.Status)
+	assert.Equal(t, DeploymentApprovalDecisionRejected, dpl.Approvals[0].Decision)
+
+	// Rejected deployment cannot be approved anymore
+	secondApprover := ProjectMember{User: User{ID: id.User(uuid.New())}, ProjectRole: ProjectRoleOwner}
+	assert.Error(t, dpl.Approve(secondApprover))
+}
+
+func TestDeployment_GithubDeploymentState(t *testing.T) {
+	testCases := []struct {
+		name   string
+		status DeploymentStatus
+		want   GithubDeploymentState
+	}{
+		{
+			name:   "Pending approval",
+			status: DeploymentStatusPendingApproval,
+			want:   GithubDeploymentStateInProgress,
+		},
+		{
+			name:   "Deployed",
+			status: DeploymentStatusDeployed,
+			want:   GithubDeploymentStateSuccess,
+		},
+		{
+			name:   "Rejected",
+			status: DeploymentStatusRejected,
+			want:   GithubDeploymentStateFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dpl := Deployment{Status: tc.status}
+			assert.Equal(t, tc.want, dpl.GithubDeploymentState())
+		})
+	}
+}
@@ -3,6 +3,7 @@ package model
 import (
 	"errors"
 	"net/url"
+	"slices"
 	"time"
 
 	"release-manager/pkg/id"
@@ -13,6 +14,9 @@ var (
 	errEnvironmentInvalidServiceURL        = errors.New("invalid service url")
 	errEnvironmentServiceURLMustBeAbsolute = errors.New("service url must be absolute")
 	errEnvironmentNameRequired             = errors.New("environment name is required")
+	errApprovalPolicyNegativeApprovals     = errors.New("required approvals must not be negative")
+	errApprovalPolicyApproversRequired     = errors.New("approver users or roles are required when approvals are required")
+	errApprovalPolicyNotEnoughApprovers    = errors.New("required approvals exceed the number of approver users")
 )
 
 type Environment struct {
@@ -20,19 +24,63 @@ type Environment struct {
 	ProjectID  id.Project
 	Name       string
 	ServiceURL url.URL
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
+	// ApprovalPolicy defines who has to approve a deployment to the environment before it is recorded as deployed.
+	ApprovalPolicy ApprovalPolicy
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// ApprovalPolicy requires RequiredApprovals approvals from project members
+// listed in ApproverUserIDs or having one of ApproverRoles.
+// If RequiredApprovals is zero, deployments do not need any approval.
+type ApprovalPolicy struct {
+	RequiredApprovals int
+	ApproverUserIDs   []id.User
+	ApproverRoles     []ProjectRole
+}
+
+func (p ApprovalPolicy) IsApprovalRequired() bool {
+	return p.RequiredApprovals > 0
+}
+
+func (p ApprovalPolicy) CanApprove(m ProjectMember) bool {
+	return slices.Contains(p.ApproverUserIDs, m.User.ID) || slices.Contains(p.ApproverRoles, m.ProjectRole)
+}
+
+func (p ApprovalPolicy) Validate() error {
+	if p.RequiredApprovals < 0 {
+		return errApprovalPolicyNegativeApprovals
+	}
+	for _, role := range p.ApproverRoles {
+		if err := role.Validate(); err != nil {
+			return err
+		}
+	}
+	if !p.IsApprovalRequired() {
+		return nil
+	}
+	if len(p.ApproverUserIDs) == 0 && len(p.ApproverRoles) == 0 {
+		return errApprovalPolicyApproversRequired
+	}
+	// With roles, number of approvers is not known upfront, it changes with project members.
+	if len(p.ApproverRoles) == 0 && p.RequiredApprovals > len(p.ApproverUserIDs) {
+		return errApprovalPolicyNotEnoughApprovers
+	}
+
+	return nil
 }
 
 type CreateEnvironmentInput struct {
-	ProjectID     id.Project
-	Name          string
-	ServiceRawURL string
+	ProjectID      id.Project
+	Name           string
+	ServiceRawURL  string
+	ApprovalPolicy ApprovalPolicy
 }
 
 type UpdateEnvironmentInput struct {
-	Name          *string
-	ServiceRawURL *string
+	Name           *string
+	ServiceRawURL  *string
+	ApprovalPolicy *ApprovalPolicy
 }
 
 func NewEnvironment(c CreateEnvironmentInput) (Environment, error) {
@@ -43,12 +91,13 @@ func NewEnvironment(c CreateEnvironmentInput) (Environment, error) {
 
 	now := time.Now()
 	env := Environment{
-		ID:         id.NewEnvironment(),
-		ProjectID:  c.ProjectID,
-		Name:       c.Name,
-		ServiceURL: u,
-		CreatedAt:  now,
-		UpdatedAt:  now,
+		ID:             id.NewEnvironment(),
+		ProjectID:      c.ProjectID,
+		Name:           c.Name,
+		ServiceURL:     u,
+		ApprovalPolicy: c.ApprovalPolicy,
+		CreatedAt:      now,
+		UpdatedAt:      now,
 	}
 
 	if err := env.Validate(); err != nil {
@@ -67,7 +116,7 @@ func (e *Environment) Validate() error {
 		return errEnvironmentServiceURLMustBeAbsolute
 	}
 
-	return nil
+	return e.ApprovalPolicy.Validate()
 }
 
 func (e *Environment) Update(u UpdateEnvironmentInput) error {
@@ -84,6 +133,10 @@ func (e *Environment) Update(u UpdateEnvironmentInput) error {
 		e.Name = *u.Name
 	}
 
+	if u.ApprovalPolicy != nil {
+		e.ApprovalPolicy = *u.ApprovalPolicy
+	}
+
 	e.UpdatedAt = time.Now()
 
 	return e.Validate()
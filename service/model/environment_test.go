@@ -7,6 +7,7 @@ import (
 	"release-manager/pkg/pointer"
 	"release-manager/pkg/urlx"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -173,3 +174,87 @@ func TestIsServiceURLSet(t *testing.T) {
 		})
 	}
 }
+
+func TestApprovalPolicy_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  ApprovalPolicy
+		wantErr bool
+	}{
+		{
+			name:    "Valid - no approval required",
+			policy:  ApprovalPolicy{},
+			wantErr: false,
+		},
+		{
+			name: "Valid - approver users",
+			policy: ApprovalPolicy{
+				RequiredApprovals: 2,
+				ApproverUserIDs:   []id.User{id.User(uuid.New()), id.User(uuid.New())},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Valid - approver roles",
+			policy: ApprovalPolicy{
+				RequiredApprovals: 3,
+				ApproverRoles:     []ProjectRole{ProjectRoleOwner},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Invalid - negative required approvals",
+			policy: ApprovalPolicy{
+				RequiredApprovals: -1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Invalid - no approvers",
+			policy: ApprovalPolicy{
+				RequiredApprovals: 1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Invalid - not enough approver users",
+			policy: ApprovalPolicy{
+				RequiredApprovals: 2,
+				ApproverUserIDs:   []id.User{id.User(uuid.New())},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Invalid - unknown role",
+			policy: ApprovalPolicy{
+				RequiredApprovals: 1,
+				ApproverRoles:     []ProjectRole{"admin"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestApprovalPolicy_CanApprove(t *testing.T) {
+	userID := id.User(uuid.New())
+	policy := ApprovalPolicy{
+		RequiredApprovals: 1,
+		ApproverUserIDs:   []id.User{userID},
+		ApproverRoles:     []ProjectRole{ProjectRoleOwner},
+	}
+
+	assert.True(t, policy.CanApprove(ProjectMember{User: User{ID: userID}, ProjectRole: ProjectRoleViewer}))
+	assert.True(t, policy.CanApprove(ProjectMember{User: User{ID: id.User(uuid.New())}, ProjectRole: ProjectRoleOwner}))
+	assert.False(t, policy.CanApprove(ProjectMember{User: User{ID: id.User(uuid.New())}, ProjectRole: ProjectRoleEditor}))
+}
@@ -0,0 +1,168 @@
+package model
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	GitTagSortByCommitDate GitTagSortBy = "commit_date"
+	GitTagSortByVersion    GitTagSortBy = "version"
+)
+
+var (
+	errGitTagSortByInvalid = errors.New("invalid sort by, must be one of: commit_date, version")
+)
+
+type GitTagSortBy string
+
+func (s GitTagSortBy) Validate() error {
+	switch s {
+	case GitTagSortByCommitDate, GitTagSortByVersion:
+		return nil
+	default:
+		return errGitTagSortByInvalid
+	}
+}
+
+type ListGitTagsParams struct {
+	// Prefix keeps only tags starting with the prefix.
+	Prefix *string
+	// Search keeps only tags containing the text, letter case is ignored.
+	Search *string
+	// SortBy orders tags by commit_date if not set, the newest or the highest version is first.
+	// Tags without a semantic version are placed last when sorted by version.
+	SortBy GitTagSortBy
+	// ProjectTagPrefix keeps only tags of the project, it is set by the service if the repo is shared by several projects.
+	ProjectTagPrefix string
+}
+
+func (p ListGitTagsParams) Validate() error {
+	if p.SortBy != "" {
+		if err := p.SortBy.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p ListGitTagsParams) SortByOrDefault() GitTagSortBy {
+	if p.SortBy == "" {
+		return GitTagSortByCommitDate
+	}
+
+	return p.SortBy
+}
+
+func (p ListGitTagsParams) matches(tagName string) bool {
+	if !strings.HasPrefix(tagName, p.ProjectTagPrefix) {
+		return false
+	}
+	if p.Prefix != nil && !strings.HasPrefix(tagName, *p.Prefix) {
+		return false
+	}
+	if p.Search != nil && !strings.Contains(strings.ToLower(tagName), strings.ToLower(*p.Search)) {
+		return false
+	}
+
+	return true
+}
+
+// RepoGitTag is a git tag listed from the repo of the project.
+type RepoGitTag struct {
+	Tag GitTag
+	// CommittedAt is the date of the tagged commit, nil if the tag does not point to a commit.
+	CommittedAt   *time.Time
+	UsedByRelease bool
+}
+
+// NewRepoGitTagList filters and sorts the tags of the repo, tags of existing releases are marked as used.
+// Versions are parsed from tags using the version tag prefix of the project.
+func NewRepoGitTagList(tags []RepoGitTag, params ListGitTagsParams, versionTagPrefix string, usedTagNames []string) []RepoGitTag {
+	list := make([]RepoGitTag, 0, len(tags))
+	for _, t := range tags {
+		if !params.matches(t.Tag.Name) {
+			continue
+		}
+
+		t.UsedByRelease = slices.Contains(usedTagNames, t.Tag.Name)
+		list = append(list, t)
+	}
+
+	if params.SortByOrDefault() == GitTagSortByVersion {
+		slices.SortStableFunc(list, func(a, b RepoGitTag) int {
+			return compareRepoGitTagsByVersion(a, b, versionTagPrefix)
+		})
+	} else {
+		slices.SortStableFunc(list, compareRepoGitTagsByCommitDate)
+	}
+
+	return list
+}
+
+// compareRepoGitTagsByCommitDate orders the newest tags first, tags without a commit date are last.
+func compareRepoGitTagsByCommitDate(a, b RepoGitTag) int {
+	switch {
+	case a.CommittedAt == nil && b.CommittedAt == nil:
+		return strings.Compare(a.Tag.Name, b.Tag.Name)
+	case a.CommittedAt == nil:
+		return 1
+	case b.CommittedAt == nil:
+		return -1
+	case !a.CommittedAt.Equal(*b.CommittedAt):
+		return b.CommittedAt.Compare(*a.CommittedAt)
+	default:
+		return strings.Compare(a.Tag.Name, b.Tag.Name)
+	}
+}
+
+// compareRepoGitTagsByVersion orders the highest versions first, tags without a version are last ordered by commit date.
+func compareRepoGitTagsByVersion(a, b RepoGitTag, versionTagPrefix string) int {
+	aVersion, aOK := ParseVersionFromTag(a.Tag.Name, versionTagPrefix)
+	bVersion, bOK := ParseVersionFromTag(b.Tag.Name, versionTagPrefix)
+
+	switch {
+	case !aOK && !bOK:
+		return compareRepoGitTagsByCommitDate(a, b)
+	case !aOK:
+		return 1
+	case !bOK:
+		return -1
+	}
+
+	if c := bVersion.Compare(aVersion); c != 0 {
+		return c
+	}
+
+	return compareRepoGitTagsByCommitDate(a, b)
+}
+
+// PreviousVersionTagName returns the tag with the highest version lower than the version of the tag.
+// Only tags with the version tag prefix are considered, so tags of other projects sharing the repo are skipped.
+// The second return value is false if the tag has no version or no tag precedes it.
+func PreviousVersionTagName(tags []RepoGitTag, tagName, versionTagPrefix string) (string, bool) {
+	version, ok := ParseVersionFromTag(tagName, versionTagPrefix)
+	if !ok {
+		return "", false
+	}
+
+	var (
+		previous        string
+		previousVersion Version
+		found           bool
+	)
+	for _, t := range tags {
+		v, ok := ParseVersionFromTag(t.Tag.Name, versionTagPrefix)
+		if !ok || v.Compare(version) >= 0 {
+			continue
+		}
+		if !found || v.GreaterThan(previousVersion) {
+			previous, previousVersion, found = t.Tag.Name, v, true
+		}
+	}
+
+	return previous, found
+}
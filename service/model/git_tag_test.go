@@ -0,0 +1,161 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"release-manager/pkg/pointer"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRepoGitTagList(t *testing.T) {
+	older := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+
+	tags := []RepoGitTag{
+		{Tag: GitTag{Name: "v1.10.0"}, CommittedAt: &older},
+		{Tag: GitTag{Name: "nightly"}, CommittedAt: &newer},
+		{Tag: GitTag{Name: "v1.9.0"}, CommittedAt: &newer},
+		{Tag: GitTag{Name: "v2.0.0-rc.1"}},
+	}
+
+	tests := []struct {
+		name      string
+		params    ListGitTagsParams
+		used      []string
+		wantNames []string
+		wantUsed  []bool
+	}{
+		{
+			name:      "Sorted by commit date by default",
+			params:    ListGitTagsParams{},
+			used:      []string{"v1.9.0"},
+			wantNames: []string{"nightly", "v1.9.0", "v1.10.0", "v2.0.0-rc.1"},
+			wantUsed:  []bool{false, true, false, false},
+		},
+		{
+			name:      "Sorted by version",
+			params:    ListGitTagsParams{SortBy: GitTagSortByVersion},
+			wantNames: []string{"v2.0.0-rc.1", "v1.10.0", "v1.9.0", "nightly"},
+			wantUsed:  []bool{false, false, false, false},
+		},
+		{
+			name:      "Filtered by prefix",
+			params:    ListGitTagsParams{Prefix: pointer.StringPtr("v1."), SortBy: GitTagSortByVersion},
+			wantNames: []string{"v1.10.0", "v1.9.0"},
+			wantUsed:  []bool{false, false},
+		},
+		{
+			name:      "Filtered by project tag prefix",
+			params:    ListGitTagsParams{ProjectTagPrefix: "v1."},
+			wantNames: []string{"v1.9.0", "v1.10.0"},
+			wantUsed:  []bool{false, false},
+		},
+		{
+			name:      "Filtered by search ignoring letter case",
+			params:    ListGitTagsParams{Search: pointer.StringPtr("RC")},
+			wantNames: []string{"v2.0.0-rc.1"},
+			wantUsed:  []bool{false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := NewRepoGitTagList(tags, tt.params, "v", tt.used)
+
+			names := make([]string, 0, len(list))
+			used := make([]bool, 0, len(list))
+			for _, tag := range list {
+				names = append(names, tag.Tag.Name)
+				used = append(used, tag.UsedByRelease)
+			}
+
+			assert.Equal(t, tt.wantNames, names)
+			assert.Equal(t, tt.wantUsed, used)
+		})
+	}
+}
+
+func TestListGitTagsParams_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  ListGitTagsParams
+		wantErr bool
+	}{
+		{
+			name:    "Default params",
+			params:  ListGitTagsParams{},
+			wantErr: false,
+		},
+		{
+			name:    "Sorted by version",
+			params:  ListGitTagsParams{SortBy: GitTagSortByVersion},
+			wantErr: false,
+		},
+		{
+			name:    "Unknown sort by",
+			params:  ListGitTagsParams{SortBy: "name"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPreviousVersionTagName(t *testing.T) {
+	tags := []RepoGitTag{
+		{Tag: GitTag{Name: "api/v1.0.0"}},
+		{Tag: GitTag{Name: "web/v1.5.0"}},
+		{Tag: GitTag{Name: "api/v1.2.0-rc.1"}},
+		{Tag: GitTag{Name: "api/v1.2.0"}},
+		{Tag: GitTag{Name: "api/nightly"}},
+	}
+
+	tests := []struct {
+		name      string
+		tagName   string
+		wantName  string
+		wantFound bool
+	}{
+		{
+			name:      "Highest lower version of the prefix",
+			tagName:   "api/v1.3.0",
+			wantName:  "api/v1.2.0",
+			wantFound: true,
+		},
+		{
+			name:      "Prerelease precedes the release",
+			tagName:   "api/v1.2.0",
+			wantName:  "api/v1.2.0-rc.1",
+			wantFound: true,
+		},
+		{
+			name:      "First version of the prefix",
+			tagName:   "api/v1.0.0",
+			wantFound: false,
+		},
+		{
+			name:      "Tag without version",
+			tagName:   "api/nightly",
+			wantFound: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, found := PreviousVersionTagName(tags, tt.tagName, "api/v")
+			assert.Equal(t, tt.wantFound, found)
+			assert.Equal(t, tt.wantName, name)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package model
+
+import "errors"
+
+const (
+	GithubMakeLatestTrue  GithubMakeLatest = "true"
+	GithubMakeLatestFalse GithubMakeLatest = "false"
+	// GithubMakeLatestLegacy lets GitHub pick the latest release by the creation date and the semantic version.
+	GithubMakeLatestLegacy GithubMakeLatest = "legacy"
+)
+
+var (
+	errGithubMakeLatestInvalid      = errors.New("invalid make latest, must be one of: true, false, legacy")
+	errGithubReleaseLatestForbidden = errors.New("draft and prerelease GitHub releases cannot be made latest")
+)
+
+type GithubMakeLatest string
+
+func (m GithubMakeLatest) Validate() error {
+	switch m {
+	case GithubMakeLatestTrue, GithubMakeLatestFalse, GithubMakeLatestLegacy:
+		return nil
+	default:
+		return errGithubMakeLatestInvalid
+	}
+}
+
+// GithubReleaseOptions are flags of the GitHub release, they are sent to GitHub whenever the GitHub release is upserted.
+type GithubReleaseOptions struct {
+	Draft      bool
+	Prerelease bool
+	// MakeLatest is not sent to GitHub when it is empty, GitHub then makes the release latest.
+	MakeLatest GithubMakeLatest
+}
+
+// NewGithubReleaseOptions marks releases with a prerelease version as prereleases, so they do not become latest on GitHub.
+func NewGithubReleaseOptions(v *Version) GithubReleaseOptions {
+	if v != nil && v.IsPrerelease() {
+		return GithubReleaseOptions{
+			Prerelease: true,
+			MakeLatest: GithubMakeLatestFalse,
+		}
+	}
+
+	return GithubReleaseOptions{
+		MakeLatest: GithubMakeLatestTrue,
+	}
+}
+
+// GithubReleaseOptionsInput changes only the options which are set.
+type GithubReleaseOptionsInput struct {
+	Draft      *bool
+	Prerelease *bool
+	MakeLatest *GithubMakeLatest
+}
+
+// Apply returns the options changed by the input. If the release becomes a draft or a prerelease
+// and make latest is not set, the release is no longer made latest.
+func (i GithubReleaseOptionsInput) Apply(o GithubReleaseOptions) GithubReleaseOptions {
+	if i.Draft != nil {
+		o.Draft = *i.Draft
+	}
+	if i.Prerelease != nil {
+		o.Prerelease = *i.Prerelease
+	}
+
+	switch {
+	case i.MakeLatest != nil:
+		o.MakeLatest = *i.MakeLatest
+	case (o.Draft || o.Prerelease) && (o.MakeLatest == GithubMakeLatestTrue || o.MakeLatest == ""):
+		o.MakeLatest = GithubMakeLatestFalse
+	}
+
+	return o
+}
+
+func (o GithubReleaseOptions) Validate() error {
+	if o.MakeLatest != "" {
+		if err := o.MakeLatest.Validate(); err != nil {
+			return err
+		}
+	}
+	if (o.Draft || o.Prerelease) && o.MakeLatest == GithubMakeLatestTrue {
+		return errGithubReleaseLatestForbidden
+	}
+
+	return nil
+}
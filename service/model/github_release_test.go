@@ -0,0 +1,138 @@
+package model
+
+import (
+	"testing"
+
+	"release-manager/pkg/pointer"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGithubReleaseOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		version *Version
+		want    GithubReleaseOptions
+	}{
+		{
+			name:    "Without version",
+			version: nil,
+			want:    GithubReleaseOptions{MakeLatest: GithubMakeLatestTrue},
+		},
+		{
+			name:    "Stable version",
+			version: &Version{Major: 1, Minor: 2},
+			want:    GithubReleaseOptions{MakeLatest: GithubMakeLatestTrue},
+		},
+		{
+			name:    "Prerelease version",
+			version: &Version{Major: 1, Minor: 2, Prerelease: "rc.1"},
+			want:    GithubReleaseOptions{Prerelease: true, MakeLatest: GithubMakeLatestFalse},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NewGithubReleaseOptions(tt.version))
+		})
+	}
+}
+
+func TestGithubReleaseOptionsInput_Apply(t *testing.T) {
+	legacy := GithubMakeLatestLegacy
+	makeLatest := GithubMakeLatestTrue
+
+	tests := []struct {
+		name    string
+		input   GithubReleaseOptionsInput
+		options GithubReleaseOptions
+		want    GithubReleaseOptions
+	}{
+		{
+			name:    "Empty input",
+			input:   GithubReleaseOptionsInput{},
+			options: GithubReleaseOptions{Prerelease: true, MakeLatest: GithubMakeLatestFalse},
+			want:    GithubReleaseOptions{Prerelease: true, MakeLatest: GithubMakeLatestFalse},
+		},
+		{
+			name:    "Draft is no longer latest",
+			input:   GithubReleaseOptionsInput{Draft: pointer.BoolPtr(true)},
+			options: GithubReleaseOptions{MakeLatest: GithubMakeLatestTrue},
+			want:    GithubReleaseOptions{Draft: true, MakeLatest: GithubMakeLatestFalse},
+		},
+		{
+			name:    "Prerelease without make latest is no longer latest",
+			input:   GithubReleaseOptionsInput{Prerelease: pointer.BoolPtr(true)},
+			options: GithubReleaseOptions{},
+			want:    GithubReleaseOptions{Prerelease: true, MakeLatest: GithubMakeLatestFalse},
+		},
+		{
+			name:    "Explicit make latest is kept",
+			input:   GithubReleaseOptionsInput{Draft: pointer.BoolPtr(true), MakeLatest: &legacy},
+			options: GithubReleaseOptions{MakeLatest: GithubMakeLatestTrue},
+			want:    GithubReleaseOptions{Draft: true, MakeLatest: GithubMakeLatestLegacy},
+		},
+		{
+			name:    "Promoted to stable",
+			input:   GithubReleaseOptionsInput{Prerelease: pointer.BoolPtr(false), MakeLatest: &makeLatest},
+			options: GithubReleaseOptions{Prerelease: true, MakeLatest: GithubMakeLatestFalse},
+			want:    GithubReleaseOptions{MakeLatest: GithubMakeLatestTrue},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.input.Apply(tt.options))
+		})
+	}
+}
+
+func TestGithubReleaseOptions_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		options GithubReleaseOptions
+		wantErr bool
+	}{
+		{
+			name:    "Latest release",
+			options: GithubReleaseOptions{MakeLatest: GithubMakeLatestTrue},
+			wantErr: false,
+		},
+		{
+			name:    "Make latest not set",
+			options: GithubReleaseOptions{},
+			wantErr: false,
+		},
+		{
+			name:    "Legacy prerelease",
+			options: GithubReleaseOptions{Prerelease: true, MakeLatest: GithubMakeLatestLegacy},
+			wantErr: false,
+		},
+		{
+			name:    "Invalid make latest",
+			options: GithubReleaseOptions{MakeLatest: "always"},
+			wantErr: true,
+		},
+		{
+			name:    "Latest prerelease",
+			options: GithubReleaseOptions{Prerelease: true, MakeLatest: GithubMakeLatestTrue},
+			wantErr: true,
+		},
+		{
+			name:    "Latest draft",
+			options: GithubReleaseOptions{Draft: true, MakeLatest: GithubMakeLatestTrue},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.options.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	GithubWebhookDeliveryStatusProcessing GithubWebhookDeliveryStatus = "processing"
+	GithubWebhookDeliveryStatusSucceeded  GithubWebhookDeliveryStatus = "succeeded"
+	GithubWebhookDeliveryStatusFailed     GithubWebhookDeliveryStatus = "failed"
+	GithubWebhookDeliveryStatusIgnored    GithubWebhookDeliveryStatus = "ignored"
+
+	GithubWebhookDeliveriesDefaultLimit = 50
+	GithubWebhookDeliveriesMaxLimit     = 100
+)
+
+var (
+	errGithubWebhookDeliveryIDRequired     = errors.New("delivery ID is required")
+	errGithubWebhookEventRequired          = errors.New("event is required")
+	errGithubWebhookDeliveryStatusInvalid  = errors.New("invalid delivery status, must be one of: processing, succeeded, failed, ignored")
+	errGithubWebhookDeliveriesLimitInvalid = errors.New("limit must be between 1 and 100")
+)
+
+type GithubWebhookDeliveryStatus string
+
+func (s GithubWebhookDeliveryStatus) Validate() error {
+	switch s {
+	case GithubWebhookDeliveryStatusProcessing, GithubWebhookDeliveryStatusSucceeded, GithubWebhookDeliveryStatusFailed,
+		GithubWebhookDeliveryStatusIgnored:
+		return nil
+	default:
+		return errGithubWebhookDeliveryStatusInvalid
+	}
+}
+
+type GithubWebhookDeliveryInput struct {
+	// DeliveryID is the X-GitHub-Delivery header, GitHub keeps it when the delivery is redelivered.
+	DeliveryID string
+	Event      string
+	RawPayload []byte
+	Signature  string
+}
+
+func (i GithubWebhookDeliveryInput) Validate() error {
+	if i.DeliveryID == "" {
+		return errGithubWebhookDeliveryIDRequired
+	}
+	if i.Event == "" {
+		return errGithubWebhookEventRequired
+	}
+
+	return nil
+}
+
+// GithubWebhookDeliveryInfo is read from the payload before the delivery is processed.
+type GithubWebhookDeliveryInfo struct {
+	// RepoFullName is "owner/repo", empty if the payload does not contain a repository.
+	RepoFullName   string
+	SignatureValid bool
+}
+
+// GithubWebhookDelivery is an incoming GitHub webhook delivery, the payload is stored so the delivery can be replayed.
+type GithubWebhookDelivery struct {
+	DeliveryID     string
+	Event          string
+	RepoFullName   string
+	RawPayload     []byte
+	Signature      string
+	SignatureValid bool
+	Status         GithubWebhookDeliveryStatus
+	Error          *string
+	ReplayCount    int
+	ReceivedAt     time.Time
+	ProcessedAt    *time.Time
+}
+
+func NewGithubWebhookDelivery(input GithubWebhookDeliveryInput, info GithubWebhookDeliveryInfo) (GithubWebhookDelivery, error) {
+	if err := input.Validate(); err != nil {
+		return GithubWebhookDelivery{}, err
+	}
+
+	return GithubWebhookDelivery{
+		DeliveryID:     input.DeliveryID,
+		Event:          input.Event,
+		RepoFullName:   info.RepoFullName,
+		RawPayload:     input.RawPayload,
+		Signature:      input.Signature,
+		SignatureValid: info.SignatureValid,
+		Status:         GithubWebhookDeliveryStatusProcessing,
+		ReceivedAt:     time.Now(),
+	}, nil
+}
+
+// StartReplay processes the stored payload again, the outcome of the previous processing is cleared.
+func (d *GithubWebhookDelivery) StartReplay() {
+	d.ReplayCount++
+	d.Status = GithubWebhookDeliveryStatusProcessing
+	d.Error = nil
+	d.ProcessedAt = nil
+}
+
+// Finish records the outcome of the processing, err is nil if the delivery was processed successfully.
+func (d *GithubWebhookDelivery) Finish(err error) {
+	now := time.Now()
+	d.ProcessedAt = &now
+	d.Status = GithubWebhookDeliveryStatusSucceeded
+	d.Error = nil
+
+	if err != nil {
+		msg := err.Error()
+		d.Status = GithubWebhookDeliveryStatusFailed
+		d.Error = &msg
+	}
+}
+
+// Ignore records that the delivery was acknowledged without processing, e.g. a ping or an event of a branch.
+func (d *GithubWebhookDelivery) Ignore() {
+	now := time.Now()
+	d.ProcessedAt = &now
+	d.Status = GithubWebhookDeliveryStatusIgnored
+	d.Error = nil
+}
+
+func (d GithubWebhookDelivery) ToTagCreationWebhookInput() GithubTagCreationWebhookInput {
+	return GithubTagCreationWebhookInput{
+		RawPayload: d.RawPayload,
+		Signature:  d.Signature,
+	}
+}
+
+func (d GithubWebhookDelivery) ToTagDeletionWebhookInput() GithubTagDeletionWebhookInput {
+	return GithubTagDeletionWebhookInput{
+		RawPayload: d.RawPayload,
+		Signature:  d.Signature,
+	}
+}
+
+type ListGithubWebhookDeliveriesParams struct {
+	Status *GithubWebhookDeliveryStatus
+	Event  *string
+	Limit  int
+}
+
+func (p ListGithubWebhookDeliveriesParams) Validate() error {
+	if p.Status != nil {
+		if err := p.Status.Validate(); err != nil {
+			return err
+		}
+	}
+	if p.Limit < 1 || p.Limit > GithubWebhookDeliveriesMaxLimit {
+		return errGithubWebhookDeliveriesLimitInvalid
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package model
+
+import (
+	"errors"
+	"testing"
+
+	"release-manager/pkg/pointer"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGithubWebhookDelivery(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   GithubWebhookDeliveryInput
+		wantErr bool
+	}{
+		{
+			name:    "Valid delivery",
+			input:   GithubWebhookDeliveryInput{DeliveryID: "1", Event: "create"},
+			wantErr: false,
+		},
+		{
+			name:    "Missing delivery ID",
+			input:   GithubWebhookDeliveryInput{Event: "create"},
+			wantErr: true,
+		},
+		{
+			name:    "Missing event",
+			input:   GithubWebhookDeliveryInput{DeliveryID: "1"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := NewGithubWebhookDelivery(tt.input, GithubWebhookDeliveryInfo{RepoFullName: "owner/repo", SignatureValid: true})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, GithubWebhookDeliveryStatusProcessing, d.Status)
+			assert.Equal(t, "owner/repo", d.RepoFullName)
+			assert.True(t, d.SignatureValid)
+		})
+	}
+}
+
+func TestGithubWebhookDelivery_Finish(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus GithubWebhookDeliveryStatus
+		wantError  *string
+	}{
+		{
+			name:       "Succeeded",
+			err:        nil,
+			wantStatus: GithubWebhookDeliveryStatusSucceeded,
+			wantError:  nil,
+		},
+		{
+			name:       "Failed",
+			err:        errors.New("release not found"),
+			wantStatus: GithubWebhookDeliveryStatusFailed,
+			wantError:  pointer.StringPtr("release not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := GithubWebhookDelivery{Status: GithubWebhookDeliveryStatusProcessing}
+			d.Finish(tt.err)
+
+			assert.Equal(t, tt.wantStatus, d.Status)
+			assert.Equal(t, tt.wantError, d.Error)
+			assert.NotNil(t, d.ProcessedAt)
+		})
+	}
+}
+
+func TestGithubWebhookDelivery_Ignore(t *testing.T) {
+	d := GithubWebhookDelivery{Status: GithubWebhookDeliveryStatusProcessing, Error: pointer.StringPtr("release not found")}
+	d.Ignore()
+
+	assert.Equal(t, GithubWebhookDeliveryStatusIgnored, d.Status)
+	assert.Nil(t, d.Error)
+	assert.NotNil(t, d.ProcessedAt)
+}
+
+func TestListGithubWebhookDeliveriesParams_Validate(t *testing.T) {
+	failed := GithubWebhookDeliveryStatusFailed
+	unknown := GithubWebhookDeliveryStatus("unknown")
+
+	tests := []struct {
+		name    string
+		params  ListGithubWebhookDeliveriesParams
+		wantErr bool
+	}{
+		{
+			name:    "Default params",
+			params:  ListGithubWebhookDeliveriesParams{Limit: GithubWebhookDeliveriesDefaultLimit},
+			wantErr: false,
+		},
+		{
+			name:    "Filtered by status",
+			params:  ListGithubWebhookDeliveriesParams{Status: &failed, Limit: GithubWebhookDeliveriesDefaultLimit},
+			wantErr: false,
+		},
+		{
+			name:    "Unknown status",
+			params:  ListGithubWebhookDeliveriesParams{Status: &unknown, Limit: GithubWebhookDeliveriesDefaultLimit},
+			wantErr: true,
+		},
+		{
+			name:    "Limit too high",
+			params:  ListGithubWebhookDeliveriesParams{Limit: GithubWebhookDeliveriesMaxLimit + 1},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
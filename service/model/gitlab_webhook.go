@@ -0,0 +1,18 @@
+package model
+
+// GitlabTagPushEvent is the value of the X-Gitlab-Event header of tag push webhooks.
+const GitlabTagPushEvent = "Tag Push Hook"
+
+type GitlabWebhookInput struct {
+	Event string
+	// Token is the secret token of the webhook, GitLab sends it as it is, the payload is not signed.
+	Token      string
+	RawPayload []byte
+}
+
+// GitlabTagPushWebhookOutput is a tag push event, a tag is either created or deleted by the push.
+type GitlabTagPushWebhookOutput struct {
+	Repo    GitlabRepo
+	Tag     GitTag
+	Deleted bool
+}
@@ -2,14 +2,25 @@ package model
 
 import (
 	"errors"
+	"fmt"
 	"net/url"
+	"strings"
 	"time"
 
 	"release-manager/pkg/id"
 )
 
+const (
+	// DefaultVersionTagPrefix is used when the version tag prefix is not set for a new project.
+	DefaultVersionTagPrefix = "v"
+	// bitbucketCloudHost is the host of Bitbucket Cloud, any other host is a Bitbucket Server instance.
+	bitbucketCloudHost = "bitbucket.org"
+)
+
 var (
 	errProjectNameRequired                      = errors.New("project name is required")
+	errProjectVersionTagPrefixInvalid           = errors.New("version tag prefix contains characters which are not allowed in git tags")
+	errProjectVersionTagPrefixOverlap           = errors.New("version tag prefix overlaps with the prefix of another project sharing the git repo")
 	errReleaseNotificationConfigMessageRequired = errors.New("message in release notification config is required")
 )
 
@@ -19,8 +30,19 @@ type Project struct {
 	SlackChannelID            string
 	ReleaseNotificationConfig ReleaseNotificationConfig
 	GithubRepo                *GithubRepo
-	CreatedAt                 time.Time
-	UpdatedAt                 time.Time
+	// GithubWebhook is registered on the GitHub repo by the service, nil if the webhook is configured manually.
+	GithubWebhook *GithubRepoWebhook
+	// GitlabRepo is linked instead of the GitHub repo, a project has at most one of them.
+	GitlabRepo *GitlabRepo
+	// BitbucketRepo is linked instead of the GitHub or GitLab repo.
+	BitbucketRepo *BitbucketRepo
+	// VersionTagPrefix precedes the semantic version in git tag names, e.g. "v" or "service-a/".
+	// Projects sharing a repo (monorepo) are told apart by their prefixes, e.g. "api/v" and "web/v".
+	VersionTagPrefix string
+	// ReleaseNotesTemplate prefills notes of new releases and defines their required sections.
+	ReleaseNotesTemplate ReleaseNotesTemplate
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
 }
 
 type GithubRepo struct {
@@ -29,16 +51,67 @@ type GithubRepo struct {
 	RepoSlug  string
 }
 
+// GitlabRepo is a project on the GitLab instance set in the settings.
+type GitlabRepo struct {
+	// URL is the web URL of the project, e.g. https://gitlab.example.com/group/subgroup/project
+	URL url.URL
+	// ProjectID is the ID of the project on GitLab, it does not change when the project is moved or renamed.
+	ProjectID int64
+	// Path is the full path of the project including its namespace, e.g. group/subgroup/project
+	Path string
+}
+
+// TagURL returns the URL of the tag on GitLab.
+func (r GitlabRepo) TagURL(tagName string) url.URL {
+	u := r.URL
+	u.Path = fmt.Sprintf("%s/-/tags/%s", strings.TrimSuffix(u.Path, "/"), tagName)
+	return u
+}
+
+// BitbucketRepo is a repo on Bitbucket Cloud or on the Bitbucket Server instance set in the settings.
+type BitbucketRepo struct {
+	// URL is the web URL of the repo, e.g. https://bitbucket.org/workspace/repo or https://bitbucket.example.com/projects/KEY/repos/repo
+	URL url.URL
+	// Workspace is the workspace slug on Bitbucket Cloud or the project key on Bitbucket Server.
+	Workspace string
+	RepoSlug  string
+}
+
+// TagURL returns the URL of the tag on Bitbucket, Bitbucket Server shows tags by browsing the repo at the tag ref.
+func (r BitbucketRepo) TagURL(tagName string) url.URL {
+	u := r.URL
+	if u.Host == bitbucketCloudHost {
+		u.Path = fmt.Sprintf("%s/src/%s", strings.TrimSuffix(u.Path, "/"), tagName)
+		return u
+	}
+
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/browse"
+	u.RawQuery = url.Values{"at": {"refs/tags/" + tagName}}.Encode()
+	return u
+}
+
+// GithubRepoWebhook is a webhook registered on the GitHub repo of the project, its deliveries are signed with the secret.
+type GithubRepoWebhook struct {
+	ID     int64
+	Secret GithubWebhookSecret
+}
+
 type CreateProjectInput struct {
 	Name                      string
 	SlackChannelID            string
 	ReleaseNotificationConfig ReleaseNotificationConfig
+	// DefaultVersionTagPrefix is used if not set.
+	VersionTagPrefix     *string
+	ReleaseNotesTemplate ReleaseNotesTemplate
 }
 
 type UpdateProjectInput struct {
 	Name                            *string
 	SlackChannelID                  *string
 	ReleaseNotificationConfigUpdate UpdateReleaseNotificationConfigInput
+	VersionTagPrefix                *string
+	// ReleaseNotesTemplate replaces the whole template, an empty template removes it.
+	ReleaseNotesTemplate *ReleaseNotesTemplate
 }
 
 type ReleaseNotificationConfig struct {
@@ -48,6 +121,7 @@ type ReleaseNotificationConfig struct {
 	ShowReleaseNotes   bool
 	ShowLastDeployment bool
 	ShowSourceCode     bool
+	ShowLinkedItems    bool
 }
 
 type UpdateReleaseNotificationConfigInput struct {
@@ -57,6 +131,7 @@ type UpdateReleaseNotificationConfigInput struct {
 	ShowReleaseNotes   *bool
 	ShowLastDeployment *bool
 	ShowSourceCode     *bool
+	ShowLinkedItems    *bool
 }
 
 func NewProject(c CreateProjectInput) (Project, error) {
@@ -66,9 +141,14 @@ func NewProject(c CreateProjectInput) (Project, error) {
 		Name:                      c.Name,
 		SlackChannelID:            c.SlackChannelID,
 		ReleaseNotificationConfig: c.ReleaseNotificationConfig,
+		VersionTagPrefix:          DefaultVersionTagPrefix,
+		ReleaseNotesTemplate:      c.ReleaseNotesTemplate,
 		CreatedAt:                 now,
 		UpdatedAt:                 now,
 	}
+	if c.VersionTagPrefix != nil {
+		p.VersionTagPrefix = *c.VersionTagPrefix
+	}
 
 	if err := p.Validate(); err != nil {
 		return Project{}, err
@@ -77,11 +157,63 @@ func NewProject(c CreateProjectInput) (Project, error) {
 	return p, nil
 }
 
+// SetGithubRepo links the GitHub repo, the GitLab and Bitbucket repos are unlinked since a project has at most one repo.
 func (p *Project) SetGithubRepo(repo *GithubRepo) {
 	p.GithubRepo = repo
+	p.GitlabRepo = nil
+	p.BitbucketRepo = nil
+	p.UpdatedAt = time.Now()
+}
+
+// SetGitlabRepo links the GitLab repo, the GitHub repo is unlinked together with its webhook.
+func (p *Project) SetGitlabRepo(repo *GitlabRepo) {
+	p.GitlabRepo = repo
+	p.GithubRepo = nil
+	p.GithubWebhook = nil
+	p.BitbucketRepo = nil
+	p.UpdatedAt = time.Now()
+}
+
+func (p *Project) UnsetGitlabRepo() {
+	p.GitlabRepo = nil
+	p.UpdatedAt = time.Now()
+}
+
+// SetBitbucketRepo links the Bitbucket repo, the GitHub repo is unlinked together with its webhook.
+func (p *Project) SetBitbucketRepo(repo *BitbucketRepo) {
+	p.BitbucketRepo = repo
+	p.GithubRepo = nil
+	p.GithubWebhook = nil
+	p.GitlabRepo = nil
+	p.UpdatedAt = time.Now()
+}
+
+func (p *Project) UnsetBitbucketRepo() {
+	p.BitbucketRepo = nil
+	p.UpdatedAt = time.Now()
+}
+
+func (p *Project) SetGithubWebhook(webhook *GithubRepoWebhook) {
+	p.GithubWebhook = webhook
+	p.UpdatedAt = time.Now()
+}
+
+// UnsetGithubRepo unlinks the GitHub repo, the webhook of the repo is removed as well.
+func (p *Project) UnsetGithubRepo() {
+	p.GithubRepo = nil
+	p.GithubWebhook = nil
 	p.UpdatedAt = time.Now()
 }
 
+// GithubWebhookSecret returns the secret of the registered webhook, the global secret is used for manually configured webhooks.
+func (p *Project) GithubWebhookSecret(globalSecret GithubWebhookSecret) GithubWebhookSecret {
+	if p.GithubWebhook == nil {
+		return globalSecret
+	}
+
+	return p.GithubWebhook.Secret
+}
+
 func (p *Project) Update(u UpdateProjectInput) error {
 	if u.Name != nil {
 		p.Name = *u.Name
@@ -89,6 +221,12 @@ func (p *Project) Update(u UpdateProjectInput) error {
 	if u.SlackChannelID != nil {
 		p.SlackChannelID = *u.SlackChannelID
 	}
+	if u.VersionTagPrefix != nil {
+		p.VersionTagPrefix = *u.VersionTagPrefix
+	}
+	if u.ReleaseNotesTemplate != nil {
+		p.ReleaseNotesTemplate = *u.ReleaseNotesTemplate
+	}
 
 	p.ReleaseNotificationConfig.Update(u.ReleaseNotificationConfigUpdate)
 	p.UpdatedAt = time.Now()
@@ -100,10 +238,72 @@ func (p *Project) Validate() error {
 	if p.Name == "" {
 		return errProjectNameRequired
 	}
+	// Characters not allowed in git refs, see git-check-ref-format.
+	if strings.ContainsAny(p.VersionTagPrefix, " ~^:?*[\\") {
+		return errProjectVersionTagPrefixInvalid
+	}
+	if err := p.ReleaseNotesTemplate.Validate(); err != nil {
+		return err
+	}
 
 	return p.ReleaseNotificationConfig.Validate()
 }
 
+// ParseTagVersion parses the semantic version from the git tag name using the version tag prefix of the project.
+// The second return value is false if the tag does not contain a semantic version.
+func (p *Project) ParseTagVersion(tagName string) (Version, bool) {
+	return ParseVersionFromTag(tagName, p.VersionTagPrefix)
+}
+
+// ProjectForGitTag returns the project which the tag of a repo shared by the projects belongs to.
+// It is the project with the longest version tag prefix the tag starts with, a repo linked to a single project routes all its tags to it.
+// The second return value is false if the tag does not belong to any of the projects.
+func ProjectForGitTag(projects []Project, tagName string) (Project, bool) {
+	if len(projects) == 1 {
+		return projects[0], true
+	}
+
+	var (
+		match Project
+		found bool
+	)
+	for _, p := range projects {
+		if !p.HasVersionTagPrefix(tagName) {
+			continue
+		}
+		if !found || len(p.VersionTagPrefix) > len(match.VersionTagPrefix) {
+			match, found = p, true
+		}
+	}
+
+	return match, found
+}
+
+// ValidateVersionTagPrefixOverlap checks that no other project sharing the git repo has a version tag prefix
+// which starts with the prefix of the project or vice versa, e.g. "v" and "vendor/v". Otherwise, a tag could belong to both projects.
+func (p *Project) ValidateVersionTagPrefixOverlap(sharingProjects []Project) error {
+	for _, other := range sharingProjects {
+		if other.ID == p.ID {
+			continue
+		}
+		if strings.HasPrefix(p.VersionTagPrefix, other.VersionTagPrefix) || strings.HasPrefix(other.VersionTagPrefix, p.VersionTagPrefix) {
+			return fmt.Errorf("%w: prefix %q of project %q", errProjectVersionTagPrefixOverlap, other.VersionTagPrefix, other.Name)
+		}
+	}
+
+	return nil
+}
+
+// HasVersionTagPrefix reports whether the git tag name starts with the version tag prefix of the project.
+func (p *Project) HasVersionTagPrefix(tagName string) bool {
+	return strings.HasPrefix(tagName, p.VersionTagPrefix)
+}
+
+// VersionTagName returns the git tag name for the version.
+func (p *Project) VersionTagName(v Version) string {
+	return p.VersionTagPrefix + v.String()
+}
+
 func (p *Project) IsSlackChannelSet() bool {
 	return p.SlackChannelID != ""
 }
@@ -112,6 +312,19 @@ func (p *Project) IsGithubRepoSet() bool {
 	return p.GithubRepo != nil
 }
 
+// IsGithubRepoLinked reports whether the project is linked to the GitHub repo.
+func (p *Project) IsGithubRepoLinked(repo GithubRepo) bool {
+	return p.IsGithubRepoSet() && p.GithubRepo.OwnerSlug == repo.OwnerSlug && p.GithubRepo.RepoSlug == repo.RepoSlug
+}
+
+func (p *Project) IsGitlabRepoSet() bool {
+	return p.GitlabRepo != nil
+}
+
+func (p *Project) IsBitbucketRepoSet() bool {
+	return p.BitbucketRepo != nil
+}
+
 func (p *Project) GithubOwnerSlug() *string {
 	if p.GithubRepo == nil {
 		return nil
@@ -147,6 +360,9 @@ func (c *ReleaseNotificationConfig) Update(u UpdateReleaseNotificationConfigInpu
 	if u.ShowSourceCode != nil {
 		c.ShowSourceCode = *u.ShowSourceCode
 	}
+	if u.ShowLinkedItems != nil {
+		c.ShowLinkedItems = *u.ShowLinkedItems
+	}
 }
 
 func (c *ReleaseNotificationConfig) IsEmpty() bool {
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"net/url"
 	"testing"
 
 	"release-manager/pkg/id"
@@ -79,6 +80,48 @@ func TestProject_Update(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Valid version tag prefix",
+			project: Project{
+				ID:   id.NewProject(),
+				Name: "Test Project",
+				ReleaseNotificationConfig: ReleaseNotificationConfig{
+					Message: "Test Message",
+				},
+			},
+			update: UpdateProjectInput{
+				VersionTagPrefix: pointer.StringPtr("service-a/v"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Invalid version tag prefix",
+			project: Project{
+				ID:   id.NewProject(),
+				Name: "Test Project",
+				ReleaseNotificationConfig: ReleaseNotificationConfig{
+					Message: "Test Message",
+				},
+			},
+			update: UpdateProjectInput{
+				VersionTagPrefix: pointer.StringPtr("release v"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Invalid release notes template",
+			project: Project{
+				ID:   id.NewProject(),
+				Name: "Test Project",
+				ReleaseNotificationConfig: ReleaseNotificationConfig{
+					Message: "Test Message",
+				},
+			},
+			update: UpdateProjectInput{
+				ReleaseNotesTemplate: &ReleaseNotesTemplate{Sections: []ReleaseNotesTemplateSection{{Title: ""}}},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Missing name",
 			project: Project{
@@ -294,3 +337,222 @@ func TestProject_GithubRepoSlug(t *testing.T) {
 		})
 	}
 }
+
+func TestProject_GithubWebhookSecret(t *testing.T) {
+	tests := []struct {
+		name           string
+		project        *Project
+		expectedResult GithubWebhookSecret
+	}{
+		{
+			name:           "Manually configured webhook uses the global secret",
+			project:        &Project{GithubRepo: &GithubRepo{RepoSlug: "repo123"}},
+			expectedResult: "global",
+		},
+		{
+			name: "Secret of the registered webhook",
+			project: &Project{
+				GithubRepo:    &GithubRepo{RepoSlug: "repo123"},
+				GithubWebhook: &GithubRepoWebhook{ID: 1, Secret: "project"},
+			},
+			expectedResult: "project",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.project.GithubWebhookSecret("global")
+			assert.Equal(t, tt.expectedResult, result)
+		})
+	}
+}
+
+func TestGitlabRepo_TagURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		repoURL  url.URL
+		expected string
+	}{
+		{
+			name:     "Project in a subgroup",
+			repoURL:  url.URL{Scheme: "https", Host: "gitlab.com", Path: "/group/subgroup/project"},
+			expected: "https://gitlab.com/group/subgroup/project/-/tags/v1.0.0",
+		},
+		{
+			name:     "Self-hosted instance with trailing slash",
+			repoURL:  url.URL{Scheme: "https", Host: "gitlab.example.com", Path: "/gitlab/group/project/"},
+			expected: "https://gitlab.example.com/gitlab/group/project/-/tags/v1.0.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := GitlabRepo{URL: tt.repoURL}.TagURL("v1.0.0")
+			assert.Equal(t, tt.expected, u.String())
+		})
+	}
+}
+
+func TestProject_SetGitlabRepo(t *testing.T) {
+	p := Project{
+		GithubRepo:    &GithubRepo{OwnerSlug: "owner", RepoSlug: "repo"},
+		GithubWebhook: &GithubRepoWebhook{ID: 1, Secret: "secret"},
+	}
+
+	p.SetGitlabRepo(&GitlabRepo{ProjectID: 42, Path: "group/project"})
+
+	assert.True(t, p.IsGitlabRepoSet())
+	assert.False(t, p.IsGithubRepoSet())
+	assert.Nil(t, p.GithubWebhook)
+
+	p.SetGithubRepo(&GithubRepo{OwnerSlug: "owner", RepoSlug: "repo"})
+
+	assert.True(t, p.IsGithubRepoSet())
+	assert.False(t, p.IsGitlabRepoSet())
+}
+
+func TestBitbucketRepo_TagURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		repoURL  url.URL
+		expected string
+	}{
+		{
+			name:     "Bitbucket Cloud",
+			repoURL:  url.URL{Scheme: "https", Host: "bitbucket.org", Path: "/workspace/repo"},
+			expected: "https://bitbucket.org/workspace/repo/src/v1.0.0",
+		},
+		{
+			name:     "Bitbucket Server",
+			repoURL:  url.URL{Scheme: "https", Host: "bitbucket.example.com", Path: "/projects/KEY/repos/repo"},
+			expected: "https://bitbucket.example.com/projects/KEY/repos/repo/browse?at=refs%2Ftags%2Fv1.0.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := BitbucketRepo{URL: tt.repoURL}.TagURL("v1.0.0")
+			assert.Equal(t, tt.expected, u.String())
+		})
+	}
+}
+
+func TestProject_SetBitbucketRepo(t *testing.T) {
+	p := Project{
+		GithubRepo:    &GithubRepo{OwnerSlug: "owner", RepoSlug: "repo"},
+		GithubWebhook: &GithubRepoWebhook{ID: 1, Secret: "secret"},
+	}
+
+	p.SetBitbucketRepo(&BitbucketRepo{Workspace: "workspace", RepoSlug: "repo"})
+
+	assert.True(t, p.IsBitbucketRepoSet())
+	assert.False(t, p.IsGithubRepoSet())
+	assert.Nil(t, p.GithubWebhook)
+
+	p.SetGitlabRepo(&GitlabRepo{ProjectID: 42, Path: "group/project"})
+
+	assert.True(t, p.IsGitlabRepoSet())
+	assert.False(t, p.IsBitbucketRepoSet())
+}
+
+func TestProjectForGitTag(t *testing.T) {
+	api := Project{Name: "api", VersionTagPrefix: "api/v"}
+	apiInternal := Project{Name: "api-internal", VersionTagPrefix: "api/internal/v"}
+	web := Project{Name: "web", VersionTagPrefix: "web/v"}
+
+	tests := []struct {
+		name      string
+		projects  []Project
+		tagName   string
+		wantName  string
+		wantFound bool
+	}{
+		{
+			name:      "Single project gets all tags",
+			projects:  []Project{api},
+			tagName:   "v1.0.0",
+			wantName:  "api",
+			wantFound: true,
+		},
+		{
+			name:      "Tag routed by prefix",
+			projects:  []Project{api, web},
+			tagName:   "web/v3.0.0",
+			wantName:  "web",
+			wantFound: true,
+		},
+		{
+			name:      "Longest matching prefix wins",
+			projects:  []Project{api, apiInternal, web},
+			tagName:   "api/internal/v1.0.0",
+			wantName:  "api-internal",
+			wantFound: true,
+		},
+		{
+			name:      "No matching prefix",
+			projects:  []Project{api, web},
+			tagName:   "v1.0.0",
+			wantFound: false,
+		},
+		{
+			name:      "No projects",
+			tagName:   "v1.0.0",
+			wantFound: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, found := ProjectForGitTag(tt.projects, tt.tagName)
+			assert.Equal(t, tt.wantFound, found)
+			assert.Equal(t, tt.wantName, p.Name)
+		})
+	}
+}
+
+func TestProject_ValidateVersionTagPrefixOverlap(t *testing.T) {
+	p := Project{ID: id.NewProject(), VersionTagPrefix: "v"}
+
+	tests := []struct {
+		name     string
+		projects []Project
+		wantErr  bool
+	}{
+		{
+			name:     "Repo not shared",
+			projects: []Project{p},
+			wantErr:  false,
+		},
+		{
+			name:     "Disjoint prefixes",
+			projects: []Project{p, {ID: id.NewProject(), VersionTagPrefix: "api/v"}},
+			wantErr:  false,
+		},
+		{
+			name:     "Same prefix",
+			projects: []Project{p, {ID: id.NewProject(), VersionTagPrefix: "v"}},
+			wantErr:  true,
+		},
+		{
+			name:     "Prefix of the other project starts with the prefix",
+			projects: []Project{p, {ID: id.NewProject(), VersionTagPrefix: "vendor/v"}},
+			wantErr:  true,
+		},
+		{
+			name:     "Empty prefix of the other project",
+			projects: []Project{p, {ID: id.NewProject(), VersionTagPrefix: ""}},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := p.ValidateVersionTagPrefixOverlap(tt.projects)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errProjectVersionTagPrefixOverlap)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
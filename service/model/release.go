@@ -2,7 +2,12 @@ package model
 
 import (
 	"errors"
+	"fmt"
+	"io"
+	"mime"
 	"net/url"
+	"path"
+	"strings"
 	"time"
 
 	"release-manager/pkg/id"
@@ -12,19 +17,227 @@ import (
 
 var (
 	errReleaseTitleRequired               = errors.New("release title is required")
+	errReleaseGitTagNameRequired          = errors.New("git tag name is required")
+	errReleaseGitTagTargetRequired        = errors.New("git tag target must be a branch name or a commit SHA")
 	errGithubGeneratedNotesGitTagRequired = errors.New("git tag name is required")
+	errReleaseAttachmentNameRequired      = errors.New("attachment file name is required")
+	errReleaseAttachmentNameInvalid       = errors.New("attachment file name must not contain a path")
+	errReleaseAttachmentTypeNotAllowed    = errors.New("attachment content type is not allowed")
+	errReleaseAttachmentTooLarge          = errors.New("attachment exceeds the maximum allowed file size")
+	errReleaseStatusInvalid               = errors.New("invalid release status")
+	errReleaseStatusTransitionNotAllowed  = errors.New("release status transition is not allowed")
+	errReleaseVersionNotIncreased         = errors.New("release version must be greater than the version of the last published release")
+	errReleaseSortByInvalid               = errors.New("invalid sort by, must be one of: created_at, version")
+	errReleaseVersionRangeInvalid         = errors.New("min version must not be greater than max version")
+	errReleaseSortOrderInvalid            = errors.New("invalid sort order, must be one of: asc, desc")
+	errReleaseCreatedRangeInvalid         = errors.New("created from must not be after created to")
+	errReleasePageLimitInvalid            = errors.New("limit must be between 1 and 100")
+	errReleaseCursorSortMismatch          = errors.New("cursor was created for a different sort, start again without the cursor")
 )
 
+const (
+	ReleaseStatusDraft      ReleaseStatus = "draft"
+	ReleaseStatusReady      ReleaseStatus = "ready"
+	ReleaseStatusPublished  ReleaseStatus = "published"
+	ReleaseStatusDeprecated ReleaseStatus = "deprecated"
+	ReleaseStatusYanked     ReleaseStatus = "yanked"
+)
+
+var (
+	validReleaseStatuses = map[ReleaseStatus]bool{
+		ReleaseStatusDraft:      true,
+		ReleaseStatusReady:      true,
+		ReleaseStatusPublished:  true,
+		ReleaseStatusDeprecated: true,
+		ReleaseStatusYanked:     true,
+	}
+
+	// allowedReleaseStatusTransitions maps a release status to statuses the release can be moved to.
+	// Yanked is a final status, a yanked release must not be used anymore.
+	allowedReleaseStatusTransitions = map[ReleaseStatus]map[ReleaseStatus]bool{
+		ReleaseStatusDraft: {
+			ReleaseStatusReady: true,
+		},
+		ReleaseStatusReady: {
+			ReleaseStatusDraft:     true,
+			ReleaseStatusPublished: true,
+		},
+		ReleaseStatusPublished: {
+			ReleaseStatusDeprecated: true,
+			ReleaseStatusYanked:     true,
+		},
+		ReleaseStatusDeprecated: {
+			ReleaseStatusPublished: true,
+			ReleaseStatusYanked:    true,
+		},
+		ReleaseStatusYanked: {},
+	}
+)
+
+type ReleaseStatus string
+
+func (s ReleaseStatus) Validate() error {
+	if _, exists := validReleaseStatuses[s]; exists {
+		return nil
+	}
+
+	return errReleaseStatusInvalid
+}
+
+func (s ReleaseStatus) CanTransitionTo(status ReleaseStatus) bool {
+	return allowedReleaseStatusTransitions[s][status]
+}
+
+const (
+	ReleaseSortByCreatedAt ReleaseSortBy = "created_at"
+	ReleaseSortByVersion   ReleaseSortBy = "version"
+)
+
+type ReleaseSortBy string
+
+func (s ReleaseSortBy) Validate() error {
+	switch s {
+	case ReleaseSortByCreatedAt, ReleaseSortByVersion:
+		return nil
+	default:
+		return errReleaseSortByInvalid
+	}
+}
+
+const (
+	ReleaseSortOrderDesc ReleaseSortOrder = "desc"
+	ReleaseSortOrderAsc  ReleaseSortOrder = "asc"
+)
+
+type ReleaseSortOrder string
+
+func (o ReleaseSortOrder) Validate() error {
+	switch o {
+	case ReleaseSortOrderDesc, ReleaseSortOrderAsc:
+		return nil
+	default:
+		return errReleaseSortOrderInvalid
+	}
+}
+
+type ListReleasesFilterParams struct {
+	// SortBy orders releases by created_at if not set.
+	// Releases without a semantic version are placed last when sorted by version.
+	SortBy ReleaseSortBy
+	// SortOrder is desc (the newest or the highest version first) if not set.
+	SortOrder ReleaseSortOrder
+	// MinVersion and MaxVersion are inclusive, releases without a semantic version are skipped if any of them is set.
+	MinVersion *Version
+	MaxVersion *Version
+	// CreatedFrom and CreatedTo are inclusive.
+	CreatedFrom  *time.Time
+	CreatedTo    *time.Time
+	AuthorUserID *id.AuthUser
+	GitTagPrefix *string
+	// DeployedToEnvironmentID keeps only releases which were deployed to the environment.
+	DeployedToEnvironmentID *id.Environment
+	// Limit is the maximum number of releases in a page, all releases are listed if not set.
+	Limit *int
+	// Cursor continues listing after the last release of the previous page.
+	Cursor *ReleaseCursor
+}
+
+func (p ListReleasesFilterParams) Validate() error {
+	if p.SortBy != "" {
+		if err := p.SortBy.Validate(); err != nil {
+			return err
+		}
+	}
+	if p.SortOrder != "" {
+		if err := p.SortOrder.Validate(); err != nil {
+			return err
+		}
+	}
+	if p.MinVersion != nil && p.MaxVersion != nil && p.MinVersion.GreaterThan(*p.MaxVersion) {
+		return errReleaseVersionRangeInvalid
+	}
+	if p.CreatedFrom != nil && p.CreatedTo != nil && p.CreatedFrom.After(*p.CreatedTo) {
+		return errReleaseCreatedRangeInvalid
+	}
+	if p.Limit != nil && (*p.Limit < 1 || *p.Limit > ReleasesPageMaxLimit) {
+		return errReleasePageLimitInvalid
+	}
+	if p.Cursor != nil && (p.Cursor.SortBy != p.SortByOrDefault() || p.Cursor.SortOrder != p.SortOrderOrDefault()) {
+		return errReleaseCursorSortMismatch
+	}
+
+	return nil
+}
+
+func (p ListReleasesFilterParams) SortByOrDefault() ReleaseSortBy {
+	if p.SortBy == "" {
+		return ReleaseSortByCreatedAt
+	}
+
+	return p.SortBy
+}
+
+func (p ListReleasesFilterParams) SortOrderOrDefault() ReleaseSortOrder {
+	if p.SortOrder == "" {
+		return ReleaseSortOrderDesc
+	}
+
+	return p.SortOrder
+}
+
+const (
+	// ReleaseAttachmentMaxSize is the maximum size of a release attachment file in bytes (50 MB).
+	ReleaseAttachmentMaxSize = 50 << 20
+)
+
+// allowedReleaseAttachmentContentTypes lists content types that can be uploaded as release attachments.
+var allowedReleaseAttachmentContentTypes = map[string]bool{
+	"application/gzip":                        true,
+	"application/json":                        true,
+	"application/octet-stream":                true,
+	"application/pdf":                         true,
+	"application/vnd.android.package-archive": true,
+	"application/x-tar":                       true,
+	"application/x-gzip":                      true,
+	"application/zip":                         true,
+	"image/gif":                               true,
+	"image/jpeg":                              true,
+	"image/png":                               true,
+	"text/csv":                                true,
+	"text/markdown":                           true,
+	"text/plain":                              true,
+}
+
 type CreateReleaseInput struct {
 	ReleaseTitle string
 	ReleaseNotes string
 	// Used for linking the release with a specific point in a git repository.
 	GitTagName string
+	// GitTagTarget is a branch name or a commit SHA. If it is set, an annotated tag is created on GitHub,
+	// otherwise the tag must already exist.
+	GitTagTarget *string
+	// GithubOptions override the defaults based on the version of the release.
+	GithubOptions GithubReleaseOptionsInput
+}
+
+func (i CreateReleaseInput) Validate() error {
+	if i.ReleaseTitle == "" {
+		return errReleaseTitleRequired
+	}
+	if strings.TrimSpace(i.GitTagName) == "" {
+		return errReleaseGitTagNameRequired
+	}
+	if i.GitTagTarget != nil && strings.TrimSpace(*i.GitTagTarget) == "" {
+		return errReleaseGitTagTargetRequired
+	}
+
+	return nil
 }
 
 type UpdateReleaseInput struct {
-	ReleaseTitle *string
-	ReleaseNotes *string
+	ReleaseTitle  *string
+	ReleaseNotes  *string
+	GithubOptions GithubReleaseOptionsInput
 }
 
 type Release struct {
@@ -32,11 +245,19 @@ type Release struct {
 	ProjectID    id.Project
 	ReleaseTitle string
 	ReleaseNotes string
+	Status       ReleaseStatus
 	AuthorUserID id.AuthUser
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 	Tag          GitTag
-	Attachments  []ReleaseAttachment
+	// Version is parsed from the git tag name, nil if the tag does not contain a semantic version.
+	Version *Version
+	// NotesTemplate is the release notes template of the project, it is not stored with the release.
+	NotesTemplate ReleaseNotesTemplate
+	GithubOptions GithubReleaseOptions
+	Attachments   []ReleaseAttachment
+	// LinkedItems is nil until pull requests and issues of the release are synced from GitHub.
+	LinkedItems *ReleaseLinkedItems
 }
 
 type GitTag struct {
@@ -45,11 +266,112 @@ type GitTag struct {
 }
 
 type ReleaseAttachment struct {
-	ID        uuid.UUID
-	Name      string
-	FilePath  string
-	URL       url.URL
-	CreatedAt time.Time
+	ID   uuid.UUID
+	Name string
+	// FilePath is empty if the file is not in the storage, ExternalURL points to the file then.
+	FilePath    string
+	URL         url.URL
+	ExternalURL *url.URL
+	CreatedAt   time.Time
+}
+
+type ReleaseAttachmentInput struct {
+	Name        string
+	ContentType string
+	Content     io.Reader
+}
+
+func (i ReleaseAttachmentInput) Validate() error {
+	if i.Name == "" {
+		return errReleaseAttachmentNameRequired
+	}
+	if path.Base(i.Name) != i.Name || strings.ContainsAny(i.Name, `/\`) || i.Name == "." || i.Name == ".." {
+		return errReleaseAttachmentNameInvalid
+	}
+	// Content type can contain parameters (e.g. charset), only the media type is checked.
+	mediaType, _, err := mime.ParseMediaType(i.ContentType)
+	if err != nil || !allowedReleaseAttachmentContentTypes[mediaType] {
+		return fmt.Errorf("%w: %q", errReleaseAttachmentTypeNotAllowed, i.ContentType)
+	}
+
+	return nil
+}
+
+func NewReleaseAttachment(input ReleaseAttachmentInput, releaseID id.Release) (ReleaseAttachment, error) {
+	if err := input.Validate(); err != nil {
+		return ReleaseAttachment{}, err
+	}
+
+	return ReleaseAttachment{
+		ID:        uuid.New(),
+		Name:      input.Name,
+		FilePath:  newReleaseAttachmentFilePath(releaseID, input.Name),
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// Replace points the attachment to a new file, the attachment ID is kept.
+// The new file gets its own file path, so the previous file stays untouched
+// until the attachment is updated to point to the new one.
+func (a *ReleaseAttachment) Replace(input ReleaseAttachmentInput, releaseID id.Release) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	a.Name = input.Name
+	a.FilePath = newReleaseAttachmentFilePath(releaseID, input.Name)
+	a.ExternalURL = nil
+	a.CreatedAt = time.Now()
+
+	return nil
+}
+
+// IsStored reports whether the file of the attachment is in the storage, e.g. imported GitHub assets are not.
+func (a ReleaseAttachment) IsStored() bool {
+	return a.FilePath != ""
+}
+
+// newReleaseAttachmentFilePath generates a unique file path in the storage bucket.
+// The random segment prevents collisions between attachments with the same file name.
+func newReleaseAttachmentFilePath(releaseID id.Release, name string) string {
+	return fmt.Sprintf("releases/%s/%s/%s", releaseID, uuid.New(), name)
+}
+
+// ReleaseAttachmentContent wraps the attachment content and fails the read
+// as soon as more than ReleaseAttachmentMaxSize bytes are read.
+type ReleaseAttachmentContent struct {
+	r        io.Reader
+	size     int64
+	tooLarge bool
+	// readErr is the error of the wrapped reader, e.g. the request body exceeded its limit while it was streamed
+	readErr error
+}
+
+func NewReleaseAttachmentContent(r io.Reader) *ReleaseAttachmentContent {
+	return &ReleaseAttachmentContent{r: r}
+}
+
+func (c *ReleaseAttachmentContent) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.size += int64(n)
+	if c.size > ReleaseAttachmentMaxSize {
+		c.tooLarge = true
+		return n, errReleaseAttachmentTooLarge
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		c.readErr = err
+	}
+
+	return n, err
+}
+
+func (c *ReleaseAttachmentContent) IsTooLarge() bool {
+	return c.tooLarge
+}
+
+// ReadErr returns the error of the wrapped reader, the storage may not pass it on when the upload fails.
+func (c *ReleaseAttachmentContent) ReadErr() error {
+	return c.readErr
 }
 
 func NewRelease(input CreateReleaseInput, tag GitTag, projectID id.Project, authorUserID id.AuthUser) (Release, error) {
@@ -59,6 +381,7 @@ func NewRelease(input CreateReleaseInput, tag GitTag, projectID id.Project, auth
 		ProjectID:    projectID,
 		ReleaseTitle: input.ReleaseTitle,
 		ReleaseNotes: input.ReleaseNotes,
+		Status:       ReleaseStatusDraft,
 		Tag:          tag,
 		AuthorUserID: authorUserID,
 		CreatedAt:    now,
@@ -79,16 +402,88 @@ func (r *Release) Update(input UpdateReleaseInput) error {
 	if input.ReleaseNotes != nil {
 		r.ReleaseNotes = *input.ReleaseNotes
 	}
+	r.GithubOptions = input.GithubOptions.Apply(r.GithubOptions)
 
 	r.UpdatedAt = time.Now()
 
 	return r.Validate()
 }
 
+func (r *Release) UpdateStatus(status ReleaseStatus) error {
+	if err := status.Validate(); err != nil {
+		return err
+	}
+	if !r.Status.CanTransitionTo(status) {
+		return fmt.Errorf("%w: from %s to %s", errReleaseStatusTransitionNotAllowed, r.Status, status)
+	}
+
+	r.Status = status
+	r.UpdatedAt = time.Now()
+
+	return r.Validate()
+}
+
+// SetVersion parses the semantic version from the git tag name using the version tag prefix of the project.
+func (r *Release) SetVersion(p Project) {
+	r.Version = nil
+	if v, ok := p.ParseTagVersion(r.Tag.Name); ok {
+		r.Version = &v
+	}
+}
+
+// SetGithubOptions sets the GitHub release options, defaults are based on the version, so it must be set before.
+func (r *Release) SetGithubOptions(input GithubReleaseOptionsInput) error {
+	r.GithubOptions = input.Apply(NewGithubReleaseOptions(r.Version))
+	return r.GithubOptions.Validate()
+}
+
+// ValidateVersionAfter checks that the release has greater version than the last published release.
+// Releases without a semantic version are not compared.
+func (r *Release) ValidateVersionAfter(last Release) error {
+	if r.Version == nil || last.Version == nil {
+		return nil
+	}
+	if !r.Version.GreaterThan(*last.Version) {
+		return fmt.Errorf("%w: %s is not greater than %s", errReleaseVersionNotIncreased, r.Version, last.Version)
+	}
+
+	return nil
+}
+
+// SetNotesTemplate sets the release notes template of the project, empty notes are prefilled from the template.
+func (r *Release) SetNotesTemplate(p Project) {
+	r.NotesTemplate = p.ReleaseNotesTemplate
+	if strings.TrimSpace(r.ReleaseNotes) == "" {
+		r.ReleaseNotes = r.NotesTemplate.Render()
+	}
+}
+
+// SetLinkedItems replaces the snapshot of the linked pull requests and issues, the release itself is not changed.
+func (r *Release) SetLinkedItems(items ReleaseLinkedItems) {
+	items.SyncedAt = time.Now()
+	r.LinkedItems = &items
+}
+
+func (r *Release) IsPublished() bool {
+	return r.Status == ReleaseStatusPublished
+}
+
 func (r *Release) Validate() error {
 	if r.ReleaseTitle == "" {
 		return errReleaseTitleRequired
 	}
+	if err := r.Status.Validate(); err != nil {
+		return err
+	}
+	if err := r.GithubOptions.Validate(); err != nil {
+		return err
+	}
+	// Drafts can be incomplete, deprecated and yanked releases can keep notes written before the template changed.
+	if r.Status == ReleaseStatusReady || r.Status == ReleaseStatusPublished {
+		if err := r.NotesTemplate.ValidateNotes(r.ReleaseNotes); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -103,6 +498,7 @@ type ReleaseNotification struct {
 	DeployedToEnvironment *string
 	DeployedAt            *time.Time
 	DeployedServiceURL    *url.URL
+	LinkedItems           *ReleaseLinkedItems
 }
 
 func NewReleaseNotification(p Project, r Release, dpl *Deployment) ReleaseNotification {
@@ -123,6 +519,9 @@ func NewReleaseNotification(p Project, r Release, dpl *Deployment) ReleaseNotifi
 		n.GitTagName = &r.Tag.Name
 		n.GitTagURL = &r.Tag.URL
 	}
+	if p.ReleaseNotificationConfig.ShowLinkedItems && r.LinkedItems != nil && !r.LinkedItems.IsEmpty() {
+		n.LinkedItems = r.LinkedItems
+	}
 	if p.ReleaseNotificationConfig.ShowLastDeployment && dpl != nil {
 		n.DeployedToEnvironment = &dpl.Environment.Name
 		n.DeployedAt = &dpl.DeployedAt
@@ -137,16 +536,48 @@ func NewReleaseNotification(p Project, r Release, dpl *Deployment) ReleaseNotifi
 	return n
 }
 
+// NextReleaseVersion is the suggested version of the next release of the project.
+type NextReleaseVersion struct {
+	// PreviousVersion is the version of the last published release, nil if no release was published yet.
+	PreviousVersion *Version
+	Version         Version
+	GitTagName      string
+}
+
+// NewNextReleaseVersion bumps the version of the last published release.
+// If there is no such release, the version is bumped from 0.0.0.
+func NewNextReleaseVersion(p Project, last *Release, bump VersionBump) NextReleaseVersion {
+	var previous *Version
+	if last != nil {
+		previous = last.Version
+	}
+
+	var v Version
+	if previous != nil {
+		v = *previous
+	}
+
+	next := v.Bump(bump)
+
+	return NextReleaseVersion{
+		PreviousVersion: previous,
+		Version:         next,
+		GitTagName:      p.VersionTagName(next),
+	}
+}
+
 type DeleteReleaseInput struct {
-	DeleteGithubRelease bool
+	DeleteGitRelease bool
 }
 
-type GithubReleaseNotesInput struct {
+type GitReleaseNotesInput struct {
 	GitTagName         *string
 	PreviousGitTagName *string
+	// IncludeLinkedItems appends pull requests merged and issues closed since the previous tag to the notes.
+	IncludeLinkedItems bool
 }
 
-func (i GithubReleaseNotesInput) Validate() error {
+func (i GitReleaseNotesInput) Validate() error {
 	if i.GitTagName == nil {
 		return errGithubGeneratedNotesGitTagRequired
 	}
@@ -157,7 +588,7 @@ func (i GithubReleaseNotesInput) Validate() error {
 	return nil
 }
 
-func (i GithubReleaseNotesInput) GetGitTagName() string {
+func (i GitReleaseNotesInput) GetGitTagName() string {
 	if i.GitTagName != nil {
 		return *i.GitTagName
 	}
@@ -165,11 +596,25 @@ func (i GithubReleaseNotesInput) GetGitTagName() string {
 	return ""
 }
 
-type GithubReleaseNotes struct {
+type GitReleaseNotes struct {
 	Title string
 	Notes string
 }
 
+// AppendLinkedItems adds sections with the pull requests and the issues after the generated notes.
+func (n *GitReleaseNotes) AppendLinkedItems(items ReleaseLinkedItems) {
+	if items.IsEmpty() {
+		return
+	}
+
+	notes := strings.TrimRight(n.Notes, "\n")
+	if notes != "" {
+		notes += "\n\n"
+	}
+
+	n.Notes = notes + items.Markdown()
+}
+
 type GithubTagDeletionWebhookInput struct {
 	RawPayload []byte
 	Signature  string
@@ -179,3 +624,13 @@ type GithubTagDeletionWebhookOutput struct {
 	Repo    GithubRepo
 	TagName string
 }
+
+type GithubTagCreationWebhookInput struct {
+	RawPayload []byte
+	Signature  string
+}
+
+type GithubTagCreationWebhookOutput struct {
+	Repo GithubRepo
+	Tag  GitTag
+}
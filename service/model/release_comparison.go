@@ -0,0 +1,123 @@
+package model
+
+import (
+	"errors"
+	"net/url"
+	"time"
+
+	"release-manager/pkg/id"
+)
+
+var (
+	errReleaseComparisonBaseRequired = errors.New("base release is required")
+	errReleaseComparisonHeadRequired = errors.New("head release is required")
+	errReleaseComparisonSameRelease  = errors.New("base and head releases must be different")
+)
+
+type CompareReleasesInput struct {
+	BaseReleaseID id.Release
+	HeadReleaseID id.Release
+}
+
+func (i CompareReleasesInput) Validate() error {
+	if i.BaseReleaseID.IsNil() {
+		return errReleaseComparisonBaseRequired
+	}
+	if i.HeadReleaseID.IsNil() {
+		return errReleaseComparisonHeadRequired
+	}
+	if i.BaseReleaseID == i.HeadReleaseID {
+		return errReleaseComparisonSameRelease
+	}
+
+	return nil
+}
+
+// GitTagComparison is a comparison of two git tags as returned by GitHub.
+type GitTagComparison struct {
+	// Status is one of: ahead, behind, diverged, identical.
+	Status   string
+	AheadBy  int
+	BehindBy int
+	// TotalCommits can be higher than the number of Commits, GitHub returns only the first 250 commits.
+	TotalCommits int
+	Commits      []GitCommit
+	PullRequests []GithubPullRequest
+	Files        []GitChangedFile
+	URL          url.URL
+}
+
+type GitCommit struct {
+	SHA         string
+	Message     string
+	Author      GitCommitAuthor
+	URL         url.URL
+	CommittedAt time.Time
+}
+
+type GitCommitAuthor struct {
+	Name  string
+	Email string
+	// Login is set only if the commit author is linked to a GitHub account.
+	Login *string
+}
+
+// key identifies the author, the same GitHub account can commit with different names and emails.
+func (a GitCommitAuthor) key() string {
+	if a.Login != nil {
+		return "login:" + *a.Login
+	}
+
+	return "email:" + a.Email
+}
+
+type GithubPullRequest struct {
+	Number      int
+	Title       string
+	AuthorLogin string
+	URL         url.URL
+	MergedAt    time.Time
+}
+
+type GitChangedFile struct {
+	Filename string
+	// Status is one of: added, removed, modified, renamed, copied, changed, unchanged.
+	Status    string
+	Additions int
+	Deletions int
+	Changes   int
+}
+
+type ReleaseComparison struct {
+	BaseRelease Release
+	HeadRelease Release
+	GitTagComparison
+	// Authors are unique authors of the compared commits in the order of their first commit.
+	Authors   []GitCommitAuthor
+	Additions int
+	Deletions int
+}
+
+func NewReleaseComparison(base, head Release, c GitTagComparison) ReleaseComparison {
+	cmp := ReleaseComparison{
+		BaseRelease:      base,
+		HeadRelease:      head,
+		GitTagComparison: c,
+		Authors:          make([]GitCommitAuthor, 0),
+	}
+
+	seen := make(map[string]bool)
+	for _, commit := range c.Commits {
+		if key := commit.Author.key(); !seen[key] {
+			seen[key] = true
+			cmp.Authors = append(cmp.Authors, commit.Author)
+		}
+	}
+
+	for _, f := range c.Files {
+		cmp.Additions += f.Additions
+		cmp.Deletions += f.Deletions
+	}
+
+	return cmp
+}
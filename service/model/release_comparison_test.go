@@ -0,0 +1,75 @@
+package model
+
+import (
+	"testing"
+
+	"release-manager/pkg/id"
+	"release-manager/pkg/pointer"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareReleasesInput_Validate(t *testing.T) {
+	releaseID := id.NewRelease()
+
+	tests := []struct {
+		name    string
+		input   CompareReleasesInput
+		wantErr bool
+	}{
+		{
+			name:    "Valid input",
+			input:   CompareReleasesInput{BaseReleaseID: releaseID, HeadReleaseID: id.NewRelease()},
+			wantErr: false,
+		},
+		{
+			name:    "Missing base release",
+			input:   CompareReleasesInput{HeadReleaseID: releaseID},
+			wantErr: true,
+		},
+		{
+			name:    "Missing head release",
+			input:   CompareReleasesInput{BaseReleaseID: releaseID},
+			wantErr: true,
+		},
+		{
+			name:    "Same release",
+			input:   CompareReleasesInput{BaseReleaseID: releaseID, HeadReleaseID: releaseID},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewReleaseComparison(t *testing.T) {
+	jane := GitCommitAuthor{Name: "Jane Doe", Email: "jane@example.com", Login: pointer.StringPtr("jane")}
+	janeOtherEmail := GitCommitAuthor{Name: "Jane", Email: "jane@work.com", Login: pointer.StringPtr("jane")}
+	john := GitCommitAuthor{Name: "John Doe", Email: "john@example.com"}
+
+	cmp := NewReleaseComparison(Release{}, Release{}, GitTagComparison{
+		Commits: []GitCommit{
+			{SHA: "a", Author: jane},
+			{SHA: "b", Author: john},
+			{SHA: "c", Author: janeOtherEmail},
+			{SHA: "d", Author: john},
+		},
+		Files: []GitChangedFile{
+			{Filename: "main.go", Additions: 10, Deletions: 2},
+			{Filename: "README.md", Additions: 1, Deletions: 5},
+		},
+	})
+
+	assert.Equal(t, []GitCommitAuthor{jane, john}, cmp.Authors)
+	assert.Equal(t, 11, cmp.Additions)
+	assert.Equal(t, 7, cmp.Deletions)
+}
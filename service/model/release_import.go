@@ -0,0 +1,110 @@
+package model
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"release-manager/pkg/id"
+
+	"github.com/google/uuid"
+)
+
+// GithubRelease is an existing release on GitHub which can be imported into a project.
+type GithubRelease struct {
+	Tag        GitTag
+	Name       string
+	Body       string
+	Draft      bool
+	Prerelease bool
+	CreatedAt  time.Time
+	Assets     []GithubReleaseAsset
+}
+
+type GithubReleaseAsset struct {
+	Name      string
+	URL       url.URL
+	CreatedAt time.Time
+}
+
+// NewImportedRelease creates a release from an existing GitHub release.
+// Imported releases keep the notes written on GitHub, so they are not validated against the notes template.
+func NewImportedRelease(gr GithubRelease, p Project, authorUserID id.AuthUser) (Release, error) {
+	title := strings.TrimSpace(gr.Name)
+	if title == "" {
+		title = gr.Tag.Name
+	}
+
+	status := ReleaseStatusPublished
+	if gr.Draft {
+		status = ReleaseStatusDraft
+	}
+
+	r := Release{
+		ID:           id.NewRelease(),
+		ProjectID:    p.ID,
+		ReleaseTitle: title,
+		ReleaseNotes: gr.Body,
+		Status:       status,
+		Tag:          gr.Tag,
+		AuthorUserID: authorUserID,
+		CreatedAt:    gr.CreatedAt,
+		UpdatedAt:    time.Now(),
+		GithubOptions: GithubReleaseOptions{
+			Draft:      gr.Draft,
+			Prerelease: gr.Prerelease,
+		},
+		Attachments: make([]ReleaseAttachment, 0, len(gr.Assets)),
+	}
+	r.SetVersion(p)
+
+	for _, a := range gr.Assets {
+		r.Attachments = append(r.Attachments, newExternalReleaseAttachment(a))
+	}
+
+	if err := r.Validate(); err != nil {
+		return Release{}, err
+	}
+
+	return r, nil
+}
+
+// newExternalReleaseAttachment stores only the metadata of the asset, the file stays on GitHub.
+func newExternalReleaseAttachment(a GithubReleaseAsset) ReleaseAttachment {
+	u := a.URL
+	return ReleaseAttachment{
+		ID:          uuid.New(),
+		Name:        a.Name,
+		URL:         u,
+		ExternalURL: &u,
+		CreatedAt:   a.CreatedAt,
+	}
+}
+
+type GithubReleaseImportFailure struct {
+	GitTagName string
+	Reason     string
+}
+
+// GithubReleaseImportSummary lists git tags of the imported GitHub releases, the tags which already had a release
+// and the releases which could not be imported.
+type GithubReleaseImportSummary struct {
+	Imported []string
+	Skipped  []string
+	Failed   []GithubReleaseImportFailure
+}
+
+func (s *GithubReleaseImportSummary) AddImported(tagName string) {
+	s.Imported = append(s.Imported, tagName)
+}
+
+func (s *GithubReleaseImportSummary) AddSkipped(tagName string) {
+	s.Skipped = append(s.Skipped, tagName)
+}
+
+func (s *GithubReleaseImportSummary) AddFailed(tagName string, err error) {
+	s.Failed = append(s.Failed, GithubReleaseImportFailure{
+		GitTagName: tagName,
+		Reason:     err.Error(),
+	})
+}
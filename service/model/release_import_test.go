@@ -0,0 +1,110 @@
+package model
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"release-manager/pkg/id"
+	"release-manager/pkg/pointer"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewImportedRelease(t *testing.T) {
+	createdAt := time.Date(2021, 3, 14, 10, 0, 0, 0, time.UTC)
+	assetURL := url.URL{Scheme: "https", Host: "github.com", Path: "/owner/repo/releases/download/v1.0.0/app.zip"}
+
+	tests := []struct {
+		name           string
+		release        GithubRelease
+		project        Project
+		wantTitle      string
+		wantStatus     ReleaseStatus
+		wantVersion    *string
+		wantPrerelease bool
+		wantErr        bool
+	}{
+		{
+			name: "Published release with assets",
+			release: GithubRelease{
+				Tag:       GitTag{Name: "v1.0.0"},
+				Name:      "First release",
+				Body:      "Initial version",
+				CreatedAt: createdAt,
+				Assets: []GithubReleaseAsset{
+					{Name: "app.zip", URL: assetURL, CreatedAt: createdAt},
+				},
+			},
+			project:     Project{VersionTagPrefix: "v"},
+			wantTitle:   "First release",
+			wantStatus:  ReleaseStatusPublished,
+			wantVersion: pointer.StringPtr("1.0.0"),
+		},
+		{
+			name: "Draft prerelease without name",
+			release: GithubRelease{
+				Tag:        GitTag{Name: "v2.0.0-rc.1"},
+				Draft:      true,
+				Prerelease: true,
+				CreatedAt:  createdAt,
+			},
+			project:        Project{VersionTagPrefix: "v"},
+			wantTitle:      "v2.0.0-rc.1",
+			wantStatus:     ReleaseStatusDraft,
+			wantVersion:    pointer.StringPtr("2.0.0-rc.1"),
+			wantPrerelease: true,
+		},
+		{
+			name: "Notes are not validated against the template",
+			release: GithubRelease{
+				Tag:       GitTag{Name: "release-2020"},
+				Name:      "Old release",
+				Body:      "No sections",
+				CreatedAt: createdAt,
+			},
+			project: Project{
+				ReleaseNotesTemplate: ReleaseNotesTemplate{
+					Sections: []ReleaseNotesTemplateSection{{Title: "Changes", Required: true}},
+				},
+			},
+			wantTitle:  "Old release",
+			wantStatus: ReleaseStatusPublished,
+		},
+		{
+			name:    "Release without title",
+			release: GithubRelease{CreatedAt: createdAt},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewImportedRelease(tt.release, tt.project, id.AuthUser{})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantTitle, r.ReleaseTitle)
+			assert.Equal(t, tt.wantStatus, r.Status)
+			assert.Equal(t, tt.release.Body, r.ReleaseNotes)
+			assert.Equal(t, tt.release.CreatedAt, r.CreatedAt)
+			assert.Equal(t, tt.release.Draft, r.GithubOptions.Draft)
+			assert.Equal(t, tt.wantPrerelease, r.GithubOptions.Prerelease)
+			if tt.wantVersion == nil {
+				assert.Nil(t, r.Version)
+			} else if assert.NotNil(t, r.Version) {
+				assert.Equal(t, *tt.wantVersion, r.Version.String())
+			}
+
+			assert.Len(t, r.Attachments, len(tt.release.Assets))
+			for i, a := range r.Attachments {
+				assert.False(t, a.IsStored())
+				assert.Equal(t, tt.release.Assets[i].Name, a.Name)
+				assert.Equal(t, tt.release.Assets[i].URL, *a.ExternalURL)
+			}
+		})
+	}
+}
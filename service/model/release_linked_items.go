@@ -0,0 +1,54 @@
+package model
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type GithubIssue struct {
+	Number      int
+	Title       string
+	AuthorLogin string
+	URL         url.URL
+	ClosedAt    time.Time
+}
+
+// ReleaseLinkedItems is a snapshot of pull requests merged and issues closed
+// between the git tag of the previous release and the git tag of the release.
+type ReleaseLinkedItems struct {
+	PreviousGitTagName string
+	PullRequests       []GithubPullRequest
+	Issues             []GithubIssue
+	SyncedAt           time.Time
+}
+
+func (i ReleaseLinkedItems) IsEmpty() bool {
+	return len(i.PullRequests) == 0 && len(i.Issues) == 0
+}
+
+// Markdown renders the pull requests and the issues as sections, so they can be appended to release notes.
+func (i ReleaseLinkedItems) Markdown() string {
+	var b strings.Builder
+
+	if len(i.PullRequests) > 0 {
+		b.WriteString("## Pull requests\n\n")
+		for _, pr := range i.PullRequests {
+			b.WriteString(fmt.Sprintf("- %s by @%s in %s\n", pr.Title, pr.AuthorLogin, pr.URL.String()))
+		}
+	}
+
+	if len(i.Issues) > 0 {
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+
+		b.WriteString("## Closed issues\n\n")
+		for _, issue := range i.Issues {
+			b.WriteString(fmt.Sprintf("- %s in %s\n", issue.Title, issue.URL.String()))
+		}
+	}
+
+	return b.String()
+}
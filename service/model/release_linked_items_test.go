@@ -0,0 +1,128 @@
+package model
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReleaseLinkedItems_Markdown(t *testing.T) {
+	prURL, _ := url.Parse("https://github.com/owner/repo/pull/1")
+	issueURL, _ := url.Parse("https://github.com/owner/repo/issues/2")
+
+	pr := GithubPullRequest{Number: 1, Title: "Add login", AuthorLogin: "octocat", URL: *prURL}
+	issue := GithubIssue{Number: 2, Title: "Login fails", URL: *issueURL}
+
+	tests := []struct {
+		name  string
+		items ReleaseLinkedItems
+		want  string
+	}{
+		{
+			name:  "No items",
+			items: ReleaseLinkedItems{},
+			want:  "",
+		},
+		{
+			name:  "Pull requests only",
+			items: ReleaseLinkedItems{PullRequests: []GithubPullRequest{pr}},
+			want:  "## Pull requests\n\n- Add login by @octocat in https://github.com/owner/repo/pull/1\n",
+		},
+		{
+			name:  "Pull requests and issues",
+			items: ReleaseLinkedItems{PullRequests: []GithubPullRequest{pr}, Issues: []GithubIssue{issue}},
+			want: "## Pull requests\n\n- Add login by @octocat in https://github.com/owner/repo/pull/1\n" +
+				"\n## Closed issues\n\n- Login fails in https://github.com/owner/repo/issues/2\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.items.Markdown())
+		})
+	}
+}
+
+func TestGithubReleaseNotes_AppendLinkedItems(t *testing.T) {
+	issueURL, _ := url.Parse("https://github.com/owner/repo/issues/2")
+	items := ReleaseLinkedItems{Issues: []GithubIssue{{Number: 2, Title: "Login fails", URL: *issueURL}}}
+
+	tests := []struct {
+		name  string
+		notes string
+		items ReleaseLinkedItems
+		want  string
+	}{
+		{
+			name:  "No items",
+			notes: "## What's Changed\n",
+			items: ReleaseLinkedItems{},
+			want:  "## What's Changed\n",
+		},
+		{
+			name:  "Items appended after notes",
+			notes: "## What's Changed\n\n",
+			items: items,
+			want:  "## What's Changed\n\n## Closed issues\n\n- Login fails in https://github.com/owner/repo/issues/2\n",
+		},
+		{
+			name:  "Empty notes",
+			notes: "",
+			items: items,
+			want:  "## Closed issues\n\n- Login fails in https://github.com/owner/repo/issues/2\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := GitReleaseNotes{Notes: tt.notes}
+			n.AppendLinkedItems(tt.items)
+			assert.Equal(t, tt.want, n.Notes)
+		})
+	}
+}
+
+func TestNewReleaseNotification_LinkedItems(t *testing.T) {
+	items := &ReleaseLinkedItems{PullRequests: []GithubPullRequest{{Number: 1}}}
+
+	tests := []struct {
+		name            string
+		showLinkedItems bool
+		items           *ReleaseLinkedItems
+		want            *ReleaseLinkedItems
+	}{
+		{
+			name:            "Shown",
+			showLinkedItems: true,
+			items:           items,
+			want:            items,
+		},
+		{
+			name:            "Not shown by the config",
+			showLinkedItems: false,
+			items:           items,
+			want:            nil,
+		},
+		{
+			name:            "Not synced",
+			showLinkedItems: true,
+			items:           nil,
+			want:            nil,
+		},
+		{
+			name:            "No items",
+			showLinkedItems: true,
+			items:           &ReleaseLinkedItems{},
+			want:            nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := Project{ReleaseNotificationConfig: ReleaseNotificationConfig{ShowLinkedItems: tt.showLinkedItems}}
+			n := NewReleaseNotification(p, Release{LinkedItems: tt.items}, nil)
+			assert.Equal(t, tt.want, n.LinkedItems)
+		})
+	}
+}
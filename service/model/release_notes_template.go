@@ -0,0 +1,160 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	errReleaseNotesTemplateSectionTitleRequired  = errors.New("release notes template section title is required")
+	errReleaseNotesTemplateSectionTitleInvalid   = errors.New("release notes template section title must be a single line")
+	errReleaseNotesTemplateSectionTitleDuplicate = errors.New("release notes template section titles must be unique")
+	errReleaseNotesRequiredSectionsMissing       = errors.New("release notes are missing required sections")
+)
+
+// ReleaseNotesTemplate defines sections of release notes, each section is a Markdown heading.
+// An empty template does not prefill or validate release notes.
+type ReleaseNotesTemplate struct {
+	Sections []ReleaseNotesTemplateSection
+}
+
+type ReleaseNotesTemplateSection struct {
+	Title string
+	// Required sections must be present and not empty once the release is ready or published.
+	Required bool
+}
+
+func (t ReleaseNotesTemplate) IsEmpty() bool {
+	return len(t.Sections) == 0
+}
+
+func (t ReleaseNotesTemplate) Validate() error {
+	titles := make(map[string]bool, len(t.Sections))
+	for _, s := range t.Sections {
+		title := strings.TrimSpace(s.Title)
+		if title == "" {
+			return errReleaseNotesTemplateSectionTitleRequired
+		}
+		if strings.ContainsAny(title, "\r\n") {
+			return errReleaseNotesTemplateSectionTitleInvalid
+		}
+
+		key := strings.ToLower(title)
+		if titles[key] {
+			return fmt.Errorf("%w: %s", errReleaseNotesTemplateSectionTitleDuplicate, title)
+		}
+		titles[key] = true
+	}
+
+	return nil
+}
+
+// Render returns release notes with an empty heading for each section, used to prefill new releases.
+func (t ReleaseNotesTemplate) Render() string {
+	headings := make([]string, 0, len(t.Sections))
+	for _, s := range t.Sections {
+		headings = append(headings, "## "+strings.TrimSpace(s.Title)+"\n")
+	}
+
+	return strings.Join(headings, "\n")
+}
+
+// ValidateNotes checks that all required sections are present in the notes and have some content.
+// Section headings are matched case-insensitively and can be of any level.
+func (t ReleaseNotesTemplate) ValidateNotes(notes string) error {
+	sections := parseReleaseNotesSections(notes)
+
+	var missing []string
+	for _, s := range t.Sections {
+		if !s.Required {
+			continue
+		}
+
+		title := strings.TrimSpace(s.Title)
+		if sections[strings.ToLower(title)] == "" {
+			missing = append(missing, title)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %s", errReleaseNotesRequiredSectionsMissing, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// parseReleaseNotesSections maps lowercased heading titles to the trimmed content of their sections.
+// A section ends with the next heading of the same or higher level, so subsections are part of the content.
+func parseReleaseNotesSections(notes string) map[string]string {
+	type section struct {
+		title   string
+		level   int
+		content strings.Builder
+	}
+
+	var (
+		open     []*section
+		sections []*section
+		inCode   bool
+	)
+
+	for _, line := range strings.Split(notes, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCode = !inCode
+		}
+
+		if level, title, ok := parseMarkdownHeading(line); ok && !inCode {
+			for len(open) > 0 && open[len(open)-1].level >= level {
+				open = open[:len(open)-1]
+			}
+			for _, s := range open {
+				s.content.WriteString(line + "\n")
+			}
+
+			s := &section{title: strings.ToLower(title), level: level}
+			open = append(open, s)
+			sections = append(sections, s)
+			continue
+		}
+
+		for _, s := range open {
+			s.content.WriteString(line + "\n")
+		}
+	}
+
+	result := make(map[string]string, len(sections))
+	for _, s := range sections {
+		// The first section with the title wins, duplicates are ignored.
+		if _, exists := result[s.title]; !exists {
+			result[s.title] = strings.TrimSpace(s.content.String())
+		}
+	}
+
+	return result
+}
+
+// parseMarkdownHeading parses ATX headings, e.g. "## Features" returns level 2 and title "Features".
+func parseMarkdownHeading(line string) (level int, title string, ok bool) {
+	line = strings.TrimRight(line, " \t\r")
+	if trimmed := strings.TrimLeft(line, " "); len(line)-len(trimmed) <= 3 {
+		line = trimmed
+	} else {
+		return 0, "", false
+	}
+
+	for level < len(line) && line[level] == '#' {
+		level++
+	}
+	if level == 0 || level > 6 || (level < len(line) && line[level] != ' ') {
+		return 0, "", false
+	}
+
+	// Closing sequence of hashes is optional, e.g. "## Features ##", but it must be preceded by a space.
+	title = strings.TrimSpace(line[level:])
+	if withoutClosing := strings.TrimRight(title, "#"); withoutClosing == "" || strings.HasSuffix(withoutClosing, " ") {
+		title = strings.TrimSpace(withoutClosing)
+	}
+
+	return level, title, true
+}
@@ -0,0 +1,129 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReleaseNotesTemplate_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template ReleaseNotesTemplate
+		wantErr  bool
+	}{
+		{
+			name:     "Empty template",
+			template: ReleaseNotesTemplate{},
+			wantErr:  false,
+		},
+		{
+			name: "Valid template",
+			template: ReleaseNotesTemplate{Sections: []ReleaseNotesTemplateSection{
+				{Title: "Features", Required: true},
+				{Title: "Fixes"},
+			}},
+			wantErr: false,
+		},
+		{
+			name:     "Missing section title",
+			template: ReleaseNotesTemplate{Sections: []ReleaseNotesTemplateSection{{Title: " "}}},
+			wantErr:  true,
+		},
+		{
+			name:     "Multiline section title",
+			template: ReleaseNotesTemplate{Sections: []ReleaseNotesTemplateSection{{Title: "Features\nFixes"}}},
+			wantErr:  true,
+		},
+		{
+			name: "Duplicate section titles",
+			template: ReleaseNotesTemplate{Sections: []ReleaseNotesTemplateSection{
+				{Title: "Features"},
+				{Title: "features"},
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.template.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestReleaseNotesTemplate_Render(t *testing.T) {
+	template := ReleaseNotesTemplate{Sections: []ReleaseNotesTemplateSection{
+		{Title: "Features", Required: true},
+		{Title: "Breaking changes"},
+	}}
+
+	assert.Equal(t, "## Features\n\n## Breaking changes\n", template.Render())
+	assert.Equal(t, "", ReleaseNotesTemplate{}.Render())
+}
+
+func TestReleaseNotesTemplate_ValidateNotes(t *testing.T) {
+	template := ReleaseNotesTemplate{Sections: []ReleaseNotesTemplateSection{
+		{Title: "Features", Required: true},
+		{Title: "Fixes"},
+		{Title: "Breaking changes", Required: true},
+	}}
+
+	tests := []struct {
+		name    string
+		notes   string
+		wantErr bool
+	}{
+		{
+			name:    "All required sections filled",
+			notes:   "## Features\n- Dark mode\n\n## Fixes\n\n## Breaking changes\nNone\n",
+			wantErr: false,
+		},
+		{
+			name:    "Headings of different level and case",
+			notes:   "# features #\n- Dark mode\n### BREAKING CHANGES\n- Removed v1 API\n",
+			wantErr: false,
+		},
+		{
+			name:    "Subsections are part of the section",
+			notes:   "## Features\n### Web\n- Dark mode\n## Breaking changes\nNone\n",
+			wantErr: false,
+		},
+		{
+			name:    "Prefilled template",
+			notes:   template.Render(),
+			wantErr: true,
+		},
+		{
+			name:    "Missing required section",
+			notes:   "## Features\n- Dark mode\n",
+			wantErr: true,
+		},
+		{
+			name:    "Heading in a code block",
+			notes:   "## Features\n- Dark mode\n```\n## Breaking changes\n```\n",
+			wantErr: true,
+		},
+		{
+			name:    "Text without heading",
+			notes:   "Features: dark mode. Breaking changes: none.",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := template.ValidateNotes(tt.notes)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"release-manager/pkg/id"
+)
+
+const (
+	ReleasesPageDefaultLimit = 50
+	ReleasesPageMaxLimit     = 100
+)
+
+var (
+	errReleaseCursorInvalid = errors.New("invalid cursor")
+)
+
+// ReleasePage is a page of releases listed with ListReleasesFilterParams.
+type ReleasePage struct {
+	Releases []Release
+	// NextCursor is nil if there are no more releases.
+	NextCursor *ReleaseCursor
+}
+
+// NewReleasePage expects one release more than the limit to be listed, so it can tell if there is a next page.
+func NewReleasePage(releases []Release, params ListReleasesFilterParams) ReleasePage {
+	if params.Limit == nil || len(releases) <= *params.Limit {
+		return ReleasePage{Releases: releases}
+	}
+
+	releases = releases[:*params.Limit]
+	return ReleasePage{
+		Releases:   releases,
+		NextCursor: NewReleaseCursor(releases[len(releases)-1], params),
+	}
+}
+
+// ReleaseCursor points to the last release of a page. It contains all values the releases are sorted by,
+// so the next page can continue after the release even if the release was deleted in the meantime.
+type ReleaseCursor struct {
+	SortBy    ReleaseSortBy    `json:"sort_by"`
+	SortOrder ReleaseSortOrder `json:"sort_order"`
+	Version   *Version         `json:"version,omitempty"`
+	CreatedAt time.Time        `json:"created_at"`
+	ReleaseID id.Release       `json:"release_id"`
+}
+
+func NewReleaseCursor(rls Release, params ListReleasesFilterParams) *ReleaseCursor {
+	return &ReleaseCursor{
+		SortBy:    params.SortByOrDefault(),
+		SortOrder: params.SortOrderOrDefault(),
+		Version:   rls.Version,
+		CreatedAt: rls.CreatedAt,
+		ReleaseID: rls.ID,
+	}
+}
+
+// String encodes the cursor as an opaque URL-safe string.
+func (c ReleaseCursor) String() string {
+	// Marshaling cannot fail, the cursor contains only strings, time and IDs.
+	data, _ := json.Marshal(releaseCursorJSON(c))
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func (c ReleaseCursor) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+func (c *ReleaseCursor) UnmarshalText(text []byte) error {
+	data, err := base64.RawURLEncoding.DecodeString(string(text))
+	if err != nil {
+		return errReleaseCursorInvalid
+	}
+
+	var cursor releaseCursorJSON
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return errReleaseCursorInvalid
+	}
+	if cursor.ReleaseID.IsNil() || cursor.SortBy.Validate() != nil || cursor.SortOrder.Validate() != nil {
+		return errReleaseCursorInvalid
+	}
+
+	*c = ReleaseCursor(cursor)
+	return nil
+}
+
+// releaseCursorJSON has no text marshaling methods, so the cursor fields can be encoded as JSON.
+type releaseCursorJSON ReleaseCursor
@@ -0,0 +1,186 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"release-manager/pkg/id"
+	"release-manager/pkg/pointer"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListReleasesFilterParams_Validate(t *testing.T) {
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	createdBefore := createdAt.Add(-time.Hour)
+	limit := 10
+	zeroLimit := 0
+
+	tests := []struct {
+		name    string
+		params  ListReleasesFilterParams
+		wantErr bool
+	}{
+		{
+			name:    "Default params",
+			params:  ListReleasesFilterParams{},
+			wantErr: false,
+		},
+		{
+			name: "All filters",
+			params: ListReleasesFilterParams{
+				SortBy:       ReleaseSortByVersion,
+				SortOrder:    ReleaseSortOrderAsc,
+				CreatedFrom:  &createdAt,
+				CreatedTo:    &createdAt,
+				GitTagPrefix: pointer.StringPtr("api/"),
+				Limit:        &limit,
+				Cursor:       &ReleaseCursor{SortBy: ReleaseSortByVersion, SortOrder: ReleaseSortOrderAsc, ReleaseID: id.NewRelease()},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Invalid sort order",
+			params:  ListReleasesFilterParams{SortOrder: "newest"},
+			wantErr: true,
+		},
+		{
+			name: "Invalid created range",
+			params: ListReleasesFilterParams{
+				CreatedFrom: &createdAt,
+				CreatedTo:   &createdBefore,
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Zero limit",
+			params:  ListReleasesFilterParams{Limit: &zeroLimit},
+			wantErr: true,
+		},
+		{
+			name: "Cursor for default sort",
+			params: ListReleasesFilterParams{
+				Cursor: &ReleaseCursor{SortBy: ReleaseSortByCreatedAt, SortOrder: ReleaseSortOrderDesc, ReleaseID: id.NewRelease()},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Cursor for different sort order",
+			params: ListReleasesFilterParams{
+				SortOrder: ReleaseSortOrderAsc,
+				Cursor:    &ReleaseCursor{SortBy: ReleaseSortByCreatedAt, SortOrder: ReleaseSortOrderDesc, ReleaseID: id.NewRelease()},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewReleasePage(t *testing.T) {
+	releases := []Release{
+		{ID: id.NewRelease(), CreatedAt: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), Version: &Version{Major: 3}},
+		{ID: id.NewRelease(), CreatedAt: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), Version: &Version{Major: 2}},
+		{ID: id.NewRelease(), CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	two, three := 2, 3
+
+	tests := []struct {
+		name       string
+		params     ListReleasesFilterParams
+		wantCount  int
+		wantCursor *ReleaseCursor
+	}{
+		{
+			name:      "Without limit",
+			params:    ListReleasesFilterParams{},
+			wantCount: 3,
+		},
+		{
+			name:      "Last page",
+			params:    ListReleasesFilterParams{Limit: &three},
+			wantCount: 3,
+		},
+		{
+			name:      "More releases than limit",
+			params:    ListReleasesFilterParams{SortBy: ReleaseSortByVersion, Limit: &two},
+			wantCount: 2,
+			wantCursor: &ReleaseCursor{
+				SortBy:    ReleaseSortByVersion,
+				SortOrder: ReleaseSortOrderDesc,
+				Version:   &Version{Major: 2},
+				CreatedAt: releases[1].CreatedAt,
+				ReleaseID: releases[1].ID,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := NewReleasePage(releases, tt.params)
+			assert.Len(t, page.Releases, tt.wantCount)
+			assert.Equal(t, tt.wantCursor, page.NextCursor)
+		})
+	}
+}
+
+func TestReleaseCursor_UnmarshalText(t *testing.T) {
+	cursor := ReleaseCursor{
+		SortBy:    ReleaseSortByVersion,
+		SortOrder: ReleaseSortOrderAsc,
+		Version:   &Version{Major: 1, Minor: 2, Prerelease: "rc.1"},
+		CreatedAt: time.Date(2024, 1, 1, 12, 30, 0, 0, time.UTC),
+		ReleaseID: id.NewRelease(),
+	}
+
+	tests := []struct {
+		name    string
+		text    string
+		want    ReleaseCursor
+		wantErr bool
+	}{
+		{
+			name: "Encoded cursor",
+			text: cursor.String(),
+			want: cursor,
+		},
+		{
+			name:    "Not base64",
+			text:    "not a cursor!",
+			wantErr: true,
+		},
+		{
+			name:    "Not JSON",
+			text:    "bm90IGpzb24",
+			wantErr: true,
+		},
+		{
+			name:    "Missing release ID",
+			text:    ReleaseCursor{SortBy: ReleaseSortByCreatedAt, SortOrder: ReleaseSortOrderDesc}.String(),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var c ReleaseCursor
+			err := c.UnmarshalText([]byte(tt.text))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, c)
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"release-manager/pkg/id"
+)
+
+const (
+	ReleasePlanScopeItemStatusTodo       ReleasePlanScopeItemStatus = "todo"
+	ReleasePlanScopeItemStatusInProgress ReleasePlanScopeItemStatus = "in_progress"
+	ReleasePlanScopeItemStatusDone       ReleasePlanScopeItemStatus = "done"
+	ReleasePlanScopeItemStatusDropped    ReleasePlanScopeItemStatus = "dropped"
+)
+
+var (
+	errReleasePlanTitleRequired          = errors.New("release plan title is required")
+	errReleasePlanTargetDateRequired     = errors.New("release plan target date is required")
+	errReleasePlanScopeItemTitleRequired = errors.New("scope item title is required")
+	errReleasePlanScopeItemStatusInvalid = errors.New("invalid scope item status")
+	errReleasePlanAlreadyConverted       = errors.New("release plan has already been converted to a release")
+	errReleasePlanDateRangeInvalid       = errors.New("target date from must not be after target date to")
+
+	validReleasePlanScopeItemStatuses = map[ReleasePlanScopeItemStatus]bool{
+		ReleasePlanScopeItemStatusTodo:       true,
+		ReleasePlanScopeItemStatusInProgress: true,
+		ReleasePlanScopeItemStatusDone:       true,
+		ReleasePlanScopeItemStatusDropped:    true,
+	}
+)
+
+type ReleasePlanScopeItemStatus string
+
+func (s ReleasePlanScopeItemStatus) Validate() error {
+	if _, exists := validReleasePlanScopeItemStatuses[s]; exists {
+		return nil
+	}
+
+	return errReleasePlanScopeItemStatusInvalid
+}
+
+// ReleasePlan is a release which is planned, but does not have a git tag yet.
+// Once the tag exists, the plan is converted to a release and ReleaseID is set.
+type ReleasePlan struct {
+	ID          id.ReleasePlan
+	ProjectID   id.Project
+	Title       string
+	TargetDate  time.Time
+	OwnerUserID *id.User
+	ScopeItems  []ReleasePlanScopeItem
+	// ReleaseID is set when the plan is converted to a release.
+	ReleaseID    *id.Release
+	AuthorUserID id.AuthUser
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type ReleasePlanScopeItem struct {
+	Title  string
+	Status ReleasePlanScopeItemStatus
+}
+
+func (i ReleasePlanScopeItem) Validate() error {
+	if i.Title == "" {
+		return errReleasePlanScopeItemTitleRequired
+	}
+
+	return i.Status.Validate()
+}
+
+type CreateReleasePlanInput struct {
+	Title       string
+	TargetDate  time.Time
+	OwnerUserID *id.User
+	ScopeItems  []ReleasePlanScopeItem
+}
+
+type UpdateReleasePlanInput struct {
+	Title       *string
+	TargetDate  *time.Time
+	OwnerUserID *id.User
+	// UnsetOwner removes the owner from the plan, OwnerUserID is ignored.
+	UnsetOwner bool
+	// ScopeItems replace all existing scope items of the plan.
+	ScopeItems *[]ReleasePlanScopeItem
+}
+
+type ConvertReleasePlanInput struct {
+	GitTagName string
+	// ReleaseNotes are generated from done scope items if not provided.
+	ReleaseNotes *string
+}
+
+type ListReleasePlansFilterParams struct {
+	OwnerUserID    *id.User
+	TargetDateFrom *time.Time
+	TargetDateTo   *time.Time
+	Converted      *bool
+}
+
+func (p ListReleasePlansFilterParams) Validate() error {
+	if p.TargetDateFrom != nil && p.TargetDateTo != nil && p.TargetDateFrom.After(*p.TargetDateTo) {
+		return errReleasePlanDateRangeInvalid
+	}
+
+	return nil
+}
+
+func NewReleasePlan(input CreateReleasePlanInput, projectID id.Project, authorUserID id.AuthUser) (ReleasePlan, error) {
+	now := time.Now()
+	p := ReleasePlan{
+		ID:           id.NewReleasePlan(),
+		ProjectID:    projectID,
+		Title:        input.Title,
+		TargetDate:   input.TargetDate,
+		OwnerUserID:  input.OwnerUserID,
+		ScopeItems:   newReleasePlanScopeItems(input.ScopeItems),
+		AuthorUserID: authorUserID,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	if err := p.Validate(); err != nil {
+		return ReleasePlan{}, err
+	}
+
+	return p, nil
+}
+
+func (p *ReleasePlan) Validate() error {
+	if p.Title == "" {
+		return errReleasePlanTitleRequired
+	}
+	if p.TargetDate.IsZero() {
+		return errReleasePlanTargetDateRequired
+	}
+
+	for _, item := range p.ScopeItems {
+		if err := item.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *ReleasePlan) Update(input UpdateReleasePlanInput) error {
+	if p.IsConverted() {
+		return errReleasePlanAlreadyConverted
+	}
+
+	if input.Title != nil {
+		p.Title = *input.Title
+	}
+	if input.TargetDate != nil {
+		p.TargetDate = *input.TargetDate
+	}
+	if input.UnsetOwner {
+		p.OwnerUserID = nil
+	} else if input.OwnerUserID != nil {
+		p.OwnerUserID = input.OwnerUserID
+	}
+	if input.ScopeItems != nil {
+		p.ScopeItems = newReleasePlanScopeItems(*input.ScopeItems)
+	}
+
+	p.UpdatedAt = time.Now()
+
+	return p.Validate()
+}
+
+func (p *ReleasePlan) IsConverted() bool {
+	return p.ReleaseID != nil
+}
+
+// NewRelease creates a release from the plan and marks the plan as converted.
+func (p *ReleasePlan) NewRelease(input ConvertReleasePlanInput, tag GitTag, authorUserID id.AuthUser) (Release, error) {
+	if p.IsConverted() {
+		return Release{}, errReleasePlanAlreadyConverted
+	}
+
+	notes := p.releaseNotes()
+	if input.ReleaseNotes != nil {
+		notes = *input.ReleaseNotes
+	}
+
+	rls, err := NewRelease(CreateReleaseInput{
+		ReleaseTitle: p.Title,
+		ReleaseNotes: notes,
+		GitTagName:   input.GitTagName,
+	}, tag, p.ProjectID, authorUserID)
+	if err != nil {
+		return Release{}, err
+	}
+
+	p.ReleaseID = &rls.ID
+	p.UpdatedAt = time.Now()
+
+	return rls, nil
+}
+
+// releaseNotes lists done scope items, the rest of the scope did not make it to the release.
+func (p *ReleasePlan) releaseNotes() string {
+	var b strings.Builder
+	for _, item := range p.ScopeItems {
+		if item.Status == ReleasePlanScopeItemStatusDone {
+			fmt.Fprintf(&b, "- %s\n", item.Title)
+		}
+	}
+
+	return b.String()
+}
+
+// newReleasePlanScopeItems sets todo status for items without status.
+func newReleasePlanScopeItems(items []ReleasePlanScopeItem) []ReleasePlanScopeItem {
+	scopeItems := make([]ReleasePlanScopeItem, 0, len(items))
+	for _, item := range items {
+		if item.Status == "" {
+			item.Status = ReleasePlanScopeItemStatusTodo
+		}
+
+		scopeItems = append(scopeItems, item)
+	}
+
+	return scopeItems
+}
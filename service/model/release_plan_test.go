@@ -0,0 +1,270 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"release-manager/pkg/id"
+	"release-manager/pkg/pointer"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReleasePlan_NewReleasePlan(t *testing.T) {
+	targetDate := time.Date(2024, 11, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		input   CreateReleasePlanInput
+		want    []ReleasePlanScopeItem
+		wantErr bool
+	}{
+		{
+			name: "Valid plan",
+			input: CreateReleasePlanInput{
+				Title:      "Release 1.2",
+				TargetDate: targetDate,
+				ScopeItems: []ReleasePlanScopeItem{
+					{Title: "Dark mode"},
+					{Title: "Export", Status: ReleasePlanScopeItemStatusDone},
+				},
+			},
+			want: []ReleasePlanScopeItem{
+				{Title: "Dark mode", Status: ReleasePlanScopeItemStatusTodo},
+				{Title: "Export", Status: ReleasePlanScopeItemStatusDone},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Missing title",
+			input: CreateReleasePlanInput{
+				TargetDate: targetDate,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Missing target date",
+			input: CreateReleasePlanInput{
+				Title: "Release 1.2",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Scope item without title",
+			input: CreateReleasePlanInput{
+				Title:      "Release 1.2",
+				TargetDate: targetDate,
+				ScopeItems: []ReleasePlanScopeItem{{Title: ""}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Invalid scope item status",
+			input: CreateReleasePlanInput{
+				Title:      "Release 1.2",
+				TargetDate: targetDate,
+				ScopeItems: []ReleasePlanScopeItem{{Title: "Dark mode", Status: "postponed"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewReleasePlan(tt.input, id.NewProject(), id.AuthUser{})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, p.ScopeItems)
+			assert.False(t, p.IsConverted())
+		})
+	}
+}
+
+func TestReleasePlan_Update(t *testing.T) {
+	ownerID := id.User(uuid.New())
+	newOwnerID := id.User(uuid.New())
+	releaseID := id.NewRelease()
+	targetDate := time.Date(2024, 11, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		plan    ReleasePlan
+		input   UpdateReleasePlanInput
+		want    ReleasePlan
+		wantErr bool
+	}{
+		{
+			name: "Update title and owner",
+			plan: ReleasePlan{Title: "Release 1.2", TargetDate: targetDate, OwnerUserID: &ownerID},
+			input: UpdateReleasePlanInput{
+				Title:       pointer.StringPtr("Release 1.3"),
+				OwnerUserID: &newOwnerID,
+			},
+			want:    ReleasePlan{Title: "Release 1.3", TargetDate: targetDate, OwnerUserID: &newOwnerID},
+			wantErr: false,
+		},
+		{
+			name: "Unset owner",
+			plan: ReleasePlan{Title: "Release 1.2", TargetDate: targetDate, OwnerUserID: &ownerID},
+			input: UpdateReleasePlanInput{
+				OwnerUserID: &newOwnerID,
+				UnsetOwner:  true,
+			},
+			want:    ReleasePlan{Title: "Release 1.2", TargetDate: targetDate},
+			wantErr: false,
+		},
+		{
+			name: "Replace scope items",
+			plan: ReleasePlan{
+				Title:      "Release 1.2",
+				TargetDate: targetDate,
+				ScopeItems: []ReleasePlanScopeItem{{Title: "Dark mode", Status: ReleasePlanScopeItemStatusInProgress}},
+			},
+			input: UpdateReleasePlanInput{
+				ScopeItems: &[]ReleasePlanScopeItem{{Title: "Export"}},
+			},
+			want: ReleasePlan{
+				Title:      "Release 1.2",
+				TargetDate: targetDate,
+				ScopeItems: []ReleasePlanScopeItem{{Title: "Export", Status: ReleasePlanScopeItemStatusTodo}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Empty title",
+			plan: ReleasePlan{Title: "Release 1.2", TargetDate: targetDate},
+			input: UpdateReleasePlanInput{
+				Title: pointer.StringPtr(""),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Converted plan",
+			plan: ReleasePlan{Title: "Release 1.2", TargetDate: targetDate, ReleaseID: &releaseID},
+			input: UpdateReleasePlanInput{
+				Title: pointer.StringPtr("Release 1.3"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.plan.Update(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want.Title, tt.plan.Title)
+			assert.Equal(t, tt.want.TargetDate, tt.plan.TargetDate)
+			assert.Equal(t, tt.want.OwnerUserID, tt.plan.OwnerUserID)
+			assert.Equal(t, tt.want.ScopeItems, tt.plan.ScopeItems)
+		})
+	}
+}
+
+func TestReleasePlan_NewRelease(t *testing.T) {
+	releaseID := id.NewRelease()
+	scopeItems := []ReleasePlanScopeItem{
+		{Title: "Dark mode", Status: ReleasePlanScopeItemStatusDone},
+		{Title: "Export", Status: ReleasePlanScopeItemStatusDropped},
+		{Title: "Import", Status: ReleasePlanScopeItemStatusDone},
+	}
+
+	tests := []struct {
+		name      string
+		plan      ReleasePlan
+		input     ConvertReleasePlanInput
+		wantNotes string
+		wantErr   bool
+	}{
+		{
+			name:      "Notes from done scope items",
+			plan:      ReleasePlan{Title: "Release 1.2", ScopeItems: scopeItems},
+			input:     ConvertReleasePlanInput{GitTagName: "v1.2.0"},
+			wantNotes: "- Dark mode\n- Import\n",
+			wantErr:   false,
+		},
+		{
+			name: "Custom notes",
+			plan: ReleasePlan{Title: "Release 1.2", ScopeItems: scopeItems},
+			input: ConvertReleasePlanInput{
+				GitTagName:   "v1.2.0",
+				ReleaseNotes: pointer.StringPtr("Custom notes"),
+			},
+			wantNotes: "Custom notes",
+			wantErr:   false,
+		},
+		{
+			name:    "Already converted",
+			plan:    ReleasePlan{Title: "Release 1.2", ReleaseID: &releaseID},
+			input:   ConvertReleasePlanInput{GitTagName: "v1.2.0"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rls, err := tt.plan.NewRelease(tt.input, GitTag{Name: tt.input.GitTagName}, id.AuthUser{})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.plan.Title, rls.ReleaseTitle)
+			assert.Equal(t, tt.wantNotes, rls.ReleaseNotes)
+			assert.Equal(t, &rls.ID, tt.plan.ReleaseID)
+		})
+	}
+}
+
+func TestListReleasePlansFilterParams_Validate(t *testing.T) {
+	from := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 11, 30, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		params  ListReleasePlansFilterParams
+		wantErr bool
+	}{
+		{
+			name:    "No filters",
+			params:  ListReleasePlansFilterParams{},
+			wantErr: false,
+		},
+		{
+			name:    "Valid date range",
+			params:  ListReleasePlansFilterParams{TargetDateFrom: &from, TargetDateTo: &to},
+			wantErr: false,
+		},
+		{
+			name:    "Same day range",
+			params:  ListReleasePlansFilterParams{TargetDateFrom: &from, TargetDateTo: &from},
+			wantErr: false,
+		},
+		{
+			name:    "Inverted date range",
+			params:  ListReleasePlansFilterParams{TargetDateFrom: &to, TargetDateTo: &from},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
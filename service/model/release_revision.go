@@ -0,0 +1,173 @@
+package model
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"release-manager/pkg/id"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DiffLineKindEqual   DiffLineKind = "equal"
+	DiffLineKindAdded   DiffLineKind = "added"
+	DiffLineKindRemoved DiffLineKind = "removed"
+)
+
+var (
+	errReleaseRevisionNumberInvalid = errors.New("release revision number must be greater than 0")
+	errReleaseRevisionDiffSame      = errors.New("compared release revisions must be different")
+)
+
+// ReleaseRevision is created on every change of the release title or notes.
+// Revisions of a release are numbered from 1 in the order they were created.
+type ReleaseRevision struct {
+	ID           uuid.UUID
+	ReleaseID    id.Release
+	Number       int
+	Before       ReleaseContent
+	After        ReleaseContent
+	AuthorUserID id.AuthUser
+	CreatedAt    time.Time
+}
+
+// ReleaseContent is the part of the release which is tracked by revisions.
+type ReleaseContent struct {
+	ReleaseTitle string
+	ReleaseNotes string
+}
+
+type DiffReleaseRevisionsParams struct {
+	// FromNumber can be 0 to compare with the release content before the first revision.
+	FromNumber int
+	ToNumber   int
+}
+
+func (p DiffReleaseRevisionsParams) Validate() error {
+	if p.FromNumber < 0 || p.ToNumber < 1 {
+		return errReleaseRevisionNumberInvalid
+	}
+	if p.FromNumber == p.ToNumber {
+		return errReleaseRevisionDiffSame
+	}
+
+	return nil
+}
+
+type DiffLineKind string
+
+type DiffLine struct {
+	Kind DiffLineKind
+	Text string
+}
+
+type ReleaseRevisionDiff struct {
+	From         ReleaseContent
+	To           ReleaseContent
+	FromNumber   int
+	ToNumber     int
+	TitleChanged bool
+	NotesDiff    []DiffLine
+}
+
+// NewReleaseRevision returns nil if neither the title nor the notes of the release were changed.
+func NewReleaseRevision(before, after Release, authorUserID id.AuthUser) *ReleaseRevision {
+	b, a := before.Content(), after.Content()
+	if b == a {
+		return nil
+	}
+
+	return &ReleaseRevision{
+		ID:           uuid.New(),
+		ReleaseID:    after.ID,
+		Before:       b,
+		After:        a,
+		AuthorUserID: authorUserID,
+		CreatedAt:    time.Now(),
+	}
+}
+
+// NewReleaseRevisionDiff compares the content of the release after two revisions.
+// The from content is the content before the first revision if the from number is 0.
+func NewReleaseRevisionDiff(fromNumber int, from ReleaseContent, to ReleaseRevision) ReleaseRevisionDiff {
+	return ReleaseRevisionDiff{
+		From:         from,
+		To:           to.After,
+		FromNumber:   fromNumber,
+		ToNumber:     to.Number,
+		TitleChanged: from.ReleaseTitle != to.After.ReleaseTitle,
+		NotesDiff:    DiffLines(from.ReleaseNotes, to.After.ReleaseNotes),
+	}
+}
+
+func (r *Release) Content() ReleaseContent {
+	return ReleaseContent{
+		ReleaseTitle: r.ReleaseTitle,
+		ReleaseNotes: r.ReleaseNotes,
+	}
+}
+
+// Restore returns the input which updates the release to the content after the revision.
+func (r ReleaseRevision) Restore() UpdateReleaseInput {
+	return UpdateReleaseInput{
+		ReleaseTitle: &r.After.ReleaseTitle,
+		ReleaseNotes: &r.After.ReleaseNotes,
+	}
+}
+
+// DiffLines returns a line diff of two texts based on their longest common subsequence.
+// Removed lines are placed before added lines where a block of lines was replaced.
+func DiffLines(a, b string) []DiffLine {
+	aLines, bLines := splitLines(a), splitLines(b)
+
+	// lcs[i][j] is the length of the longest common subsequence of aLines[i:] and bLines[j:].
+	lcs := make([][]int, len(aLines)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(bLines)+1)
+	}
+	for i := len(aLines) - 1; i >= 0; i-- {
+		for j := len(bLines) - 1; j >= 0; j-- {
+			if aLines[i] == bLines[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	diff := make([]DiffLine, 0, max(len(aLines), len(bLines)))
+	i, j := 0, 0
+	for i < len(aLines) && j < len(bLines) {
+		switch {
+		case aLines[i] == bLines[j]:
+			diff = append(diff, DiffLine{Kind: DiffLineKindEqual, Text: aLines[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			diff = append(diff, DiffLine{Kind: DiffLineKindRemoved, Text: aLines[i]})
+			i++
+		default:
+			diff = append(diff, DiffLine{Kind: DiffLineKindAdded, Text: bLines[j]})
+			j++
+		}
+	}
+	for ; i < len(aLines); i++ {
+		diff = append(diff, DiffLine{Kind: DiffLineKindRemoved, Text: aLines[i]})
+	}
+	for ; j < len(bLines); j++ {
+		diff = append(diff, DiffLine{Kind: DiffLineKindAdded, Text: bLines[j]})
+	}
+
+	return diff
+}
+
+// splitLines splits text into lines, empty text has no lines and a trailing newline does not start a new line.
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
@@ -0,0 +1,178 @@
+package model
+
+import (
+	"testing"
+
+	"release-manager/pkg/id"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffReleaseRevisionsParams_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  DiffReleaseRevisionsParams
+		wantErr bool
+	}{
+		{
+			name:    "Valid params",
+			params:  DiffReleaseRevisionsParams{FromNumber: 1, ToNumber: 3},
+			wantErr: false,
+		},
+		{
+			name:    "Compare with content before the first revision",
+			params:  DiffReleaseRevisionsParams{FromNumber: 0, ToNumber: 1},
+			wantErr: false,
+		},
+		{
+			name:    "Newer revision first",
+			params:  DiffReleaseRevisionsParams{FromNumber: 3, ToNumber: 1},
+			wantErr: false,
+		},
+		{
+			name:    "Negative from number",
+			params:  DiffReleaseRevisionsParams{FromNumber: -1, ToNumber: 1},
+			wantErr: true,
+		},
+		{
+			name:    "Missing to number",
+			params:  DiffReleaseRevisionsParams{FromNumber: 1},
+			wantErr: true,
+		},
+		{
+			name:    "Same revisions",
+			params:  DiffReleaseRevisionsParams{FromNumber: 2, ToNumber: 2},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewReleaseRevision(t *testing.T) {
+	authorUserID := id.AuthUser{}
+
+	tests := []struct {
+		name   string
+		before Release
+		after  Release
+		want   *ReleaseContent
+	}{
+		{
+			name:   "Notes changed",
+			before: Release{ReleaseTitle: "Title", ReleaseNotes: "Old notes"},
+			after:  Release{ReleaseTitle: "Title", ReleaseNotes: "New notes"},
+			want:   &ReleaseContent{ReleaseTitle: "Title", ReleaseNotes: "New notes"},
+		},
+		{
+			name:   "Title changed",
+			before: Release{ReleaseTitle: "Old title", ReleaseNotes: "Notes"},
+			after:  Release{ReleaseTitle: "New title", ReleaseNotes: "Notes"},
+			want:   &ReleaseContent{ReleaseTitle: "New title", ReleaseNotes: "Notes"},
+		},
+		{
+			name:   "Only status changed",
+			before: Release{ReleaseTitle: "Title", ReleaseNotes: "Notes", Status: ReleaseStatusDraft},
+			after:  Release{ReleaseTitle: "Title", ReleaseNotes: "Notes", Status: ReleaseStatusReady},
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rev := NewReleaseRevision(tt.before, tt.after, authorUserID)
+			if tt.want == nil {
+				assert.Nil(t, rev)
+				return
+			}
+
+			assert.NotNil(t, rev)
+			assert.Equal(t, tt.before.Content(), rev.Before)
+			assert.Equal(t, *tt.want, rev.After)
+		})
+	}
+}
+
+func TestReleaseRevision_Restore(t *testing.T) {
+	rls := Release{ReleaseTitle: "Current title", ReleaseNotes: "Current notes", Status: ReleaseStatusDraft}
+	rev := ReleaseRevision{
+		Number: 1,
+		Before: ReleaseContent{ReleaseTitle: "First title", ReleaseNotes: "First notes"},
+		After:  ReleaseContent{ReleaseTitle: "Second title", ReleaseNotes: "Second notes"},
+	}
+
+	err := rls.Update(rev.Restore())
+	assert.NoError(t, err)
+	assert.Equal(t, rev.After, rls.Content())
+}
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want []DiffLine
+	}{
+		{
+			name: "Same texts",
+			a:    "one\ntwo\n",
+			b:    "one\ntwo",
+			want: []DiffLine{
+				{Kind: DiffLineKindEqual, Text: "one"},
+				{Kind: DiffLineKindEqual, Text: "two"},
+			},
+		},
+		{
+			name: "Empty texts",
+			a:    "",
+			b:    "",
+			want: []DiffLine{},
+		},
+		{
+			name: "Added to empty text",
+			a:    "",
+			b:    "one\ntwo",
+			want: []DiffLine{
+				{Kind: DiffLineKindAdded, Text: "one"},
+				{Kind: DiffLineKindAdded, Text: "two"},
+			},
+		},
+		{
+			name: "Replaced line",
+			a:    "## Features\n- old\n## Fixes",
+			b:    "## Features\n- new\n## Fixes",
+			want: []DiffLine{
+				{Kind: DiffLineKindEqual, Text: "## Features"},
+				{Kind: DiffLineKindRemoved, Text: "- old"},
+				{Kind: DiffLineKindAdded, Text: "- new"},
+				{Kind: DiffLineKindEqual, Text: "## Fixes"},
+			},
+		},
+		{
+			name: "Removed and appended lines",
+			a:    "one\ntwo\nthree",
+			b:    "one\nthree\nfour",
+			want: []DiffLine{
+				{Kind: DiffLineKindEqual, Text: "one"},
+				{Kind: DiffLineKindRemoved, Text: "two"},
+				{Kind: DiffLineKindEqual, Text: "three"},
+				{Kind: DiffLineKindAdded, Text: "four"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DiffLines(tt.a, tt.b))
+		})
+	}
+}
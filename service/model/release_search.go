@@ -0,0 +1,73 @@
+package model
+
+import (
+	"errors"
+	"html"
+	"strings"
+	"time"
+
+	"release-manager/pkg/id"
+)
+
+const (
+	ReleaseSearchDefaultLimit = 20
+	ReleaseSearchMaxLimit     = 100
+
+	releaseSearchQueryMaxLength = 200
+
+	// ReleaseSearchHighlightStart and ReleaseSearchHighlightStop delimit the matched words in the highlights
+	// returned by search_releases.sql. The control characters are removed from the text before it is highlighted,
+	// so they cannot be confused with the text of the release.
+	ReleaseSearchHighlightStart = "\x02"
+	ReleaseSearchHighlightStop  = "\x03"
+)
+
+var (
+	errReleaseSearchQueryRequired = errors.New("search query is required")
+	errReleaseSearchQueryTooLong  = errors.New("search query must not be longer than 200 characters")
+	errReleaseSearchLimitInvalid  = errors.New("limit must be between 1 and 100")
+)
+
+type SearchReleasesParams struct {
+	// Query supports the web search syntax, e.g. quoted phrases, "or" and "-" for excluded words.
+	Query string
+	Limit int
+}
+
+func (p SearchReleasesParams) Validate() error {
+	query := strings.TrimSpace(p.Query)
+	if query == "" {
+		return errReleaseSearchQueryRequired
+	}
+	if len([]rune(query)) > releaseSearchQueryMaxLength {
+		return errReleaseSearchQueryTooLong
+	}
+	if p.Limit < 1 || p.Limit > ReleaseSearchMaxLimit {
+		return errReleaseSearchLimitInvalid
+	}
+
+	return nil
+}
+
+// ReleaseSearchHit is a release matching the search query, hits are ordered from the highest rank.
+type ReleaseSearchHit struct {
+	ReleaseID    id.Release
+	ProjectID    id.Project
+	ProjectName  string
+	ReleaseTitle string
+	GitTagName   string
+	Status       ReleaseStatus
+	CreatedAt    time.Time
+	Rank         float64
+	// TitleHighlight and NotesSnippet are HTML, the text is escaped and the matched words are wrapped in <mark> and </mark>.
+	TitleHighlight string
+	NotesSnippet   string
+}
+
+// ToReleaseSearchHighlightHTML escapes the text of the highlight and replaces the delimiters of the matched words with <mark> and </mark>.
+func ToReleaseSearchHighlightHTML(highlight string) string {
+	return strings.NewReplacer(
+		ReleaseSearchHighlightStart, "<mark>",
+		ReleaseSearchHighlightStop, "</mark>",
+	).Replace(html.EscapeString(highlight))
+}
@@ -0,0 +1,83 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchReleasesParams_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  SearchReleasesParams
+		wantErr bool
+	}{
+		{
+			name:    "Valid params",
+			params:  SearchReleasesParams{Query: "login bug", Limit: ReleaseSearchDefaultLimit},
+			wantErr: false,
+		},
+		{
+			name:    "Blank query",
+			params:  SearchReleasesParams{Query: "  ", Limit: ReleaseSearchDefaultLimit},
+			wantErr: true,
+		},
+		{
+			name:    "Too long query",
+			params:  SearchReleasesParams{Query: strings.Repeat("a", 201), Limit: ReleaseSearchDefaultLimit},
+			wantErr: true,
+		},
+		{
+			name:    "Zero limit",
+			params:  SearchReleasesParams{Query: "login"},
+			wantErr: true,
+		},
+		{
+			name:    "Too high limit",
+			params:  SearchReleasesParams{Query: "login", Limit: ReleaseSearchMaxLimit + 1},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestToReleaseSearchHighlightHTML(t *testing.T) {
+	tests := []struct {
+		name      string
+		highlight string
+		want      string
+	}{
+		{
+			name:      "Matched words are marked",
+			highlight: "Fixed \x02login\x03 release",
+			want:      "Fixed <mark>login</mark> release",
+		},
+		{
+			name:      "Markup of the release is escaped",
+			highlight: "<mark>Fixed</mark> \x02login\x03 <script>alert(1)</script>",
+			want:      "&lt;mark&gt;Fixed&lt;/mark&gt; <mark>login</mark> &lt;script&gt;alert(1)&lt;/script&gt;",
+		},
+		{
+			name:      "No match",
+			highlight: "Tom & Jerry",
+			want:      "Tom &amp; Jerry",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ToReleaseSearchHighlightHTML(tt.highlight))
+		})
+	}
+}
@@ -1,7 +1,11 @@
 package model
 
 import (
+	"errors"
+	"io"
+	"strings"
 	"testing"
+	"testing/iotest"
 	"time"
 
 	"release-manager/pkg/id"
@@ -101,6 +105,7 @@ func TestRelease_Update(t *testing.T) {
 				ProjectID:    id.NewProject(),
 				ReleaseTitle: "Initial Title",
 				ReleaseNotes: "Initial Notes",
+				Status:       ReleaseStatusDraft,
 				Tag: GitTag{
 					Name: "v1.0.0",
 					URL:  *validURL,
@@ -127,26 +132,26 @@ func TestRelease_Update(t *testing.T) {
 func TestGithubGeneratedReleaseNotesInput_Validate(t *testing.T) {
 	tests := []struct {
 		name    string
-		input   GithubReleaseNotesInput
+		input   GitReleaseNotesInput
 		wantErr bool
 	}{
 		{
 			name: "Valid GitTagName",
-			input: GithubReleaseNotesInput{
+			input: GitReleaseNotesInput{
 				GitTagName: pointer.StringPtr("v1.0.0"),
 			},
 			wantErr: false,
 		},
 		{
 			name: "Nil GitTagName",
-			input: GithubReleaseNotesInput{
+			input: GitReleaseNotesInput{
 				GitTagName: nil,
 			},
 			wantErr: true,
 		},
 		{
 			name: "Empty GitTagName",
-			input: GithubReleaseNotesInput{
+			input: GitReleaseNotesInput{
 				GitTagName: pointer.StringPtr(""),
 			},
 			wantErr: true,
@@ -164,3 +169,395 @@ func TestGithubGeneratedReleaseNotesInput_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestRelease_UpdateStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    ReleaseStatus
+		to      ReleaseStatus
+		wantErr bool
+	}{
+		{
+			name:    "Draft to ready",
+			from:    ReleaseStatusDraft,
+			to:      ReleaseStatusReady,
+			wantErr: false,
+		},
+		{
+			name:    "Ready to published",
+			from:    ReleaseStatusReady,
+			to:      ReleaseStatusPublished,
+			wantErr: false,
+		},
+		{
+			name:    "Ready back to draft",
+			from:    ReleaseStatusReady,
+			to:      ReleaseStatusDraft,
+			wantErr: false,
+		},
+		{
+			name:    "Published to deprecated",
+			from:    ReleaseStatusPublished,
+			to:      ReleaseStatusDeprecated,
+			wantErr: false,
+		},
+		{
+			name:    "Deprecated to yanked",
+			from:    ReleaseStatusDeprecated,
+			to:      ReleaseStatusYanked,
+			wantErr: false,
+		},
+		{
+			name:    "Draft to published - ready status skipped",
+			from:    ReleaseStatusDraft,
+			to:      ReleaseStatusPublished,
+			wantErr: true,
+		},
+		{
+			name:    "Published back to draft",
+			from:    ReleaseStatusPublished,
+			to:      ReleaseStatusDraft,
+			wantErr: true,
+		},
+		{
+			name:    "Yanked to published",
+			from:    ReleaseStatusYanked,
+			to:      ReleaseStatusPublished,
+			wantErr: true,
+		},
+		{
+			name:    "Same status",
+			from:    ReleaseStatusReady,
+			to:      ReleaseStatusReady,
+			wantErr: true,
+		},
+		{
+			name:    "Invalid status",
+			from:    ReleaseStatusReady,
+			to:      ReleaseStatus("archived"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := Release{
+				ReleaseTitle: "Release 1.0",
+				Status:       tt.from,
+			}
+
+			err := r.UpdateStatus(tt.to)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.from, r.Status)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.to, r.Status)
+			}
+		})
+	}
+}
+
+func TestRelease_NotesTemplate(t *testing.T) {
+	template := ReleaseNotesTemplate{Sections: []ReleaseNotesTemplateSection{
+		{Title: "Features", Required: true},
+		{Title: "Fixes"},
+	}}
+
+	tests := []struct {
+		name    string
+		notes   string
+		from    ReleaseStatus
+		to      ReleaseStatus
+		wantErr bool
+	}{
+		{
+			name:    "Prefilled notes can stay in draft",
+			from:    ReleaseStatusReady,
+			to:      ReleaseStatusDraft,
+			wantErr: false,
+		},
+		{
+			name:    "Prefilled notes cannot be ready",
+			from:    ReleaseStatusDraft,
+			to:      ReleaseStatusReady,
+			wantErr: true,
+		},
+		{
+			name:    "Filled notes can be published",
+			notes:   "## Features\n- Dark mode\n",
+			from:    ReleaseStatusReady,
+			to:      ReleaseStatusPublished,
+			wantErr: false,
+		},
+		{
+			name:    "Published release with old notes can be yanked",
+			notes:   "Dark mode",
+			from:    ReleaseStatusPublished,
+			to:      ReleaseStatusYanked,
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := Release{
+				ReleaseTitle: "Release 1.0",
+				ReleaseNotes: tt.notes,
+				Status:       tt.from,
+			}
+			r.SetNotesTemplate(Project{ReleaseNotesTemplate: template})
+
+			if tt.notes == "" {
+				assert.Equal(t, template.Render(), r.ReleaseNotes)
+			} else {
+				assert.Equal(t, tt.notes, r.ReleaseNotes)
+			}
+
+			err := r.UpdateStatus(tt.to)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRelease_NewReleaseAttachment(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   ReleaseAttachmentInput
+		wantErr bool
+	}{
+		{
+			name: "Valid attachment",
+			input: ReleaseAttachmentInput{
+				Name:        "app.zip",
+				ContentType: "application/zip",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Valid attachment - content type with parameters",
+			input: ReleaseAttachmentInput{
+				Name:        "notes.txt",
+				ContentType: "text/plain; charset=utf-8",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Invalid attachment - missing name",
+			input: ReleaseAttachmentInput{
+				Name:        "",
+				ContentType: "application/zip",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Invalid attachment - name with path",
+			input: ReleaseAttachmentInput{
+				Name:        "../app.zip",
+				ContentType: "application/zip",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Invalid attachment - content type not allowed",
+			input: ReleaseAttachmentInput{
+				Name:        "index.html",
+				ContentType: "text/html",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Invalid attachment - missing content type",
+			input: ReleaseAttachmentInput{
+				Name:        "app.zip",
+				ContentType: "",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			releaseID := id.NewRelease()
+			a, err := NewReleaseAttachment(tt.input, releaseID)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.input.Name, a.Name)
+				assert.True(t, strings.HasPrefix(a.FilePath, "releases/"+releaseID.String()+"/"))
+				assert.True(t, strings.HasSuffix(a.FilePath, "/"+tt.input.Name))
+			}
+		})
+	}
+}
+
+func TestRelease_ReplaceReleaseAttachment(t *testing.T) {
+	releaseID := id.NewRelease()
+	a, err := NewReleaseAttachment(ReleaseAttachmentInput{
+		Name:        "app.zip",
+		ContentType: "application/zip",
+	}, releaseID)
+	assert.NoError(t, err)
+
+	replaced := a
+	err = replaced.Replace(ReleaseAttachmentInput{
+		Name:        "app.zip",
+		ContentType: "application/zip",
+	}, releaseID)
+	assert.NoError(t, err)
+	assert.Equal(t, a.ID, replaced.ID)
+	assert.NotEqual(t, a.FilePath, replaced.FilePath)
+
+	err = replaced.Replace(ReleaseAttachmentInput{
+		Name:        "index.html",
+		ContentType: "text/html",
+	}, releaseID)
+	assert.Error(t, err)
+}
+
+func TestRelease_ReleaseAttachmentContent(t *testing.T) {
+	tests := []struct {
+		name         string
+		size         int
+		wantTooLarge bool
+	}{
+		{
+			name:         "Content within limit",
+			size:         ReleaseAttachmentMaxSize,
+			wantTooLarge: false,
+		},
+		{
+			name:         "Content exceeding limit",
+			size:         ReleaseAttachmentMaxSize + 1,
+			wantTooLarge: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := NewReleaseAttachmentContent(io.LimitReader(zeroReader{}, int64(tt.size)))
+			_, err := io.Copy(io.Discard, content)
+			if tt.wantTooLarge {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantTooLarge, content.IsTooLarge())
+		})
+	}
+}
+
+func TestRelease_ReleaseAttachmentContent_ReadErr(t *testing.T) {
+	readErr := errors.New("request body too large")
+	content := NewReleaseAttachmentContent(io.MultiReader(io.LimitReader(zeroReader{}, 10), iotest.ErrReader(readErr)))
+
+	_, err := io.Copy(io.Discard, content)
+	assert.ErrorIs(t, err, readErr)
+	assert.ErrorIs(t, content.ReadErr(), readErr)
+	assert.False(t, content.IsTooLarge())
+
+	content = NewReleaseAttachmentContent(io.LimitReader(zeroReader{}, 10))
+	_, err = io.Copy(io.Discard, content)
+	assert.NoError(t, err)
+	assert.NoError(t, content.ReadErr())
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
+
+func TestRelease_ValidateVersionAfter(t *testing.T) {
+	tests := []struct {
+		name    string
+		release Release
+		last    Release
+		wantErr bool
+	}{
+		{
+			name:    "Greater version",
+			release: Release{Version: &Version{Major: 1, Minor: 1}},
+			last:    Release{Version: &Version{Major: 1}},
+			wantErr: false,
+		},
+		{
+			name:    "Release of published prerelease",
+			release: Release{Version: &Version{Major: 1}},
+			last:    Release{Version: &Version{Major: 1, Prerelease: "rc.1"}},
+			wantErr: false,
+		},
+		{
+			name:    "Release without version",
+			release: Release{},
+			last:    Release{Version: &Version{Major: 1}},
+			wantErr: false,
+		},
+		{
+			name:    "Same version",
+			release: Release{Version: &Version{Major: 1}},
+			last:    Release{Version: &Version{Major: 1, Build: "5"}},
+			wantErr: true,
+		},
+		{
+			name:    "Lower version",
+			release: Release{Version: &Version{Major: 1, Minor: 9}},
+			last:    Release{Version: &Version{Major: 2}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.release.ValidateVersionAfter(tt.last)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewNextReleaseVersion(t *testing.T) {
+	p := Project{VersionTagPrefix: "service-a/v"}
+
+	tests := []struct {
+		name string
+		last *Release
+		bump VersionBump
+		want NextReleaseVersion
+	}{
+		{
+			name: "First release",
+			last: nil,
+			bump: VersionBumpMinor,
+			want: NextReleaseVersion{
+				Version:    Version{Minor: 1},
+				GitTagName: "service-a/v0.1.0",
+			},
+		},
+		{
+			name: "Patch release",
+			last: &Release{Version: &Version{Major: 1, Minor: 2, Patch: 3}},
+			bump: VersionBumpPatch,
+			want: NextReleaseVersion{
+				PreviousVersion: &Version{Major: 1, Minor: 2, Patch: 3},
+				Version:         Version{Major: 1, Minor: 2, Patch: 4},
+				GitTagName:      "service-a/v1.2.4",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NewNextReleaseVersion(p, tt.last, tt.bump))
+		})
+	}
+}
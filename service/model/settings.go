@@ -1,14 +1,33 @@
 package model
 
 import (
+	"encoding/pem"
 	"errors"
+	"net/url"
+	"strings"
+
+	cryptox "release-manager/pkg/crypto"
 )
 
 var (
-	errOrganizationNameRequired  = errors.New("organization name is required")
-	errDefaultReleaseMsgRequired = errors.New("default release message is required")
-	errSlackMissingToken         = errors.New("token is required to enable slack integration")
-	errGithubMissingToken        = errors.New("token is required to enable github integration")
+	errOrganizationNameRequired        = errors.New("organization name is required")
+	errDefaultReleaseMsgRequired       = errors.New("default release message is required")
+	errSlackMissingToken               = errors.New("token is required to enable slack integration")
+	errGithubMissingToken              = errors.New("token or github app credentials are required to enable github integration")
+	errGithubAppIDRequired             = errors.New("github app ID is required")
+	errGithubAppInstallationIDRequired = errors.New("github app installation ID is required")
+	errGithubAppPrivateKeyFormat       = errors.New("github app private key must be PEM encoded")
+	errGitlabMissingToken              = errors.New("token is required to enable gitlab integration")
+	errGitlabBaseURLInvalid            = errors.New("gitlab base URL must be an absolute http or https URL")
+	errBitbucketMissingToken           = errors.New("token is required to enable bitbucket integration")
+	errBitbucketBaseURLInvalid         = errors.New("bitbucket base URL must be an absolute http or https URL")
+)
+
+const (
+	// DefaultGitlabBaseURL is used if the base URL of a self-hosted GitLab instance is not set.
+	DefaultGitlabBaseURL = "https://gitlab.com"
+	// DefaultBitbucketBaseURL is Bitbucket Cloud, it is used if the base URL of a Bitbucket Server instance is not set.
+	DefaultBitbucketBaseURL = "https://bitbucket.org"
 )
 
 type Settings struct {
@@ -16,6 +35,8 @@ type Settings struct {
 	DefaultReleaseMessage string
 	Slack                 SlackSettings
 	Github                GithubSettings
+	Gitlab                GitlabSettings
+	Bitbucket             BitbucketSettings
 }
 
 type UpdateSettingsInput struct {
@@ -23,6 +44,8 @@ type UpdateSettingsInput struct {
 	DefaultReleaseMsg *string
 	Slack             UpdateSlackSettingsInput
 	Github            UpdateGithubSettingsInput
+	Gitlab            UpdateGitlabSettingsInput
+	Bitbucket         UpdateBitbucketSettingsInput
 }
 
 type SlackToken string
@@ -44,20 +67,150 @@ type UpdateSlackSettingsInput struct {
 type GithubToken string
 type GithubWebhookSecret string
 
+// NewGithubWebhookSecret generates a random secret for a webhook registered on a GitHub repo.
+func NewGithubWebhookSecret() (GithubWebhookSecret, error) {
+	secret, err := cryptox.NewToken()
+	if err != nil {
+		return "", err
+	}
+
+	return GithubWebhookSecret(secret), nil
+}
+
 func (t GithubToken) String() string {
 	return string(t)
 }
 
 type GithubSettings struct {
-	Enabled       bool
+	Enabled bool
+	// Token is a personal access token, it is used only if the GitHub App is not configured.
 	Token         GithubToken
 	WebhookSecret GithubWebhookSecret
+	App           GithubAppSettings
 }
 
 type UpdateGithubSettingsInput struct {
 	Enabled       *bool
 	Token         *GithubToken
 	WebhookSecret *GithubWebhookSecret
+	App           UpdateGithubAppSettingsInput
+}
+
+type GithubAppPrivateKey string
+
+func (k GithubAppPrivateKey) Validate() error {
+	if block, _ := pem.Decode([]byte(k)); block == nil {
+		return errGithubAppPrivateKeyFormat
+	}
+
+	return nil
+}
+
+// GithubAppSettings are credentials of the GitHub App installed in the organization,
+// installation tokens are minted from them so the integration is not tied to a personal account.
+type GithubAppSettings struct {
+	AppID          int64
+	InstallationID int64
+	PrivateKey     GithubAppPrivateKey
+}
+
+type UpdateGithubAppSettingsInput struct {
+	AppID          *int64
+	InstallationID *int64
+	PrivateKey     *GithubAppPrivateKey
+}
+
+// IsConfigured returns true if any of the credentials is set, partially set credentials are invalid.
+func (s GithubAppSettings) IsConfigured() bool {
+	return s.AppID != 0 || s.InstallationID != 0 || s.PrivateKey != ""
+}
+
+func (s *GithubAppSettings) Update(u UpdateGithubAppSettingsInput) {
+	if u.AppID != nil {
+		s.AppID = *u.AppID
+	}
+	if u.InstallationID != nil {
+		s.InstallationID = *u.InstallationID
+	}
+	if u.PrivateKey != nil {
+		s.PrivateKey = *u.PrivateKey
+	}
+}
+
+func (s GithubAppSettings) Validate() error {
+	if s.AppID == 0 {
+		return errGithubAppIDRequired
+	}
+	if s.InstallationID == 0 {
+		return errGithubAppInstallationIDRequired
+	}
+
+	return s.PrivateKey.Validate()
+}
+
+type GitlabToken string
+type GitlabWebhookSecret string
+
+func (t GitlabToken) String() string {
+	return string(t)
+}
+
+type GitlabSettings struct {
+	Enabled bool
+	// BaseURL is the URL of a self-hosted GitLab instance, DefaultGitlabBaseURL is used if it is empty.
+	BaseURL string
+	// Token is a personal, group or project access token with the api scope.
+	Token GitlabToken
+	// WebhookSecret is the secret token of the tag push webhooks configured on GitLab projects.
+	WebhookSecret GitlabWebhookSecret
+}
+
+type UpdateGitlabSettingsInput struct {
+	Enabled       *bool
+	BaseURL       *string
+	Token         *GitlabToken
+	WebhookSecret *GitlabWebhookSecret
+}
+
+// GitlabInstance is the GitLab instance the API calls are made to, along with the token used for them.
+type GitlabInstance struct {
+	BaseURL url.URL
+	Token   GitlabToken
+}
+
+type BitbucketToken string
+type BitbucketWebhookSecret string
+
+func (t BitbucketToken) String() string {
+	return string(t)
+}
+
+type BitbucketSettings struct {
+	Enabled bool
+	// BaseURL is the URL of a Bitbucket Server (Data Center) instance, Bitbucket Cloud is used if it is empty.
+	BaseURL string
+	// Token is a repository, project or workspace access token on Cloud, or an HTTP access token on Server.
+	Token BitbucketToken
+	// WebhookSecret signs the payloads of the webhooks configured on Bitbucket repos.
+	WebhookSecret BitbucketWebhookSecret
+}
+
+type UpdateBitbucketSettingsInput struct {
+	Enabled       *bool
+	BaseURL       *string
+	Token         *BitbucketToken
+	WebhookSecret *BitbucketWebhookSecret
+}
+
+// BitbucketInstance is the Bitbucket Cloud or Server instance the API calls are made to, along with the token used for them.
+type BitbucketInstance struct {
+	BaseURL url.URL
+	Token   BitbucketToken
+}
+
+// IsCloud returns true for Bitbucket Cloud, which has a different API and URL format than Bitbucket Server.
+func (i BitbucketInstance) IsCloud() bool {
+	return i.BaseURL.Host == bitbucketCloudHost
 }
 
 func (s *Settings) Update(u UpdateSettingsInput) error {
@@ -74,6 +227,12 @@ func (s *Settings) Update(u UpdateSettingsInput) error {
 	if err := s.Github.Update(u.Github); err != nil {
 		return err
 	}
+	if err := s.Gitlab.Update(u.Gitlab); err != nil {
+		return err
+	}
+	if err := s.Bitbucket.Update(u.Bitbucket); err != nil {
+		return err
+	}
 
 	return s.Validate()
 }
@@ -95,6 +254,14 @@ func (s *Settings) Validate() error {
 		return err
 	}
 
+	if err := s.Gitlab.Validate(); err != nil {
+		return err
+	}
+
+	if err := s.Bitbucket.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -127,14 +294,140 @@ func (s *GithubSettings) Update(u UpdateGithubSettingsInput) error {
 	if u.WebhookSecret != nil {
 		s.WebhookSecret = *u.WebhookSecret
 	}
+	s.App.Update(u.App)
 
 	return s.Validate()
 }
 
 func (s *GithubSettings) Validate() error {
+	if s.App.IsConfigured() {
+		return s.App.Validate()
+	}
+
 	if s.Enabled && s.Token == "" {
 		return errGithubMissingToken
 	}
 
 	return nil
 }
+
+// UsesApp returns true if installation tokens of the GitHub App are used instead of the personal access token.
+func (s *GithubSettings) UsesApp() bool {
+	return s.App.IsConfigured()
+}
+
+func (s *GitlabSettings) Update(u UpdateGitlabSettingsInput) error {
+	if u.Enabled != nil {
+		s.Enabled = *u.Enabled
+	}
+	if u.BaseURL != nil {
+		s.BaseURL = *u.BaseURL
+	}
+	if u.Token != nil {
+		s.Token = *u.Token
+	}
+	if u.WebhookSecret != nil {
+		s.WebhookSecret = *u.WebhookSecret
+	}
+
+	return s.Validate()
+}
+
+func (s *GitlabSettings) Validate() error {
+	if _, err := s.ParseBaseURL(); err != nil {
+		return err
+	}
+
+	if s.Enabled && s.Token == "" {
+		return errGitlabMissingToken
+	}
+
+	return nil
+}
+
+// ParseBaseURL returns the URL of the GitLab instance, trailing slash is removed so API paths can be appended.
+func (s *GitlabSettings) ParseBaseURL() (url.URL, error) {
+	raw := s.BaseURL
+	if raw == "" {
+		raw = DefaultGitlabBaseURL
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return url.URL{}, errGitlabBaseURLInvalid
+	}
+
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	return *u, nil
+}
+
+// Instance returns the GitLab instance the API calls are made to along with the token.
+func (s *GitlabSettings) Instance() (GitlabInstance, error) {
+	baseURL, err := s.ParseBaseURL()
+	if err != nil {
+		return GitlabInstance{}, err
+	}
+
+	return GitlabInstance{
+		BaseURL: baseURL,
+		Token:   s.Token,
+	}, nil
+}
+
+func (s *BitbucketSettings) Update(u UpdateBitbucketSettingsInput) error {
+	if u.Enabled != nil {
+		s.Enabled = *u.Enabled
+	}
+	if u.BaseURL != nil {
+		s.BaseURL = *u.BaseURL
+	}
+	if u.Token != nil {
+		s.Token = *u.Token
+	}
+	if u.WebhookSecret != nil {
+		s.WebhookSecret = *u.WebhookSecret
+	}
+
+	return s.Validate()
+}
+
+func (s *BitbucketSettings) Validate() error {
+	if _, err := s.ParseBaseURL(); err != nil {
+		return err
+	}
+
+	if s.Enabled && s.Token == "" {
+		return errBitbucketMissingToken
+	}
+
+	return nil
+}
+
+// ParseBaseURL returns the URL of the Bitbucket instance, trailing slash is removed so API paths can be appended.
+func (s *BitbucketSettings) ParseBaseURL() (url.URL, error) {
+	raw := s.BaseURL
+	if raw == "" {
+		raw = DefaultBitbucketBaseURL
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return url.URL{}, errBitbucketBaseURLInvalid
+	}
+
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	return *u, nil
+}
+
+// Instance returns the Bitbucket instance the API calls are made to along with the token.
+func (s *BitbucketSettings) Instance() (BitbucketInstance, error) {
+	baseURL, err := s.ParseBaseURL()
+	if err != nil {
+		return BitbucketInstance{}, err
+	}
+
+	return BitbucketInstance{
+		BaseURL: baseURL,
+		Token:   s.Token,
+	}, nil
+}
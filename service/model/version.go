@@ -0,0 +1,294 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	VersionBumpMajor VersionBump = "major"
+	VersionBumpMinor VersionBump = "minor"
+	VersionBumpPatch VersionBump = "patch"
+)
+
+var (
+	errVersionInvalid     = errors.New("invalid semantic version")
+	errVersionBumpInvalid = errors.New("invalid version bump, must be one of: major, minor, patch")
+
+	validVersionBumps = map[VersionBump]bool{
+		VersionBumpMajor: true,
+		VersionBumpMinor: true,
+		VersionBumpPatch: true,
+	}
+)
+
+type VersionBump string
+
+func (b VersionBump) Validate() error {
+	if _, exists := validVersionBumps[b]; exists {
+		return nil
+	}
+
+	return errVersionBumpInvalid
+}
+
+// Version is a semantic version as described in https://semver.org.
+// Build metadata is kept, but it is ignored when versions are compared.
+type Version struct {
+	Major      uint64
+	Minor      uint64
+	Patch      uint64
+	Prerelease string
+	Build      string
+}
+
+// ParseVersion parses a version without any prefix, e.g. 1.2.3-rc.1+build.5.
+func ParseVersion(s string) (Version, error) {
+	var (
+		v                       Version
+		hasBuild, hasPrerelease bool
+	)
+
+	raw := s
+	s, v.Build, hasBuild = strings.Cut(s, "+")
+	s, v.Prerelease, hasPrerelease = strings.Cut(s, "-")
+	if (hasBuild && v.Build == "") || (hasPrerelease && v.Prerelease == "") {
+		return Version{}, fmt.Errorf("%w: %q", errVersionInvalid, raw)
+	}
+
+	core := strings.Split(s, ".")
+	if len(core) != 3 {
+		return Version{}, fmt.Errorf("%w: %q", errVersionInvalid, raw)
+	}
+
+	for i, dst := range []*uint64{&v.Major, &v.Minor, &v.Patch} {
+		n, err := parseVersionNumber(core[i])
+		if err != nil {
+			return Version{}, err
+		}
+
+		*dst = n
+	}
+
+	if err := validateVersionIdentifiers(v.Prerelease, true); err != nil {
+		return Version{}, err
+	}
+	if err := validateVersionIdentifiers(v.Build, false); err != nil {
+		return Version{}, err
+	}
+
+	return v, nil
+}
+
+// ParseVersionFromTag parses a version from the git tag name, the prefix must match exactly.
+// The second return value is false if the tag does not contain a semantic version.
+func ParseVersionFromTag(tagName, prefix string) (Version, bool) {
+	s, found := strings.CutPrefix(tagName, prefix)
+	if !found {
+		return Version{}, false
+	}
+
+	v, err := ParseVersion(s)
+	if err != nil {
+		return Version{}, false
+	}
+
+	return v, true
+}
+
+func (v Version) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Prerelease != "" {
+		s += "-" + v.Prerelease
+	}
+	if v.Build != "" {
+		s += "+" + v.Build
+	}
+
+	return s
+}
+
+func (v Version) IsPrerelease() bool {
+	return v.Prerelease != ""
+}
+
+// Compare returns -1 if v is lower than o, 1 if v is greater than o and 0 if they have the same precedence.
+func (v Version) Compare(o Version) int {
+	for _, c := range [][2]uint64{{v.Major, o.Major}, {v.Minor, o.Minor}, {v.Patch, o.Patch}} {
+		if c[0] != c[1] {
+			if c[0] < c[1] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	// Version without prerelease has higher precedence than the same version with prerelease.
+	switch {
+	case v.Prerelease == o.Prerelease:
+		return 0
+	case v.Prerelease == "":
+		return 1
+	case o.Prerelease == "":
+		return -1
+	}
+
+	vIdentifiers := strings.Split(v.Prerelease, ".")
+	oIdentifiers := strings.Split(o.Prerelease, ".")
+	for i := 0; i < len(vIdentifiers) && i < len(oIdentifiers); i++ {
+		if c := compareVersionIdentifiers(vIdentifiers[i], oIdentifiers[i]); c != 0 {
+			return c
+		}
+	}
+
+	// Larger set of prerelease identifiers has higher precedence.
+	switch {
+	case len(vIdentifiers) < len(oIdentifiers):
+		return -1
+	case len(vIdentifiers) > len(oIdentifiers):
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (v Version) GreaterThan(o Version) bool {
+	return v.Compare(o) > 0
+}
+
+// Bump returns the next version. Prerelease of the same version is released
+// instead of bumping, e.g. patch bump of 1.3.0-rc.1 is 1.3.0.
+func (v Version) Bump(bump VersionBump) Version {
+	next := Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch}
+
+	switch bump {
+	case VersionBumpMajor:
+		if !v.IsPrerelease() || v.Minor != 0 || v.Patch != 0 {
+			next = Version{Major: v.Major + 1}
+		}
+	case VersionBumpMinor:
+		if !v.IsPrerelease() || v.Patch != 0 {
+			next = Version{Major: v.Major, Minor: v.Minor + 1}
+		}
+	case VersionBumpPatch:
+		if !v.IsPrerelease() {
+			next.Patch++
+		}
+	}
+
+	return next
+}
+
+// SortKey returns a string which orders versions by their precedence when compared byte by byte.
+// It is stored along with the release, so releases can be sorted and filtered by version in the database.
+func (v Version) SortKey() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%020d.%020d.%020d", v.Major, v.Minor, v.Patch)
+
+	// "~" sorts after "-", so a release is placed after all of its prereleases.
+	if v.Prerelease == "" {
+		b.WriteString("~")
+		return b.String()
+	}
+
+	b.WriteString("-")
+	for i, identifier := range strings.Split(v.Prerelease, ".") {
+		// Space sorts before any character allowed in identifiers,
+		// so a shorter set of identifiers is placed first.
+		if i > 0 {
+			b.WriteString(" ")
+		}
+
+		// Numeric identifiers have lower precedence than alphanumeric ones.
+		if n, err := strconv.ParseUint(identifier, 10, 64); err == nil {
+			fmt.Fprintf(&b, "0%020d", n)
+		} else {
+			b.WriteString("1" + identifier)
+		}
+	}
+
+	return b.String()
+}
+
+func (v Version) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+func (v *Version) UnmarshalText(data []byte) error {
+	parsed, err := ParseVersion(string(data))
+	if err != nil {
+		return err
+	}
+
+	*v = parsed
+	return nil
+}
+
+func parseVersionNumber(s string) (uint64, error) {
+	if s == "" || (len(s) > 1 && s[0] == '0') {
+		return 0, fmt.Errorf("%w: invalid number %q", errVersionInvalid, s)
+	}
+
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid number %q", errVersionInvalid, s)
+	}
+
+	return n, nil
+}
+
+// validateVersionIdentifiers validates dot separated prerelease or build identifiers.
+// Leading zeros are not allowed in numeric prerelease identifiers.
+func validateVersionIdentifiers(s string, isPrerelease bool) error {
+	if s == "" {
+		return nil
+	}
+
+	for _, identifier := range strings.Split(s, ".") {
+		if identifier == "" {
+			return fmt.Errorf("%w: empty identifier in %q", errVersionInvalid, s)
+		}
+
+		numeric := true
+		for _, c := range identifier {
+			switch {
+			case c >= '0' && c <= '9':
+			case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '-':
+				numeric = false
+			default:
+				return fmt.Errorf("%w: invalid identifier %q", errVersionInvalid, identifier)
+			}
+		}
+
+		if isPrerelease && numeric && len(identifier) > 1 && identifier[0] == '0' {
+			return fmt.Errorf("%w: leading zero in identifier %q", errVersionInvalid, identifier)
+		}
+	}
+
+	return nil
+}
+
+func compareVersionIdentifiers(a, b string) int {
+	aNum, aErr := strconv.ParseUint(a, 10, 64)
+	bNum, bErr := strconv.ParseUint(b, 10, 64)
+
+	switch {
+	case aErr == nil && bErr == nil:
+		switch {
+		case aNum < bNum:
+			return -1
+		case aNum > bNum:
+			return 1
+		default:
+			return 0
+		}
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
@@ -0,0 +1,232 @@
+package model
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Version
+		wantErr bool
+	}{
+		{
+			name:  "Release version",
+			input: "1.2.3",
+			want:  Version{Major: 1, Minor: 2, Patch: 3},
+		},
+		{
+			name:  "Prerelease with build metadata",
+			input: "1.0.0-rc.1+build.5",
+			want:  Version{Major: 1, Prerelease: "rc.1", Build: "build.5"},
+		},
+		{
+			name:  "Prerelease with hyphens",
+			input: "1.0.0-x-y-z.--",
+			want:  Version{Major: 1, Prerelease: "x-y-z.--"},
+		},
+		{
+			name:    "Missing patch",
+			input:   "1.2",
+			wantErr: true,
+		},
+		{
+			name:    "Leading zero",
+			input:   "01.2.3",
+			wantErr: true,
+		},
+		{
+			name:    "Leading zero in numeric prerelease identifier",
+			input:   "1.2.3-rc.01",
+			wantErr: true,
+		},
+		{
+			name:    "Empty prerelease",
+			input:   "1.2.3-",
+			wantErr: true,
+		},
+		{
+			name:    "Empty prerelease identifier",
+			input:   "1.2.3-rc..1",
+			wantErr: true,
+		},
+		{
+			name:    "Prefix",
+			input:   "v1.2.3",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := ParseVersion(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, v)
+			assert.Equal(t, tt.input, v.String())
+		})
+	}
+}
+
+func TestParseVersionFromTag(t *testing.T) {
+	tests := []struct {
+		name    string
+		tagName string
+		prefix  string
+		want    Version
+		wantOK  bool
+	}{
+		{
+			name:    "Default prefix",
+			tagName: "v1.2.3",
+			prefix:  "v",
+			want:    Version{Major: 1, Minor: 2, Patch: 3},
+			wantOK:  true,
+		},
+		{
+			name:    "Monorepo prefix",
+			tagName: "service-a/v2.0.0-beta",
+			prefix:  "service-a/v",
+			want:    Version{Major: 2, Prerelease: "beta"},
+			wantOK:  true,
+		},
+		{
+			name:    "No prefix",
+			tagName: "1.2.3",
+			prefix:  "",
+			want:    Version{Major: 1, Minor: 2, Patch: 3},
+			wantOK:  true,
+		},
+		{
+			name:    "Different prefix",
+			tagName: "service-b/v1.2.3",
+			prefix:  "service-a/v",
+			wantOK:  false,
+		},
+		{
+			name:    "Not a version",
+			tagName: "latest",
+			prefix:  "v",
+			wantOK:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, ok := ParseVersionFromTag(tt.tagName, tt.prefix)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, v)
+		})
+	}
+}
+
+func TestVersion_Compare(t *testing.T) {
+	// Ordered by precedence, see https://semver.org/#spec-item-11
+	ordered := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.0.1",
+		"1.1.0",
+		"2.0.0",
+		"10.0.0",
+	}
+
+	versions := make([]Version, 0, len(ordered))
+	for _, s := range ordered {
+		v, err := ParseVersion(s)
+		assert.NoError(t, err)
+		versions = append(versions, v)
+	}
+
+	for i := range versions {
+		for j := range versions {
+			want := 0
+			if i < j {
+				want = -1
+			} else if i > j {
+				want = 1
+			}
+
+			assert.Equal(t, want, versions[i].Compare(versions[j]), "comparing %s and %s", versions[i], versions[j])
+		}
+	}
+
+	t.Run("Build metadata is ignored", func(t *testing.T) {
+		assert.Equal(t, 0, Version{Major: 1, Build: "a"}.Compare(Version{Major: 1, Build: "b"}))
+	})
+
+	t.Run("Sort keys follow precedence", func(t *testing.T) {
+		keys := make([]string, 0, len(versions))
+		for _, v := range versions {
+			keys = append(keys, v.SortKey())
+		}
+
+		assert.True(t, sort.StringsAreSorted(keys))
+	})
+}
+
+func TestVersion_Bump(t *testing.T) {
+	tests := []struct {
+		name    string
+		version Version
+		bump    VersionBump
+		want    Version
+	}{
+		{
+			name:    "Major",
+			version: Version{Major: 1, Minor: 2, Patch: 3},
+			bump:    VersionBumpMajor,
+			want:    Version{Major: 2},
+		},
+		{
+			name:    "Minor",
+			version: Version{Major: 1, Minor: 2, Patch: 3},
+			bump:    VersionBumpMinor,
+			want:    Version{Major: 1, Minor: 3},
+		},
+		{
+			name:    "Patch",
+			version: Version{Major: 1, Minor: 2, Patch: 3, Build: "5"},
+			bump:    VersionBumpPatch,
+			want:    Version{Major: 1, Minor: 2, Patch: 4},
+		},
+		{
+			name:    "Major of major prerelease",
+			version: Version{Major: 2, Prerelease: "rc.1"},
+			bump:    VersionBumpMajor,
+			want:    Version{Major: 2},
+		},
+		{
+			name:    "Minor of patch prerelease",
+			version: Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1"},
+			bump:    VersionBumpMinor,
+			want:    Version{Major: 1, Minor: 3},
+		},
+		{
+			name:    "Patch of prerelease",
+			version: Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1"},
+			bump:    VersionBumpPatch,
+			want:    Version{Major: 1, Minor: 2, Patch: 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.version.Bump(tt.bump))
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 
 	"release-manager/pkg/id"
 	svcerrors "release-manager/service/errors"
@@ -11,12 +12,15 @@ import (
 )
 
 type ProjectService struct {
-	authGuard      authGuard
-	settingsGetter settingsGetter
-	userGetter     userGetter
-	emailSender    emailSender
-	githubManager  githubManager
-	repo           projectRepository
+	authGuard        authGuard
+	settingsGetter   settingsGetter
+	userGetter       userGetter
+	emailSender      emailSender
+	githubManager    githubManager
+	gitlabManager    gitlabManager
+	bitbucketManager bitbucketManager
+	gitRepos         gitRepoResolver
+	repo             projectRepository
 }
 
 func NewProjectService(
@@ -25,15 +29,20 @@ func NewProjectService(
 	userGetter userGetter,
 	emailSender emailSender,
 	githubManager githubManager,
+	gitlabManager gitlabManager,
+	bitbucketManager bitbucketManager,
 	repo projectRepository,
 ) *ProjectService {
 	return &ProjectService{
-		authGuard:      guard,
-		settingsGetter: settingsGetter,
-		userGetter:     userGetter,
-		emailSender:    emailSender,
-		githubManager:  githubManager,
-		repo:           repo,
+		authGuard:        guard,
+		settingsGetter:   settingsGetter,
+		userGetter:       userGetter,
+		emailSender:      emailSender,
+		githubManager:    githubManager,
+		gitlabManager:    gitlabManager,
+		bitbucketManager: bitbucketManager,
+		gitRepos:         newGitRepoResolver(settingsGetter, githubManager, gitlabManager, bitbucketManager),
+		repo:             repo,
 	}
 }
 
@@ -87,6 +96,58 @@ func (s *ProjectService) GetProject(ctx context.Context, projectID id.Project, a
 	return p, err
 }
 
+// ListProjectsByGithubRepo is not authorized, it is used by GitHub webhooks to find the secret of the delivery.
+func (s *ProjectService) ListProjectsByGithubRepo(ctx context.Context, repo model.GithubRepo) ([]model.Project, error) {
+	p, err := s.repo.ListProjectsByGithubRepo(ctx, repo)
+	if err != nil {
+		return nil, fmt.Errorf("listing projects by github repo: %w", err)
+	}
+
+	return p, nil
+}
+
+// GetProjectByGithubRepo is not authorized, it is used by GitHub webhooks which are verified by their signature.
+// The tag is routed to the project by its version tag prefix if the repo is shared by several projects.
+func (s *ProjectService) GetProjectByGithubRepo(ctx context.Context, repo model.GithubRepo, tagName string) (model.Project, error) {
+	projects, err := s.repo.ListProjectsByGithubRepo(ctx, repo)
+	if err != nil {
+		return model.Project{}, fmt.Errorf("listing projects by github repo: %w", err)
+	}
+
+	return projectForGitTag(projects, tagName)
+}
+
+// GetProjectByGitlabRepo is not authorized, it is used by GitLab webhooks which are verified by their token.
+func (s *ProjectService) GetProjectByGitlabRepo(ctx context.Context, repo model.GitlabRepo, tagName string) (model.Project, error) {
+	projects, err := s.repo.ListProjectsByGitlabRepo(ctx, repo.ProjectID)
+	if err != nil {
+		return model.Project{}, fmt.Errorf("listing projects by gitlab repo: %w", err)
+	}
+
+	return projectForGitTag(projects, tagName)
+}
+
+// GetProjectByBitbucketRepo is not authorized, it is used by Bitbucket webhooks which are verified by their signature.
+func (s *ProjectService) GetProjectByBitbucketRepo(ctx context.Context, repo model.BitbucketRepo, tagName string) (model.Project, error) {
+	projects, err := s.repo.ListProjectsByBitbucketRepo(ctx, repo.Workspace, repo.RepoSlug)
+	if err != nil {
+		return model.Project{}, fmt.Errorf("listing projects by bitbucket repo: %w", err)
+	}
+
+	return projectForGitTag(projects, tagName)
+}
+
+// IsGitRepoShared reports whether the git repo of the project is linked to other projects as well (monorepo).
+// Tags of a shared repo belong to the projects by their version tag prefixes.
+func (s *ProjectService) IsGitRepoShared(ctx context.Context, p model.Project) (bool, error) {
+	projects, err := s.listProjectsByGitRepo(ctx, p)
+	if err != nil {
+		return false, err
+	}
+
+	return len(projects) > 1, nil
+}
+
 func (s *ProjectService) ListProjects(ctx context.Context, authUserID id.AuthUser) ([]model.Project, error) {
 	u, err := s.userGetter.GetAuthenticated(ctx, authUserID)
 	if err != nil {
@@ -116,11 +177,17 @@ func (s *ProjectService) DeleteProject(ctx context.Context, projectID id.Project
 		return fmt.Errorf("authorizing user role: %w", err)
 	}
 
-	err := s.repo.DeleteProject(ctx, projectID)
+	p, err := s.repo.ReadProject(ctx, projectID)
 	if err != nil {
+		return fmt.Errorf("reading project: %w", err)
+	}
+
+	if err := s.repo.DeleteProject(ctx, projectID); err != nil {
 		return fmt.Errorf("deleting project: %w", err)
 	}
 
+	s.removeGithubWebhook(ctx, p)
+
 	return nil
 }
 
@@ -134,6 +201,12 @@ func (s *ProjectService) UpdateProject(ctx context.Context, input model.UpdatePr
 			return model.Project{}, svcerrors.NewProjectInvalidError().Wrap(err).WithMessage(err.Error())
 		}
 
+		if input.VersionTagPrefix != nil {
+			if err := s.validateVersionTagPrefixOverlap(ctx, p); err != nil {
+				return model.Project{}, err
+			}
+		}
+
 		return p, nil
 	}); err != nil {
 		return fmt.Errorf("updating the project: %w", err)
@@ -142,6 +215,8 @@ func (s *ProjectService) UpdateProject(ctx context.Context, input model.UpdatePr
 	return nil
 }
 
+// SetGithubRepoForProject links the GitHub repo and registers the webhook of the service on it with a new secret.
+// The webhook of the previously linked repo is removed.
 func (s *ProjectService) SetGithubRepoForProject(ctx context.Context, rawRepoURL string, projectID id.Project, authUserID id.AuthUser) error {
 	if err := s.authGuard.AuthorizeProjectRoleEditor(ctx, projectID, authUserID); err != nil {
 		return fmt.Errorf("authorizing project member: %w", err)
@@ -157,13 +232,99 @@ func (s *ProjectService) SetGithubRepoForProject(ctx context.Context, rawRepoURL
 		return fmt.Errorf("reading github repo: %w", err)
 	}
 
+	webhook, err := s.registerGithubWebhook(ctx, tkn, repo)
+	if err != nil {
+		return err
+	}
+
+	var previous model.Project
 	if err = s.repo.UpdateProject(ctx, projectID, func(p model.Project) (model.Project, error) {
+		previous = p
 		p.SetGithubRepo(&repo)
+		p.SetGithubWebhook(&webhook)
+		if err := s.validateVersionTagPrefixOverlap(ctx, p); err != nil {
+			return model.Project{}, err
+		}
 		return p, nil
 	}); err != nil {
+		s.removeGithubWebhook(ctx, model.Project{GithubRepo: &repo, GithubWebhook: &webhook})
 		return fmt.Errorf("updating project with Github repo: %w", err)
 	}
 
+	s.removeGithubWebhook(ctx, previous)
+
+	return nil
+}
+
+// UnlinkGithubRepoFromProject removes the GitHub repo from the project together with the webhook registered on it.
+func (s *ProjectService) UnlinkGithubRepoFromProject(ctx context.Context, projectID id.Project, authUserID id.AuthUser) error {
+	if err := s.authGuard.AuthorizeProjectRoleEditor(ctx, projectID, authUserID); err != nil {
+		return fmt.Errorf("authorizing project member: %w", err)
+	}
+
+	var previous model.Project
+	if err := s.repo.UpdateProject(ctx, projectID, func(p model.Project) (model.Project, error) {
+		if !p.IsGithubRepoSet() {
+			return model.Project{}, svcerrors.NewGithubRepoNotSetForProjectError()
+		}
+
+		previous = p
+		p.UnsetGithubRepo()
+		return p, nil
+	}); err != nil {
+		return fmt.Errorf("unlinking Github repo from project: %w", err)
+	}
+
+	s.removeGithubWebhook(ctx, previous)
+
+	return nil
+}
+
+// RotateGithubWebhookSecret replaces the secret of the webhook registered on the GitHub repo of the project.
+// The webhook is registered if the project does not have one (e.g. it was linked before webhooks were registered
+// by the service) or if it was removed on GitHub.
+func (s *ProjectService) RotateGithubWebhookSecret(ctx context.Context, projectID id.Project, authUserID id.AuthUser) error {
+	if err := s.authGuard.AuthorizeProjectRoleEditor(ctx, projectID, authUserID); err != nil {
+		return fmt.Errorf("authorizing project member: %w", err)
+	}
+
+	tkn, err := s.settingsGetter.GetGithubToken(ctx)
+	if err != nil {
+		return fmt.Errorf("getting Github token: %w", err)
+	}
+
+	p, err := s.repo.ReadProject(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("reading project: %w", err)
+	}
+
+	if !p.IsGithubRepoSet() {
+		return svcerrors.NewGithubRepoNotSetForProjectError()
+	}
+
+	webhook, err := s.rotateGithubWebhook(ctx, tkn, p)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.UpdateProject(ctx, projectID, func(current model.Project) (model.Project, error) {
+		// The repo could be re-linked or unlinked while the webhook was rotated on GitHub
+		if !current.IsGithubRepoLinked(*p.GithubRepo) {
+			return model.Project{}, svcerrors.NewProjectGithubRepoChangedError()
+		}
+
+		current.SetGithubWebhook(&webhook)
+		return current, nil
+	}); err != nil {
+		// A webhook whose secret was rotated is kept if the project still references it
+		registered := p.GithubWebhook == nil || p.GithubWebhook.ID != webhook.ID
+		if registered || svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeProjectGithubRepoChanged) {
+			s.removeGithubWebhook(ctx, model.Project{ID: projectID, GithubRepo: p.GithubRepo, GithubWebhook: &webhook})
+		}
+
+		return fmt.Errorf("updating project with Github webhook: %w", err)
+	}
+
 	return nil
 }
 
@@ -184,6 +345,146 @@ func (s *ProjectService) GetGithubRepoForProject(ctx context.Context, projectID
 	return *p.GithubRepo, nil
 }
 
+// SetGitlabRepoForProject links the GitLab project, the GitHub repo linked before is replaced and its webhook removed.
+// Webhooks are configured on GitLab manually with the secret token of the GitLab settings.
+func (s *ProjectService) SetGitlabRepoForProject(ctx context.Context, rawRepoURL string, projectID id.Project, authUserID id.AuthUser) error {
+	if err := s.authGuard.AuthorizeProjectRoleEditor(ctx, projectID, authUserID); err != nil {
+		return fmt.Errorf("authorizing project member: %w", err)
+	}
+
+	inst, err := s.settingsGetter.GetGitlabInstance(ctx)
+	if err != nil {
+		return fmt.Errorf("getting gitlab instance: %w", err)
+	}
+
+	repo, err := s.gitlabManager.ReadRepo(ctx, inst, rawRepoURL)
+	if err != nil {
+		return fmt.Errorf("reading gitlab repo: %w", err)
+	}
+
+	var previous model.Project
+	if err = s.repo.UpdateProject(ctx, projectID, func(p model.Project) (model.Project, error) {
+		previous = p
+		p.SetGitlabRepo(&repo)
+		if err := s.validateVersionTagPrefixOverlap(ctx, p); err != nil {
+			return model.Project{}, err
+		}
+		return p, nil
+	}); err != nil {
+		return fmt.Errorf("updating project with Gitlab repo: %w", err)
+	}
+
+	s.removeGithubWebhook(ctx, previous)
+
+	return nil
+}
+
+func (s *ProjectService) UnlinkGitlabRepoFromProject(ctx context.Context, projectID id.Project, authUserID id.AuthUser) error {
+	if err := s.authGuard.AuthorizeProjectRoleEditor(ctx, projectID, authUserID); err != nil {
+		return fmt.Errorf("authorizing project member: %w", err)
+	}
+
+	if err := s.repo.UpdateProject(ctx, projectID, func(p model.Project) (model.Project, error) {
+		if !p.IsGitlabRepoSet() {
+			return model.Project{}, svcerrors.NewGitlabRepoNotSetForProjectError()
+		}
+
+		p.UnsetGitlabRepo()
+		return p, nil
+	}); err != nil {
+		return fmt.Errorf("unlinking Gitlab repo from project: %w", err)
+	}
+
+	return nil
+}
+
+func (s *ProjectService) GetGitlabRepoForProject(ctx context.Context, projectID id.Project, authUserID id.AuthUser) (model.GitlabRepo, error) {
+	if err := s.authGuard.AuthorizeProjectRoleEditor(ctx, projectID, authUserID); err != nil {
+		return model.GitlabRepo{}, fmt.Errorf("authorizing project member: %w", err)
+	}
+
+	p, err := s.repo.ReadProject(ctx, projectID)
+	if err != nil {
+		return model.GitlabRepo{}, fmt.Errorf("reading project: %w", err)
+	}
+
+	if !p.IsGitlabRepoSet() {
+		return model.GitlabRepo{}, svcerrors.NewGitlabRepoNotSetForProjectError()
+	}
+
+	return *p.GitlabRepo, nil
+}
+
+// SetBitbucketRepoForProject links the Bitbucket repo, the GitHub or GitLab repo linked before is replaced.
+// Webhooks are configured on Bitbucket manually with the webhook secret of the Bitbucket settings.
+func (s *ProjectService) SetBitbucketRepoForProject(ctx context.Context, rawRepoURL string, projectID id.Project, authUserID id.AuthUser) error {
+	if err := s.authGuard.AuthorizeProjectRoleEditor(ctx, projectID, authUserID); err != nil {
+		return fmt.Errorf("authorizing project member: %w", err)
+	}
+
+	inst, err := s.settingsGetter.GetBitbucketInstance(ctx)
+	if err != nil {
+		return fmt.Errorf("getting bitbucket instance: %w", err)
+	}
+
+	repo, err := s.bitbucketManager.ReadRepo(ctx, inst, rawRepoURL)
+	if err != nil {
+		return fmt.Errorf("reading bitbucket repo: %w", err)
+	}
+
+	var previous model.Project
+	if err = s.repo.UpdateProject(ctx, projectID, func(p model.Project) (model.Project, error) {
+		previous = p
+		p.SetBitbucketRepo(&repo)
+		if err := s.validateVersionTagPrefixOverlap(ctx, p); err != nil {
+			return model.Project{}, err
+		}
+		return p, nil
+	}); err != nil {
+		return fmt.Errorf("updating project with Bitbucket repo: %w", err)
+	}
+
+	s.removeGithubWebhook(ctx, previous)
+
+	return nil
+}
+
+func (s *ProjectService) UnlinkBitbucketRepoFromProject(ctx context.Context, projectID id.Project, authUserID id.AuthUser) error {
+	if err := s.authGuard.AuthorizeProjectRoleEditor(ctx, projectID, authUserID); err != nil {
+		return fmt.Errorf("authorizing project member: %w", err)
+	}
+
+	if err := s.repo.UpdateProject(ctx, projectID, func(p model.Project) (model.Project, error) {
+		if !p.IsBitbucketRepoSet() {
+			return model.Project{}, svcerrors.NewBitbucketRepoNotSetForProjectError()
+		}
+
+		p.UnsetBitbucketRepo()
+		return p, nil
+	}); err != nil {
+		return fmt.Errorf("unlinking Bitbucket repo from project: %w", err)
+	}
+
+	return nil
+}
+
+func (s *ProjectService) GetBitbucketRepoForProject(ctx context.Context, projectID id.Project, authUserID id.AuthUser) (model.BitbucketRepo, error) {
+	if err := s.authGuard.AuthorizeProjectRoleEditor(ctx, projectID, authUserID); err != nil {
+		return model.BitbucketRepo{}, fmt.Errorf("authorizing project member: %w", err)
+	}
+
+	p, err := s.repo.ReadProject(ctx, projectID)
+	if err != nil {
+		return model.BitbucketRepo{}, fmt.Errorf("reading project: %w", err)
+	}
+
+	if !p.IsBitbucketRepoSet() {
+		return model.BitbucketRepo{}, svcerrors.NewBitbucketRepoNotSetForProjectError()
+	}
+
+	return *p.BitbucketRepo, nil
+}
+
 func (s *ProjectService) CreateEnvironment(ctx context.Context, input model.CreateEnvironmentInput, authUserID id.AuthUser) (model.Environment, error) {
 	if err := s.authGuard.AuthorizeUserRoleAdmin(ctx, authUserID); err != nil {
 		return model.Environment{}, fmt.Errorf("authorizing user role: %w", err)
@@ -260,6 +561,37 @@ func (s *ProjectService) ListEnvironments(ctx context.Context, projectID id.Proj
 	return envs, nil
 }
 
+// ListEnvironmentApprovers returns project members who can approve deployments to the environment.
+func (s *ProjectService) ListEnvironmentApprovers(
+	ctx context.Context,
+	projectID id.Project,
+	envID id.Environment,
+	authUserID id.AuthUser,
+) ([]model.ProjectMember, error) {
+	if err := s.authGuard.AuthorizeProjectRoleViewer(ctx, projectID, authUserID); err != nil {
+		return nil, fmt.Errorf("authorizing project member: %w", err)
+	}
+
+	env, err := s.repo.ReadEnvironment(ctx, projectID, envID)
+	if err != nil {
+		return nil, fmt.Errorf("reading environment: %w", err)
+	}
+
+	members, err := s.repo.ListMembersForProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("listing members for project: %w", err)
+	}
+
+	approvers := make([]model.ProjectMember, 0, len(members))
+	for _, m := range members {
+		if env.ApprovalPolicy.CanApprove(m) {
+			approvers = append(approvers, m)
+		}
+	}
+
+	return approvers, nil
+}
+
 func (s *ProjectService) DeleteEnvironment(ctx context.Context, projectID id.Project, envID id.Environment, authUserID id.AuthUser) error {
 	if err := s.authGuard.AuthorizeUserRoleAdmin(ctx, authUserID); err != nil {
 		return fmt.Errorf("authorizing user role: %w", err)
@@ -273,14 +605,19 @@ func (s *ProjectService) DeleteEnvironment(ctx context.Context, projectID id.Pro
 	return nil
 }
 
-func (s *ProjectService) ListGithubRepoTags(ctx context.Context, projectID id.Project, authUserID id.AuthUser) ([]model.GitTag, error) {
+// ListGitRepoTags lists all tags of the project repo, tags already used by a release of the project are marked.
+func (s *ProjectService) ListGitRepoTags(
+	ctx context.Context,
+	projectID id.Project,
+	params model.ListGitTagsParams,
+	authUserID id.AuthUser,
+) ([]model.RepoGitTag, error) {
 	if err := s.authGuard.AuthorizeProjectRoleViewer(ctx, projectID, authUserID); err != nil {
 		return nil, fmt.Errorf("authorizing project member: %w", err)
 	}
 
-	tkn, err := s.settingsGetter.GetGithubToken(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("getting Github token: %w", err)
+	if err := params.Validate(); err != nil {
+		return nil, svcerrors.NewGitTagListInvalidError().Wrap(err).WithMessage(err.Error())
 	}
 
 	p, err := s.repo.ReadProject(ctx, projectID)
@@ -288,16 +625,31 @@ func (s *ProjectService) ListGithubRepoTags(ctx context.Context, projectID id.Pr
 		return nil, fmt.Errorf("reading project: %w", err)
 	}
 
-	if !p.IsGithubRepoSet() {
-		return nil, svcerrors.NewGithubRepoNotSetForProjectError()
+	repo, err := s.gitRepos.resolve(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := repo.ReadTags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("reading tags for git repo: %w", err)
 	}
 
-	t, err := s.githubManager.ReadTagsForRepo(ctx, tkn, *p.GithubRepo)
+	used, err := s.repo.ListReleaseGitTagNamesForProject(ctx, projectID)
 	if err != nil {
-		return nil, fmt.Errorf("reading tags for github repo: %w", err)
+		return nil, fmt.Errorf("listing git tags used by releases: %w", err)
+	}
+
+	shared, err := s.IsGitRepoShared(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	// Tags of other projects sharing the repo are not listed
+	if shared {
+		params.ProjectTagPrefix = p.VersionTagPrefix
 	}
 
-	return t, nil
+	return model.NewRepoGitTagList(t, params, p.VersionTagPrefix, used), nil
 }
 
 func (s *ProjectService) Invite(ctx context.Context, input model.CreateProjectInvitationInput, authUserID id.AuthUser) (model.ProjectInvitation, error) {
@@ -462,6 +814,19 @@ func (s *ProjectService) ListMembersForProject(ctx context.Context, projectID id
 	return m, nil
 }
 
+func (s *ProjectService) GetMember(ctx context.Context, projectID id.Project, userID id.User, authUserID id.AuthUser) (model.ProjectMember, error) {
+	if err := s.authGuard.AuthorizeProjectRoleViewer(ctx, projectID, authUserID); err != nil {
+		return model.ProjectMember{}, fmt.Errorf("authorizing project member: %w", err)
+	}
+
+	m, err := s.repo.ReadMember(ctx, projectID, userID)
+	if err != nil {
+		return model.ProjectMember{}, fmt.Errorf("reading member: %w", err)
+	}
+
+	return m, nil
+}
+
 func (s *ProjectService) ListMembersForUser(ctx context.Context, authUserID id.AuthUser) ([]model.ProjectMember, error) {
 	if err := s.authGuard.AuthorizeUserRoleUser(ctx, authUserID); err != nil {
 		return nil, fmt.Errorf("authorizing user role: %w", err)
@@ -511,6 +876,59 @@ func (s *ProjectService) UpdateMemberRole(
 	return nil
 }
 
+func (s *ProjectService) registerGithubWebhook(ctx context.Context, tkn model.GithubToken, repo model.GithubRepo) (model.GithubRepoWebhook, error) {
+	secret, err := model.NewGithubWebhookSecret()
+	if err != nil {
+		return model.GithubRepoWebhook{}, fmt.Errorf("generating github webhook secret: %w", err)
+	}
+
+	hookID, err := s.githubManager.CreateRepoWebhook(ctx, tkn, repo, secret)
+	if err != nil {
+		return model.GithubRepoWebhook{}, fmt.Errorf("registering github webhook: %w", err)
+	}
+
+	return model.GithubRepoWebhook{ID: hookID, Secret: secret}, nil
+}
+
+func (s *ProjectService) rotateGithubWebhook(ctx context.Context, tkn model.GithubToken, p model.Project) (model.GithubRepoWebhook, error) {
+	if p.GithubWebhook == nil {
+		return s.registerGithubWebhook(ctx, tkn, *p.GithubRepo)
+	}
+
+	secret, err := model.NewGithubWebhookSecret()
+	if err != nil {
+		return model.GithubRepoWebhook{}, fmt.Errorf("generating github webhook secret: %w", err)
+	}
+
+	if err := s.githubManager.UpdateRepoWebhookSecret(ctx, tkn, *p.GithubRepo, p.GithubWebhook.ID, secret); err != nil {
+		if svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGithubWebhookNotFound) {
+			return s.registerGithubWebhook(ctx, tkn, *p.GithubRepo)
+		}
+
+		return model.GithubRepoWebhook{}, fmt.Errorf("updating github webhook secret: %w", err)
+	}
+
+	return model.GithubRepoWebhook{ID: p.GithubWebhook.ID, Secret: secret}, nil
+}
+
+// removeGithubWebhook is best effort, the project no longer references the webhook,
+// so deliveries of a webhook which could not be removed fail on the signature or on the missing project.
+func (s *ProjectService) removeGithubWebhook(ctx context.Context, p model.Project) {
+	if !p.IsGithubRepoSet() || p.GithubWebhook == nil {
+		return
+	}
+
+	tkn, err := s.settingsGetter.GetGithubToken(ctx)
+	if err != nil {
+		slog.Error("removing github webhook: getting github token", "project_id", p.ID, "error", err)
+		return
+	}
+
+	if err := s.githubManager.DeleteRepoWebhook(ctx, tkn, *p.GithubRepo, p.GithubWebhook.ID); err != nil {
+		slog.Error("removing github webhook", "project_id", p.ID, "hook_id", p.GithubWebhook.ID, "error", err)
+	}
+}
+
 func (s *ProjectService) getDefaultReleaseNotificationConfig(ctx context.Context) (model.ReleaseNotificationConfig, error) {
 	msg, err := s.settingsGetter.GetDefaultReleaseMessage(ctx)
 	if err != nil {
@@ -552,3 +970,53 @@ func (s *ProjectService) memberExists(ctx context.Context, projectID id.Project,
 
 	return true, nil
 }
+
+// validateVersionTagPrefixOverlap checks the version tag prefix of the project against other projects sharing its git repo,
+// so every tag of the repo belongs to a single project. The repository checks it again while the repo is locked,
+// so projects linking the same repo concurrently cannot both pass.
+func (s *ProjectService) validateVersionTagPrefixOverlap(ctx context.Context, p model.Project) error {
+	projects, err := s.listProjectsByGitRepo(ctx, p)
+	if err != nil {
+		return err
+	}
+
+	if err := p.ValidateVersionTagPrefixOverlap(projects); err != nil {
+		return svcerrors.NewProjectVersionTagPrefixOverlapError().Wrap(err).WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// listProjectsByGitRepo lists the projects linked to the git repo of the project, including the project itself.
+func (s *ProjectService) listProjectsByGitRepo(ctx context.Context, p model.Project) ([]model.Project, error) {
+	var (
+		projects []model.Project
+		err      error
+	)
+	switch {
+	case p.IsGithubRepoSet():
+		projects, err = s.repo.ListProjectsByGithubRepo(ctx, *p.GithubRepo)
+	case p.IsGitlabRepoSet():
+		projects, err = s.repo.ListProjectsByGitlabRepo(ctx, p.GitlabRepo.ProjectID)
+	case p.IsBitbucketRepoSet():
+		projects, err = s.repo.ListProjectsByBitbucketRepo(ctx, p.BitbucketRepo.Workspace, p.BitbucketRepo.RepoSlug)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listing projects by git repo: %w", err)
+	}
+
+	return projects, nil
+}
+
+// projectForGitTag returns the project of the repo which the tag belongs to, see model.ProjectForGitTag.
+func projectForGitTag(projects []model.Project, tagName string) (model.Project, error) {
+	p, ok := model.ProjectForGitTag(projects, tagName)
+	if !ok {
+		return model.Project{}, svcerrors.NewProjectNotFoundError().
+			WithMessage(fmt.Sprintf("no project linked to the repo has a version tag prefix of tag %q", tagName))
+	}
+
+	return p, nil
+}
@@ -5,7 +5,9 @@ import (
 	"errors"
 	"testing"
 
+	bitbucketmock "release-manager/bitbucket/mock"
 	githubmock "release-manager/github/mock"
+	gitlabmock "release-manager/gitlab/mock"
 	"release-manager/pkg/id"
 	"release-manager/pkg/pointer"
 	repo "release-manager/repository/mock"
@@ -14,6 +16,7 @@ import (
 	svc "release-manager/service/mock"
 	"release-manager/service/model"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -88,7 +91,7 @@ func TestProjectService_CreateProject(t *testing.T) {
 			userSvc := new(svc.UserService)
 			settingsSvc := new(svc.SettingsService)
 			authSvc := new(svc.AuthorizationService)
-			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, projectRepo)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, new(gitlabmock.Client), new(bitbucketmock.Client), projectRepo)
 
 			tc.mockSetup(authSvc, settingsSvc, userSvc, github, projectRepo)
 
@@ -152,7 +155,7 @@ func TestProjectService_ListProjects(t *testing.T) {
 			userSvc := new(svc.UserService)
 			settingsSvc := new(svc.SettingsService)
 			authSvc := new(svc.AuthorizationService)
-			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, projectRepo)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, new(gitlabmock.Client), new(bitbucketmock.Client), projectRepo)
 
 			tc.mockSetup(userSvc, projectRepo)
 
@@ -203,7 +206,7 @@ func TestProjectService_GetProject(t *testing.T) {
 			userSvc := new(svc.UserService)
 			settingsSvc := new(svc.SettingsService)
 			authSvc := new(svc.AuthorizationService)
-			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, projectRepo)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, new(gitlabmock.Client), new(bitbucketmock.Client), projectRepo)
 
 			tc.mockSetup(authSvc, projectRepo)
 
@@ -221,25 +224,125 @@ func TestProjectService_GetProject(t *testing.T) {
 	}
 }
 
+func TestProjectService_GetProjectByGithubRepo(t *testing.T) {
+	api := model.Project{Name: "api", VersionTagPrefix: "api/v"}
+	web := model.Project{Name: "web", VersionTagPrefix: "web/v"}
+
+	testCases := []struct {
+		name        string
+		tagName     string
+		mockSetup   func(*repo.ProjectRepository)
+		wantProject model.Project
+		wantErr     bool
+	}{
+		{
+			name:    "Existing project",
+			tagName: "release-1",
+			mockSetup: func(projectRepo *repo.ProjectRepository) {
+				projectRepo.On("ListProjectsByGithubRepo", mock.Anything, mock.Anything).Return([]model.Project{api}, nil)
+			},
+			wantProject: api,
+			wantErr:     false,
+		},
+		{
+			name:    "Tag of shared repo is routed by prefix",
+			tagName: "web/v1.2.3",
+			mockSetup: func(projectRepo *repo.ProjectRepository) {
+				projectRepo.On("ListProjectsByGithubRepo", mock.Anything, mock.Anything).Return([]model.Project{api, web}, nil)
+			},
+			wantProject: web,
+			wantErr:     false,
+		},
+		{
+			name:    "Tag of shared repo without matching prefix",
+			tagName: "v1.2.3",
+			mockSetup: func(projectRepo *repo.ProjectRepository) {
+				projectRepo.On("ListProjectsByGithubRepo", mock.Anything, mock.Anything).Return([]model.Project{api, web}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Non-existing project",
+			tagName: "v1.2.3",
+			mockSetup: func(projectRepo *repo.ProjectRepository) {
+				projectRepo.On("ListProjectsByGithubRepo", mock.Anything, mock.Anything).Return([]model.Project{}, nil)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			projectRepo := new(repo.ProjectRepository)
+			github := new(githubmock.Client)
+			email := new(resendmock.Client)
+			userSvc := new(svc.UserService)
+			settingsSvc := new(svc.SettingsService)
+			authSvc := new(svc.AuthorizationService)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, new(gitlabmock.Client), new(bitbucketmock.Client), projectRepo)
+
+			tc.mockSetup(projectRepo)
+
+			p, err := service.GetProjectByGithubRepo(context.Background(), model.GithubRepo{OwnerSlug: "owner", RepoSlug: "repo"}, tc.tagName)
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.wantProject, p)
+			}
+
+			projectRepo.AssertExpectations(t)
+		})
+	}
+}
+
 func TestProjectService_DeleteProject(t *testing.T) {
+	repoWithWebhook := model.Project{
+		GithubRepo:    &model.GithubRepo{OwnerSlug: "owner", RepoSlug: "repo"},
+		GithubWebhook: &model.GithubRepoWebhook{ID: 1, Secret: "secret"},
+	}
+
 	testCases := []struct {
 		name      string
-		mockSetup func(auth *svc.AuthorizationService, projectRepo *repo.ProjectRepository)
+		mockSetup func(*svc.AuthorizationService, *svc.SettingsService, *githubmock.Client, *repo.ProjectRepository)
 		wantErr   bool
 	}{
 		{
 			name: "Existing project",
-			mockSetup: func(auth *svc.AuthorizationService, projectRepo *repo.ProjectRepository) {
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, projectRepo *repo.ProjectRepository) {
 				auth.On("AuthorizeUserRoleAdmin", mock.Anything, mock.Anything).Return(nil)
+				projectRepo.On("ReadProject", mock.Anything, mock.Anything).Return(model.Project{}, nil)
 				projectRepo.On("DeleteProject", mock.Anything, mock.Anything).Return(nil)
 			},
 			wantErr: false,
 		},
+		{
+			name: "Webhook of the project is removed",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, projectRepo *repo.ProjectRepository) {
+				auth.On("AuthorizeUserRoleAdmin", mock.Anything, mock.Anything).Return(nil)
+				projectRepo.On("ReadProject", mock.Anything, mock.Anything).Return(repoWithWebhook, nil)
+				projectRepo.On("DeleteProject", mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				githubClient.On("DeleteRepoWebhook", mock.Anything, mock.Anything, *repoWithWebhook.GithubRepo, int64(1)).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Project is deleted when webhook cannot be removed",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, projectRepo *repo.ProjectRepository) {
+				auth.On("AuthorizeUserRoleAdmin", mock.Anything, mock.Anything).Return(nil)
+				projectRepo.On("ReadProject", mock.Anything, mock.Anything).Return(repoWithWebhook, nil)
+				projectRepo.On("DeleteProject", mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken(""), svcerrors.NewGithubIntegrationNotEnabledError())
+			},
+			wantErr: false,
+		},
 		{
 			name: "Non-existing project",
-			mockSetup: func(auth *svc.AuthorizationService, projectRepo *repo.ProjectRepository) {
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, projectRepo *repo.ProjectRepository) {
 				auth.On("AuthorizeUserRoleAdmin", mock.Anything, mock.Anything).Return(nil)
-				projectRepo.On("DeleteProject", mock.Anything, mock.Anything).Return(svcerrors.NewProjectNotFoundError())
+				projectRepo.On("ReadProject", mock.Anything, mock.Anything).Return(model.Project{}, svcerrors.NewProjectNotFoundError())
 			},
 			wantErr: true,
 		},
@@ -253,9 +356,9 @@ func TestProjectService_DeleteProject(t *testing.T) {
 			userSvc := new(svc.UserService)
 			settingsSvc := new(svc.SettingsService)
 			authSvc := new(svc.AuthorizationService)
-			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, projectRepo)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, new(gitlabmock.Client), new(bitbucketmock.Client), projectRepo)
 
-			tc.mockSetup(authSvc, projectRepo)
+			tc.mockSetup(authSvc, settingsSvc, github, projectRepo)
 
 			err := service.DeleteProject(context.Background(), id.NewProject(), id.AuthUser{})
 
@@ -267,11 +370,19 @@ func TestProjectService_DeleteProject(t *testing.T) {
 
 			projectRepo.AssertExpectations(t)
 			authSvc.AssertExpectations(t)
+			settingsSvc.AssertExpectations(t)
+			github.AssertExpectations(t)
 		})
 	}
 }
 
 func TestProjectService_UpdateProject(t *testing.T) {
+	sharingRepo := model.Project{
+		Name:                      "web",
+		ReleaseNotificationConfig: model.ReleaseNotificationConfig{Message: "message"},
+		GitlabRepo:                &model.GitlabRepo{ProjectID: 42},
+	}
+
 	testCases := []struct {
 		name      string
 		update    model.UpdateProjectInput
@@ -306,6 +417,35 @@ func TestProjectService_UpdateProject(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name:   "Version tag prefix not overlapping with project sharing the repo",
+			update: model.UpdateProjectInput{VersionTagPrefix: pointer.StringPtr("web/v")},
+			mockSetup: func(auth *svc.AuthorizationService, projectRepo *repo.ProjectRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				projectRepo.On("ListProjectsByGitlabRepo", mock.Anything, int64(42)).Return([]model.Project{{ID: id.NewProject(), VersionTagPrefix: "api/v"}}, nil)
+				projectRepo.On("UpdateProject", mock.Anything, mock.Anything, mock.Anything).
+					Run(func(args mock.Arguments) {
+						updateFn := args.Get(2).(func(model.Project) (model.Project, error))
+						p, err := updateFn(sharingRepo)
+						assert.NoError(t, err)
+						assert.Equal(t, "web/v", p.VersionTagPrefix)
+					}).
+					Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:   "Version tag prefix overlaps with project sharing the repo",
+			update: model.UpdateProjectInput{VersionTagPrefix: pointer.StringPtr("v")},
+			mockSetup: func(auth *svc.AuthorizationService, projectRepo *repo.ProjectRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				projectRepo.On("ListProjectsByGitlabRepo", mock.Anything, int64(42)).Return([]model.Project{{ID: id.NewProject(), VersionTagPrefix: "vendor/v"}}, nil)
+				projectRepo.On("UpdateProject", mock.Anything, mock.Anything, mock.Anything).
+					Run(runProjectUpdateWithPrefixOverlap(t, sharingRepo)).
+					Return(svcerrors.NewProjectVersionTagPrefixOverlapError())
+			},
+			wantErr: true,
+		},
 		{
 			name:   "Non-existing-project",
 			update: model.UpdateProjectInput{},
@@ -325,7 +465,7 @@ func TestProjectService_UpdateProject(t *testing.T) {
 			userSvc := new(svc.UserService)
 			settingsSvc := new(svc.SettingsService)
 			authSvc := new(svc.AuthorizationService)
-			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, projectRepo)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, new(gitlabmock.Client), new(bitbucketmock.Client), projectRepo)
 
 			tc.mockSetup(authSvc, projectRepo)
 
@@ -400,7 +540,7 @@ func TestProjectService_CreateEnvironment(t *testing.T) {
 			userSvc := new(svc.UserService)
 			settingsSvc := new(svc.SettingsService)
 			authSvc := new(svc.AuthorizationService)
-			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, projectRepo)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, new(gitlabmock.Client), new(bitbucketmock.Client), projectRepo)
 
 			tc.mockSetup(authSvc, projectRepo)
 
@@ -450,7 +590,7 @@ func TestProjectService_GetEnvironment(t *testing.T) {
 			userSvc := new(svc.UserService)
 			settingsSvc := new(svc.SettingsService)
 			authSvc := new(svc.AuthorizationService)
-			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, projectRepo)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, new(gitlabmock.Client), new(bitbucketmock.Client), projectRepo)
 
 			tc.mockSetup(authSvc, projectRepo)
 
@@ -468,6 +608,83 @@ func TestProjectService_GetEnvironment(t *testing.T) {
 	}
 }
 
+func TestProjectService_ListEnvironmentApprovers(t *testing.T) {
+	approverID := id.User(uuid.New())
+	members := []model.ProjectMember{
+		{User: model.User{ID: approverID}, ProjectRole: model.ProjectRoleViewer},
+		{User: model.User{ID: id.User(uuid.New())}, ProjectRole: model.ProjectRoleOwner},
+		{User: model.User{ID: id.User(uuid.New())}, ProjectRole: model.ProjectRoleEditor},
+	}
+
+	testCases := []struct {
+		name          string
+		mockSetup     func(*svc.AuthorizationService, *repo.ProjectRepository)
+		wantApprovers int
+		wantErr       bool
+	}{
+		{
+			name: "Success",
+			mockSetup: func(auth *svc.AuthorizationService, projectRepo *repo.ProjectRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				projectRepo.On("ReadEnvironment", mock.Anything, mock.Anything, mock.Anything).Return(model.Environment{
+					ApprovalPolicy: model.ApprovalPolicy{
+						RequiredApprovals: 1,
+						ApproverUserIDs:   []id.User{approverID},
+						ApproverRoles:     []model.ProjectRole{model.ProjectRoleOwner},
+					},
+				}, nil)
+				projectRepo.On("ListMembersForProject", mock.Anything, mock.Anything).Return(members, nil)
+			},
+			wantApprovers: 2,
+			wantErr:       false,
+		},
+		{
+			name: "Success - no approval policy",
+			mockSetup: func(auth *svc.AuthorizationService, projectRepo *repo.ProjectRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				projectRepo.On("ReadEnvironment", mock.Anything, mock.Anything, mock.Anything).Return(model.Environment{}, nil)
+				projectRepo.On("ListMembersForProject", mock.Anything, mock.Anything).Return(members, nil)
+			},
+			wantApprovers: 0,
+			wantErr:       false,
+		},
+		{
+			name: "env not found",
+			mockSetup: func(auth *svc.AuthorizationService, projectRepo *repo.ProjectRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				projectRepo.On("ReadEnvironment", mock.Anything, mock.Anything, mock.Anything).Return(model.Environment{}, svcerrors.NewEnvironmentNotFoundError())
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			projectRepo := new(repo.ProjectRepository)
+			github := new(githubmock.Client)
+			email := new(resendmock.Client)
+			userSvc := new(svc.UserService)
+			settingsSvc := new(svc.SettingsService)
+			authSvc := new(svc.AuthorizationService)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, new(gitlabmock.Client), new(bitbucketmock.Client), projectRepo)
+
+			tc.mockSetup(authSvc, projectRepo)
+
+			approvers, err := service.ListEnvironmentApprovers(context.Background(), id.NewProject(), id.NewEnvironment(), id.AuthUser{})
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, approvers, tc.wantApprovers)
+			}
+
+			projectRepo.AssertExpectations(t)
+			authSvc.AssertExpectations(t)
+		})
+	}
+}
+
 func TestProjectService_UpdateEnvironment(t *testing.T) {
 	testCases := []struct {
 		name      string
@@ -506,7 +723,7 @@ func TestProjectService_UpdateEnvironment(t *testing.T) {
 			userSvc := new(svc.UserService)
 			settingsSvc := new(svc.SettingsService)
 			authSvc := new(svc.AuthorizationService)
-			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, projectRepo)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, new(gitlabmock.Client), new(bitbucketmock.Client), projectRepo)
 
 			tc.mockSetup(authSvc, projectRepo)
 
@@ -559,7 +776,7 @@ func TestProjectService_GetEnvironments(t *testing.T) {
 			userSvc := new(svc.UserService)
 			settingsSvc := new(svc.SettingsService)
 			authSvc := new(svc.AuthorizationService)
-			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, projectRepo)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, new(gitlabmock.Client), new(bitbucketmock.Client), projectRepo)
 
 			tc.mockSetup(authSvc, projectRepo)
 
@@ -609,7 +826,7 @@ func TestProjectService_DeleteEnvironment(t *testing.T) {
 			userSvc := new(svc.UserService)
 			settingsSvc := new(svc.SettingsService)
 			authSvc := new(svc.AuthorizationService)
-			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, projectRepo)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, new(gitlabmock.Client), new(bitbucketmock.Client), projectRepo)
 
 			tc.mockSetup(authSvc, projectRepo)
 
@@ -724,7 +941,7 @@ func TestProjectService_Invite(t *testing.T) {
 			userSvc := new(svc.UserService)
 			settingsSvc := new(svc.SettingsService)
 			authSvc := new(svc.AuthorizationService)
-			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, projectRepo)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, new(gitlabmock.Client), new(bitbucketmock.Client), projectRepo)
 
 			tc.mockSetup(authSvc, userSvc, email, projectRepo)
 
@@ -790,7 +1007,7 @@ func TestProjectService_GetInvitations(t *testing.T) {
 			userSvc := new(svc.UserService)
 			settingsSvc := new(svc.SettingsService)
 			authSvc := new(svc.AuthorizationService)
-			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, projectRepo)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, new(gitlabmock.Client), new(bitbucketmock.Client), projectRepo)
 
 			tc.mockSetup(authSvc, projectRepo)
 
@@ -840,7 +1057,7 @@ func TestProjectService_CancelInvitation(t *testing.T) {
 			userSvc := new(svc.UserService)
 			settingsSvc := new(svc.SettingsService)
 			authSvc := new(svc.AuthorizationService)
-			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, projectRepo)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, new(gitlabmock.Client), new(bitbucketmock.Client), projectRepo)
 
 			tc.mockSetup(authSvc, projectRepo)
 
@@ -897,7 +1114,7 @@ func TestProjectService_AcceptInvitation(t *testing.T) {
 			userSvc := new(svc.UserService)
 			settingsSvc := new(svc.SettingsService)
 			authSvc := new(svc.AuthorizationService)
-			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, projectRepo)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, new(gitlabmock.Client), new(bitbucketmock.Client), projectRepo)
 
 			tc.mockSetup(userSvc, projectRepo)
 
@@ -952,7 +1169,7 @@ func TestProjectService_RejectInvitation(t *testing.T) {
 			userSvc := new(svc.UserService)
 			settingsSvc := new(svc.SettingsService)
 			authSvc := new(svc.AuthorizationService)
-			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, projectRepo)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, new(gitlabmock.Client), new(bitbucketmock.Client), projectRepo)
 
 			tc.mockSetup(projectRepo)
 
@@ -1019,7 +1236,7 @@ func TestProjectService_ListMembersForProject(t *testing.T) {
 			userSvc := new(svc.UserService)
 			settingsSvc := new(svc.SettingsService)
 			authSvc := new(svc.AuthorizationService)
-			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, projectRepo)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, new(gitlabmock.Client), new(bitbucketmock.Client), projectRepo)
 
 			tc.mockSetup(authSvc, projectRepo)
 
@@ -1061,7 +1278,7 @@ func TestProjectService_ListMembersForUser(t *testing.T) {
 			userSvc := new(svc.UserService)
 			settingsSvc := new(svc.SettingsService)
 			authSvc := new(svc.AuthorizationService)
-			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, projectRepo)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, new(gitlabmock.Client), new(bitbucketmock.Client), projectRepo)
 
 			tc.mockSetup(authSvc, projectRepo)
 
@@ -1111,7 +1328,7 @@ func TestProjectService_DeleteMember(t *testing.T) {
 			userSvc := new(svc.UserService)
 			settingsSvc := new(svc.SettingsService)
 			authSvc := new(svc.AuthorizationService)
-			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, projectRepo)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, new(gitlabmock.Client), new(bitbucketmock.Client), projectRepo)
 
 			tc.mockSetup(authSvc, projectRepo)
 
@@ -1163,7 +1380,7 @@ func TestProjectService_UpdateMemberRole(t *testing.T) {
 			userSvc := new(svc.UserService)
 			settingsSvc := new(svc.SettingsService)
 			authSvc := new(svc.AuthorizationService)
-			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, projectRepo)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, new(gitlabmock.Client), new(bitbucketmock.Client), projectRepo)
 
 			tc.mockSetup(authSvc, projectRepo)
 
@@ -1181,21 +1398,64 @@ func TestProjectService_UpdateMemberRole(t *testing.T) {
 	}
 }
 
+// runProjectUpdate calls the update function with the project, so the test can check the result.
+func runProjectUpdate(p model.Project, result *model.Project) func(args mock.Arguments) {
+	return func(args mock.Arguments) {
+		updateFn := args.Get(2).(func(model.Project) (model.Project, error))
+		*result, _ = updateFn(p)
+	}
+}
+
+// runProjectUpdateWithPrefixOverlap checks that the update of the project is rejected for an overlapping version tag prefix.
+func runProjectUpdateWithPrefixOverlap(t *testing.T, p model.Project) func(args mock.Arguments) {
+	return func(args mock.Arguments) {
+		updateFn := args.Get(2).(func(model.Project) (model.Project, error))
+		_, err := updateFn(p)
+		assert.True(t, svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeProjectVersionTagPrefixOverlap))
+	}
+}
+
 func TestProjectService_SetGithubRepoForProject(t *testing.T) {
+	newRepo := model.GithubRepo{OwnerSlug: "test", RepoSlug: "test"}
+	previousRepo := model.GithubRepo{OwnerSlug: "previous", RepoSlug: "previous"}
+
 	testCases := []struct {
-		name      string
-		mockSetup func(*svc.AuthorizationService, *svc.SettingsService, *githubmock.Client, *repo.ProjectRepository)
-		wantErr   bool
+		name        string
+		project     model.Project
+		mockSetup   func(*svc.AuthorizationService, *svc.SettingsService, *githubmock.Client, *repo.ProjectRepository)
+		wantWebhook *model.GithubRepoWebhook
+		wantErr     bool
 	}{
 		{
-			name: "Success",
+			name:    "Success",
+			project: model.Project{},
 			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, projectRepo *repo.ProjectRepository) {
 				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
-				githubClient.On("ReadRepo", mock.Anything, mock.Anything, mock.Anything).Return(model.GithubRepo{}, nil)
-				projectRepo.On("UpdateProject", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				githubClient.On("ReadRepo", mock.Anything, mock.Anything, mock.Anything).Return(newRepo, nil)
+				githubClient.On("CreateRepoWebhook", mock.Anything, mock.Anything, newRepo, mock.Anything).Return(int64(2), nil)
+				projectRepo.On("ListProjectsByGithubRepo", mock.Anything, newRepo).Return([]model.Project{}, nil)
 			},
-			wantErr: false,
+			wantWebhook: &model.GithubRepoWebhook{ID: 2},
+			wantErr:     false,
+		},
+		{
+			name: "Webhook of the previous repo is removed",
+			project: model.Project{
+				GithubRepo:       &previousRepo,
+				GithubWebhook:    &model.GithubRepoWebhook{ID: 1, Secret: "previous"},
+				VersionTagPrefix: "web/v",
+			},
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, projectRepo *repo.ProjectRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				githubClient.On("ReadRepo", mock.Anything, mock.Anything, mock.Anything).Return(newRepo, nil)
+				githubClient.On("CreateRepoWebhook", mock.Anything, mock.Anything, newRepo, mock.Anything).Return(int64(2), nil)
+				githubClient.On("DeleteRepoWebhook", mock.Anything, mock.Anything, previousRepo, int64(1)).Return(nil)
+				projectRepo.On("ListProjectsByGithubRepo", mock.Anything, newRepo).Return([]model.Project{{ID: id.NewProject(), VersionTagPrefix: "api/v"}}, nil)
+			},
+			wantWebhook: &model.GithubRepoWebhook{ID: 2},
+			wantErr:     false,
 		},
 		{
 			name: "Github integration not enabled",
@@ -1214,6 +1474,43 @@ func TestProjectService_SetGithubRepoForProject(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Webhook cannot be registered",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, projectRepo *repo.ProjectRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				githubClient.On("ReadRepo", mock.Anything, mock.Anything, mock.Anything).Return(newRepo, nil)
+				githubClient.On("CreateRepoWebhook", mock.Anything, mock.Anything, newRepo, mock.Anything).Return(int64(0), svcerrors.NewGithubClientForbiddenError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "Registered webhook is removed when project update fails",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, projectRepo *repo.ProjectRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				githubClient.On("ReadRepo", mock.Anything, mock.Anything, mock.Anything).Return(newRepo, nil)
+				githubClient.On("CreateRepoWebhook", mock.Anything, mock.Anything, newRepo, mock.Anything).Return(int64(2), nil)
+				projectRepo.On("UpdateProject", mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewProjectGithubRepoAlreadyUsedError())
+				githubClient.On("DeleteRepoWebhook", mock.Anything, mock.Anything, newRepo, int64(2)).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Version tag prefix overlaps with project sharing the repo",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, projectRepo *repo.ProjectRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				githubClient.On("ReadRepo", mock.Anything, mock.Anything, mock.Anything).Return(newRepo, nil)
+				githubClient.On("CreateRepoWebhook", mock.Anything, mock.Anything, newRepo, mock.Anything).Return(int64(2), nil)
+				projectRepo.On("ListProjectsByGithubRepo", mock.Anything, newRepo).Return([]model.Project{{ID: id.NewProject(), VersionTagPrefix: "vendor/v"}}, nil)
+				projectRepo.On("UpdateProject", mock.Anything, mock.Anything, mock.Anything).
+					Run(runProjectUpdateWithPrefixOverlap(t, model.Project{VersionTagPrefix: "v"})).
+					Return(svcerrors.NewProjectVersionTagPrefixOverlapError())
+				githubClient.On("DeleteRepoWebhook", mock.Anything, mock.Anything, newRepo, int64(2)).Return(nil)
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1224,16 +1521,228 @@ func TestProjectService_SetGithubRepoForProject(t *testing.T) {
 			userSvc := new(svc.UserService)
 			settingsSvc := new(svc.SettingsService)
 			authSvc := new(svc.AuthorizationService)
-			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, projectRepo)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, new(gitlabmock.Client), new(bitbucketmock.Client), projectRepo)
 
 			tc.mockSetup(authSvc, settingsSvc, github, projectRepo)
 
+			var updated model.Project
+			if tc.wantWebhook != nil {
+				projectRepo.On("UpdateProject", mock.Anything, mock.Anything, mock.Anything).
+					Run(runProjectUpdate(tc.project, &updated)).
+					Return(nil)
+			}
+
 			err := service.SetGithubRepoForProject(context.Background(), "https://github.com/test/test", id.NewProject(), id.AuthUser{})
 
 			if tc.wantErr {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, newRepo, *updated.GithubRepo)
+				assert.Equal(t, tc.wantWebhook.ID, updated.GithubWebhook.ID)
+				assert.NotEmpty(t, updated.GithubWebhook.Secret)
+			}
+
+			authSvc.AssertExpectations(t)
+			projectRepo.AssertExpectations(t)
+			settingsSvc.AssertExpectations(t)
+			github.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectService_UnlinkGithubRepoFromProject(t *testing.T) {
+	linkedRepo := model.GithubRepo{OwnerSlug: "owner", RepoSlug: "repo"}
+
+	testCases := []struct {
+		name      string
+		project   model.Project
+		mockSetup func(*svc.SettingsService, *githubmock.Client)
+		updateErr error
+		wantErr   bool
+	}{
+		{
+			name: "Webhook is removed",
+			project: model.Project{
+				GithubRepo:    &linkedRepo,
+				GithubWebhook: &model.GithubRepoWebhook{ID: 1, Secret: "secret"},
+			},
+			mockSetup: func(settingsSvc *svc.SettingsService, githubClient *githubmock.Client) {
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				githubClient.On("DeleteRepoWebhook", mock.Anything, mock.Anything, linkedRepo, int64(1)).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:      "Manually configured webhook",
+			project:   model.Project{GithubRepo: &linkedRepo},
+			mockSetup: func(settingsSvc *svc.SettingsService, githubClient *githubmock.Client) {},
+			wantErr:   false,
+		},
+		{
+			name:      "Github repo not set",
+			project:   model.Project{},
+			mockSetup: func(settingsSvc *svc.SettingsService, githubClient *githubmock.Client) {},
+			updateErr: svcerrors.NewGithubRepoNotSetForProjectError(),
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			projectRepo := new(repo.ProjectRepository)
+			github := new(githubmock.Client)
+			email := new(resendmock.Client)
+			userSvc := new(svc.UserService)
+			settingsSvc := new(svc.SettingsService)
+			authSvc := new(svc.AuthorizationService)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, new(gitlabmock.Client), new(bitbucketmock.Client), projectRepo)
+
+			authSvc.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			var updated model.Project
+			projectRepo.On("UpdateProject", mock.Anything, mock.Anything, mock.Anything).
+				Run(runProjectUpdate(tc.project, &updated)).
+				Return(tc.updateErr)
+			tc.mockSetup(settingsSvc, github)
+
+			err := service.UnlinkGithubRepoFromProject(context.Background(), id.NewProject(), id.AuthUser{})
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Nil(t, updated.GithubRepo)
+				assert.Nil(t, updated.GithubWebhook)
+			}
+
+			authSvc.AssertExpectations(t)
+			projectRepo.AssertExpectations(t)
+			settingsSvc.AssertExpectations(t)
+			github.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectService_RotateGithubWebhookSecret(t *testing.T) {
+	linkedRepo := model.GithubRepo{OwnerSlug: "owner", RepoSlug: "repo"}
+	withWebhook := model.Project{
+		GithubRepo:    &linkedRepo,
+		GithubWebhook: &model.GithubRepoWebhook{ID: 1, Secret: "old"},
+	}
+
+	testCases := []struct {
+		name    string
+		project model.Project
+		// current is the project read by the update, it is the same as project if nil
+		current       *model.Project
+		updateErr     error
+		mockSetup     func(*githubmock.Client)
+		wantWebhookID int64
+		wantErr       bool
+	}{
+		{
+			name:    "Secret is rotated",
+			project: withWebhook,
+			mockSetup: func(githubClient *githubmock.Client) {
+				githubClient.On("UpdateRepoWebhookSecret", mock.Anything, mock.Anything, linkedRepo, int64(1), mock.Anything).Return(nil)
+			},
+			wantWebhookID: 1,
+			wantErr:       false,
+		},
+		{
+			name:    "Webhook is registered for project without webhook",
+			project: model.Project{GithubRepo: &linkedRepo},
+			mockSetup: func(githubClient *githubmock.Client) {
+				githubClient.On("CreateRepoWebhook", mock.Anything, mock.Anything, linkedRepo, mock.Anything).Return(int64(2), nil)
+			},
+			wantWebhookID: 2,
+			wantErr:       false,
+		},
+		{
+			name:    "Webhook removed on GitHub is registered again",
+			project: withWebhook,
+			mockSetup: func(githubClient *githubmock.Client) {
+				githubClient.On("UpdateRepoWebhookSecret", mock.Anything, mock.Anything, linkedRepo, int64(1), mock.Anything).Return(svcerrors.NewGithubWebhookNotFoundError())
+				githubClient.On("CreateRepoWebhook", mock.Anything, mock.Anything, linkedRepo, mock.Anything).Return(int64(2), nil)
+			},
+			wantWebhookID: 2,
+			wantErr:       false,
+		},
+		{
+			name:      "Github repo not set",
+			project:   model.Project{},
+			mockSetup: func(githubClient *githubmock.Client) {},
+			wantErr:   true,
+		},
+		{
+			name:      "Registered webhook is removed when repo is re-linked meanwhile",
+			project:   model.Project{GithubRepo: &linkedRepo},
+			current:   &model.Project{GithubRepo: &model.GithubRepo{OwnerSlug: "owner", RepoSlug: "other"}},
+			updateErr: svcerrors.NewProjectGithubRepoChangedError(),
+			mockSetup: func(githubClient *githubmock.Client) {
+				githubClient.On("CreateRepoWebhook", mock.Anything, mock.Anything, linkedRepo, mock.Anything).Return(int64(2), nil)
+				githubClient.On("DeleteRepoWebhook", mock.Anything, mock.Anything, linkedRepo, int64(2)).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Rotated webhook is removed when repo is unlinked meanwhile",
+			project:   withWebhook,
+			current:   &model.Project{},
+			updateErr: svcerrors.NewProjectGithubRepoChangedError(),
+			mockSetup: func(githubClient *githubmock.Client) {
+				githubClient.On("UpdateRepoWebhookSecret", mock.Anything, mock.Anything, linkedRepo, int64(1), mock.Anything).Return(nil)
+				githubClient.On("DeleteRepoWebhook", mock.Anything, mock.Anything, linkedRepo, int64(1)).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Rotated webhook is kept when project cannot be updated",
+			project:   withWebhook,
+			updateErr: errors.New("db error"),
+			mockSetup: func(githubClient *githubmock.Client) {
+				githubClient.On("UpdateRepoWebhookSecret", mock.Anything, mock.Anything, linkedRepo, int64(1), mock.Anything).Return(nil)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			projectRepo := new(repo.ProjectRepository)
+			github := new(githubmock.Client)
+			email := new(resendmock.Client)
+			userSvc := new(svc.UserService)
+			settingsSvc := new(svc.SettingsService)
+			authSvc := new(svc.AuthorizationService)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, new(gitlabmock.Client), new(bitbucketmock.Client), projectRepo)
+
+			authSvc.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+			projectRepo.On("ReadProject", mock.Anything, mock.Anything).Return(tc.project, nil)
+			tc.mockSetup(github)
+
+			current := tc.project
+			if tc.current != nil {
+				current = *tc.current
+			}
+
+			var updated model.Project
+			if tc.project.IsGithubRepoSet() {
+				projectRepo.On("UpdateProject", mock.Anything, mock.Anything, mock.Anything).
+					Run(runProjectUpdate(current, &updated)).
+					Return(tc.updateErr)
+			}
+
+			err := service.RotateGithubWebhookSecret(context.Background(), id.NewProject(), id.AuthUser{})
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.wantWebhookID, updated.GithubWebhook.ID)
+				assert.NotEmpty(t, updated.GithubWebhook.Secret)
+				assert.NotEqual(t, model.GithubWebhookSecret("old"), updated.GithubWebhook.Secret)
 			}
 
 			authSvc.AssertExpectations(t)
@@ -1289,7 +1798,7 @@ func TestProjectService_GetGithubRepoForProject(t *testing.T) {
 			userSvc := new(svc.UserService)
 			settingsSvc := new(svc.SettingsService)
 			authSvc := new(svc.AuthorizationService)
-			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, projectRepo)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, new(gitlabmock.Client), new(bitbucketmock.Client), projectRepo)
 
 			tc.mockSetup(authSvc, projectRepo)
 
@@ -1307,49 +1816,108 @@ func TestProjectService_GetGithubRepoForProject(t *testing.T) {
 	}
 }
 
-func TestProjectService_ListGithubRepoTags(t *testing.T) {
+func TestProjectService_ListGitRepoTags(t *testing.T) {
+	githubProject := model.Project{
+		GithubRepo: &model.GithubRepo{
+			OwnerSlug: "test",
+			RepoSlug:  "test",
+		},
+	}
+
+	apiProject := model.Project{
+		GithubRepo:       githubProject.GithubRepo,
+		VersionTagPrefix: "api/v",
+	}
+
 	testCases := []struct {
-		name      string
-		mockSetup func(*svc.AuthorizationService, *svc.SettingsService, *githubmock.Client, *repo.ProjectRepository)
-		wantErr   bool
+		name         string
+		params       model.ListGitTagsParams
+		mockSetup    func(*svc.AuthorizationService, *svc.SettingsService, *githubmock.Client, *gitlabmock.Client, *repo.ProjectRepository)
+		wantTagNames []string
+		wantErr      bool
 	}{
 		{
 			name: "Success",
-			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, projectRepo *repo.ProjectRepository) {
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, gitlabClient *gitlabmock.Client, projectRepo *repo.ProjectRepository) {
 				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				projectRepo.On("ReadProject", mock.Anything, mock.Anything, mock.Anything).Return(githubProject, nil)
 				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				githubClient.On("ReadTagsForRepo", mock.Anything, mock.Anything, mock.Anything).Return([]model.RepoGitTag{}, nil)
+				projectRepo.On("ListReleaseGitTagNamesForProject", mock.Anything, mock.Anything).Return([]string{}, nil)
+				projectRepo.On("ListProjectsByGithubRepo", mock.Anything, mock.Anything).Return([]model.Project{githubProject}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success - Gitlab repo",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, gitlabClient *gitlabmock.Client, projectRepo *repo.ProjectRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				projectRepo.On("ReadProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
-					GithubRepo: &model.GithubRepo{
-						OwnerSlug: "test",
-						RepoSlug:  "test",
-					},
+					GitlabRepo: &model.GitlabRepo{ProjectID: 42, Path: "group/test"},
 				}, nil)
-				githubClient.On("ReadTagsForRepo", mock.Anything, mock.Anything, mock.Anything).Return([]model.GitTag{}, nil)
+				settingsSvc.On("GetGitlabInstance", mock.Anything).Return(model.GitlabInstance{Token: "token"}, nil)
+				gitlabClient.On("ReadTagsForRepo", mock.Anything, mock.Anything, mock.Anything).Return([]model.RepoGitTag{}, nil)
+				projectRepo.On("ListReleaseGitTagNamesForProject", mock.Anything, mock.Anything).Return([]string{}, nil)
+				projectRepo.On("ListProjectsByGitlabRepo", mock.Anything, int64(42)).Return([]model.Project{{}}, nil)
 			},
 			wantErr: false,
 		},
+		{
+			name: "Shared repo lists only tags of the project",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, gitlabClient *gitlabmock.Client, projectRepo *repo.ProjectRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				projectRepo.On("ReadProject", mock.Anything, mock.Anything, mock.Anything).Return(apiProject, nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				githubClient.On("ReadTagsForRepo", mock.Anything, mock.Anything, mock.Anything).Return([]model.RepoGitTag{
+					{Tag: model.GitTag{Name: "api/v1.0.0"}},
+					{Tag: model.GitTag{Name: "web/v3.0.0"}},
+				}, nil)
+				projectRepo.On("ListReleaseGitTagNamesForProject", mock.Anything, mock.Anything).Return([]string{}, nil)
+				projectRepo.On("ListProjectsByGithubRepo", mock.Anything, mock.Anything).Return([]model.Project{apiProject, {VersionTagPrefix: "web/v"}}, nil)
+			},
+			wantTagNames: []string{"api/v1.0.0"},
+			wantErr:      false,
+		},
+		{
+			name:   "Invalid sort by",
+			params: model.ListGitTagsParams{SortBy: "name"},
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, gitlabClient *gitlabmock.Client, projectRepo *repo.ProjectRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: true,
+		},
 		{
 			name: "Github integration not enabled",
-			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, projectRepo *repo.ProjectRepository) {
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, gitlabClient *gitlabmock.Client, projectRepo *repo.ProjectRepository) {
 				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				projectRepo.On("ReadProject", mock.Anything, mock.Anything, mock.Anything).Return(githubProject, nil)
 				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken(""), svcerrors.NewGithubIntegrationNotEnabledError())
 			},
 			wantErr: true,
 		},
+		{
+			name: "Gitlab integration not enabled",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, gitlabClient *gitlabmock.Client, projectRepo *repo.ProjectRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				projectRepo.On("ReadProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
+					GitlabRepo: &model.GitlabRepo{ProjectID: 42, Path: "group/test"},
+				}, nil)
+				settingsSvc.On("GetGitlabInstance", mock.Anything).Return(model.GitlabInstance{}, svcerrors.NewGitlabIntegrationNotEnabledError())
+			},
+			wantErr: true,
+		},
 		{
 			name: "Project not found",
-			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, projectRepo *repo.ProjectRepository) {
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, gitlabClient *gitlabmock.Client, projectRepo *repo.ProjectRepository) {
 				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
 				projectRepo.On("ReadProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{}, svcerrors.NewProjectNotFoundError())
 			},
 			wantErr: true,
 		},
 		{
-			name: "Github repo not set",
-			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, projectRepo *repo.ProjectRepository) {
+			name: "Git repo not set",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, gitlabClient *gitlabmock.Client, projectRepo *repo.ProjectRepository) {
 				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
 				projectRepo.On("ReadProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{}, nil)
 			},
 			wantErr: true,
@@ -1360,26 +1928,274 @@ func TestProjectService_ListGithubRepoTags(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			projectRepo := new(repo.ProjectRepository)
 			github := new(githubmock.Client)
+			gitlab := new(gitlabmock.Client)
 			email := new(resendmock.Client)
 			userSvc := new(svc.UserService)
 			settingsSvc := new(svc.SettingsService)
 			authSvc := new(svc.AuthorizationService)
-			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, projectRepo)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, gitlab, new(bitbucketmock.Client), projectRepo)
 
-			tc.mockSetup(authSvc, settingsSvc, github, projectRepo)
+			tc.mockSetup(authSvc, settingsSvc, github, gitlab, projectRepo)
+
+			tags, err := service.ListGitRepoTags(context.Background(), id.NewProject(), tc.params, id.AuthUser{})
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tc.wantTagNames != nil {
+				names := make([]string, 0, len(tags))
+				for _, tag := range tags {
+					names = append(names, tag.Tag.Name)
+				}
+				assert.Equal(t, tc.wantTagNames, names)
+			}
+
+			authSvc.AssertExpectations(t)
+			projectRepo.AssertExpectations(t)
+			settingsSvc.AssertExpectations(t)
+			github.AssertExpectations(t)
+			gitlab.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectService_SetGitlabRepoForProject(t *testing.T) {
+	newRepo := model.GitlabRepo{ProjectID: 42, Path: "group/test"}
+	previousRepo := model.GithubRepo{OwnerSlug: "previous", RepoSlug: "previous"}
+
+	testCases := []struct {
+		name      string
+		project   model.Project
+		mockSetup func(*svc.AuthorizationService, *svc.SettingsService, *githubmock.Client, *gitlabmock.Client, *repo.ProjectRepository)
+		wantErr   bool
+	}{
+		{
+			name:    "Success",
+			project: model.Project{},
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, gitlabClient *gitlabmock.Client, projectRepo *repo.ProjectRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGitlabInstance", mock.Anything).Return(model.GitlabInstance{Token: "token"}, nil)
+				gitlabClient.On("ReadRepo", mock.Anything, mock.Anything, mock.Anything).Return(newRepo, nil)
+				projectRepo.On("ListProjectsByGitlabRepo", mock.Anything, newRepo.ProjectID).Return([]model.Project{}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Webhook of the previous Github repo is removed",
+			project: model.Project{
+				GithubRepo:    &previousRepo,
+				GithubWebhook: &model.GithubRepoWebhook{ID: 1, Secret: "previous"},
+			},
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, gitlabClient *gitlabmock.Client, projectRepo *repo.ProjectRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGitlabInstance", mock.Anything).Return(model.GitlabInstance{Token: "token"}, nil)
+				gitlabClient.On("ReadRepo", mock.Anything, mock.Anything, mock.Anything).Return(newRepo, nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				githubClient.On("DeleteRepoWebhook", mock.Anything, mock.Anything, previousRepo, int64(1)).Return(nil)
+				projectRepo.On("ListProjectsByGitlabRepo", mock.Anything, newRepo.ProjectID).Return([]model.Project{}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Gitlab integration not enabled",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, gitlabClient *gitlabmock.Client, projectRepo *repo.ProjectRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGitlabInstance", mock.Anything).Return(model.GitlabInstance{}, svcerrors.NewGitlabIntegrationNotEnabledError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "Gitlab repo not found",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, gitlabClient *gitlabmock.Client, projectRepo *repo.ProjectRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGitlabInstance", mock.Anything).Return(model.GitlabInstance{Token: "token"}, nil)
+				gitlabClient.On("ReadRepo", mock.Anything, mock.Anything, mock.Anything).Return(model.GitlabRepo{}, svcerrors.NewGitlabRepoNotFoundError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "Gitlab repo already used",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, gitlabClient *gitlabmock.Client, projectRepo *repo.ProjectRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGitlabInstance", mock.Anything).Return(model.GitlabInstance{Token: "token"}, nil)
+				gitlabClient.On("ReadRepo", mock.Anything, mock.Anything, mock.Anything).Return(newRepo, nil)
+				projectRepo.On("UpdateProject", mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewProjectGitlabRepoAlreadyUsedError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "Version tag prefix overlaps with project sharing the repo",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, gitlabClient *gitlabmock.Client, projectRepo *repo.ProjectRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGitlabInstance", mock.Anything).Return(model.GitlabInstance{Token: "token"}, nil)
+				gitlabClient.On("ReadRepo", mock.Anything, mock.Anything, mock.Anything).Return(newRepo, nil)
+				projectRepo.On("ListProjectsByGitlabRepo", mock.Anything, newRepo.ProjectID).Return([]model.Project{{ID: id.NewProject(), VersionTagPrefix: ""}}, nil)
+				projectRepo.On("UpdateProject", mock.Anything, mock.Anything, mock.Anything).
+					Run(runProjectUpdateWithPrefixOverlap(t, model.Project{VersionTagPrefix: "api/v"})).
+					Return(svcerrors.NewProjectVersionTagPrefixOverlapError())
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			projectRepo := new(repo.ProjectRepository)
+			github := new(githubmock.Client)
+			gitlab := new(gitlabmock.Client)
+			email := new(resendmock.Client)
+			userSvc := new(svc.UserService)
+			settingsSvc := new(svc.SettingsService)
+			authSvc := new(svc.AuthorizationService)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, gitlab, new(bitbucketmock.Client), projectRepo)
+
+			tc.mockSetup(authSvc, settingsSvc, github, gitlab, projectRepo)
+
+			var updated model.Project
+			if !tc.wantErr {
+				projectRepo.On("UpdateProject", mock.Anything, mock.Anything, mock.Anything).
+					Run(runProjectUpdate(tc.project, &updated)).
+					Return(nil)
+			}
+
+			err := service.SetGitlabRepoForProject(context.Background(), "https://gitlab.com/group/test", id.NewProject(), id.AuthUser{})
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, newRepo, *updated.GitlabRepo)
+				assert.Nil(t, updated.GithubRepo)
+				assert.Nil(t, updated.GithubWebhook)
+			}
+
+			authSvc.AssertExpectations(t)
+			projectRepo.AssertExpectations(t)
+			settingsSvc.AssertExpectations(t)
+			github.AssertExpectations(t)
+			gitlab.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectService_SetBitbucketRepoForProject(t *testing.T) {
+	newRepo := model.BitbucketRepo{Workspace: "workspace", RepoSlug: "test"}
+	previousRepo := model.GithubRepo{OwnerSlug: "previous", RepoSlug: "previous"}
+
+	testCases := []struct {
+		name      string
+		project   model.Project
+		mockSetup func(*svc.AuthorizationService, *svc.SettingsService, *githubmock.Client, *bitbucketmock.Client, *repo.ProjectRepository)
+		wantErr   bool
+	}{
+		{
+			name:    "Success",
+			project: model.Project{GitlabRepo: &model.GitlabRepo{ProjectID: 42, Path: "group/test"}},
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, bitbucketClient *bitbucketmock.Client, projectRepo *repo.ProjectRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetBitbucketInstance", mock.Anything).Return(model.BitbucketInstance{Token: "token"}, nil)
+				bitbucketClient.On("ReadRepo", mock.Anything, mock.Anything, mock.Anything).Return(newRepo, nil)
+				projectRepo.On("ListProjectsByBitbucketRepo", mock.Anything, newRepo.Workspace, newRepo.RepoSlug).Return([]model.Project{}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Webhook of the previous Github repo is removed",
+			project: model.Project{
+				GithubRepo:    &previousRepo,
+				GithubWebhook: &model.GithubRepoWebhook{ID: 1, Secret: "previous"},
+			},
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, bitbucketClient *bitbucketmock.Client, projectRepo *repo.ProjectRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetBitbucketInstance", mock.Anything).Return(model.BitbucketInstance{Token: "token"}, nil)
+				bitbucketClient.On("ReadRepo", mock.Anything, mock.Anything, mock.Anything).Return(newRepo, nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				githubClient.On("DeleteRepoWebhook", mock.Anything, mock.Anything, previousRepo, int64(1)).Return(nil)
+				projectRepo.On("ListProjectsByBitbucketRepo", mock.Anything, newRepo.Workspace, newRepo.RepoSlug).Return([]model.Project{}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Bitbucket integration not enabled",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, bitbucketClient *bitbucketmock.Client, projectRepo *repo.ProjectRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetBitbucketInstance", mock.Anything).Return(model.BitbucketInstance{}, svcerrors.NewBitbucketIntegrationNotEnabledError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "Bitbucket repo not found",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, bitbucketClient *bitbucketmock.Client, projectRepo *repo.ProjectRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetBitbucketInstance", mock.Anything).Return(model.BitbucketInstance{Token: "token"}, nil)
+				bitbucketClient.On("ReadRepo", mock.Anything, mock.Anything, mock.Anything).Return(model.BitbucketRepo{}, svcerrors.NewBitbucketRepoNotFoundError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "Bitbucket repo already used",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, bitbucketClient *bitbucketmock.Client, projectRepo *repo.ProjectRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetBitbucketInstance", mock.Anything).Return(model.BitbucketInstance{Token: "token"}, nil)
+				bitbucketClient.On("ReadRepo", mock.Anything, mock.Anything, mock.Anything).Return(newRepo, nil)
+				projectRepo.On("UpdateProject", mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewProjectBitbucketRepoAlreadyUsedError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "Version tag prefix overlaps with project sharing the repo",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, githubClient *githubmock.Client, bitbucketClient *bitbucketmock.Client, projectRepo *repo.ProjectRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetBitbucketInstance", mock.Anything).Return(model.BitbucketInstance{Token: "token"}, nil)
+				bitbucketClient.On("ReadRepo", mock.Anything, mock.Anything, mock.Anything).Return(newRepo, nil)
+				projectRepo.On("ListProjectsByBitbucketRepo", mock.Anything, newRepo.Workspace, newRepo.RepoSlug).Return([]model.Project{{ID: id.NewProject(), VersionTagPrefix: "v"}}, nil)
+				projectRepo.On("UpdateProject", mock.Anything, mock.Anything, mock.Anything).
+					Run(runProjectUpdateWithPrefixOverlap(t, model.Project{VersionTagPrefix: "v"})).
+					Return(svcerrors.NewProjectVersionTagPrefixOverlapError())
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			projectRepo := new(repo.ProjectRepository)
+			github := new(githubmock.Client)
+			bitbucket := new(bitbucketmock.Client)
+			email := new(resendmock.Client)
+			userSvc := new(svc.UserService)
+			settingsSvc := new(svc.SettingsService)
+			authSvc := new(svc.AuthorizationService)
+			service := NewProjectService(authSvc, settingsSvc, userSvc, email, github, new(gitlabmock.Client), bitbucket, projectRepo)
+
+			tc.mockSetup(authSvc, settingsSvc, github, bitbucket, projectRepo)
+
+			var updated model.Project
+			if !tc.wantErr {
+				projectRepo.On("UpdateProject", mock.Anything, mock.Anything, mock.Anything).
+					Run(runProjectUpdate(tc.project, &updated)).
+					Return(nil)
+			}
 
-			_, err := service.ListGithubRepoTags(context.Background(), id.NewProject(), id.AuthUser{})
+			err := service.SetBitbucketRepoForProject(context.Background(), "https://bitbucket.org/workspace/test", id.NewProject(), id.AuthUser{})
 
 			if tc.wantErr {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, newRepo, *updated.BitbucketRepo)
+				assert.Nil(t, updated.GithubRepo)
+				assert.Nil(t, updated.GithubWebhook)
+				assert.Nil(t, updated.GitlabRepo)
 			}
 
 			authSvc.AssertExpectations(t)
 			projectRepo.AssertExpectations(t)
 			settingsSvc.AssertExpectations(t)
 			github.AssertExpectations(t)
+			bitbucket.AssertExpectations(t)
 		})
 	}
 }
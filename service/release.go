@@ -8,6 +8,8 @@ import (
 	"release-manager/pkg/id"
 	svcerrors "release-manager/service/errors"
 	"release-manager/service/model"
+
+	"github.com/google/uuid"
 )
 
 type ReleaseService struct {
@@ -15,8 +17,14 @@ type ReleaseService struct {
 	projectGetter     projectGetter
 	settingsGetter    settingsGetter
 	environmentGetter environmentGetter
+	memberGetter      memberGetter
 	slackNotifier     slackNotifier
+	emailSender       emailSender
 	githubManager     githubManager
+	gitlabManager     gitlabManager
+	bitbucketManager  bitbucketManager
+	gitRepos          gitRepoResolver
+	fileStorage       fileStorage
 	repo              releaseRepository
 }
 
@@ -25,8 +33,13 @@ func NewReleaseService(
 	projectGetter projectGetter,
 	settingsGetter settingsGetter,
 	environmentGetter environmentGetter,
+	memberGetter memberGetter,
 	notifier slackNotifier,
+	emailSender emailSender,
 	manager githubManager,
+	gitlabManager gitlabManager,
+	bitbucketManager bitbucketManager,
+	storage fileStorage,
 	repo releaseRepository,
 ) *ReleaseService {
 	return &ReleaseService{
@@ -34,8 +47,14 @@ func NewReleaseService(
 		projectGetter:     projectGetter,
 		settingsGetter:    settingsGetter,
 		environmentGetter: environmentGetter,
+		memberGetter:      memberGetter,
 		slackNotifier:     notifier,
+		emailSender:       emailSender,
 		githubManager:     manager,
+		gitlabManager:     gitlabManager,
+		bitbucketManager:  bitbucketManager,
+		gitRepos:          newGitRepoResolver(settingsGetter, manager, gitlabManager, bitbucketManager),
+		fileStorage:       storage,
 		repo:              repo,
 	}
 }
@@ -50,23 +69,26 @@ func (s *ReleaseService) CreateRelease(
 		return model.Release{}, fmt.Errorf("authorizing project member: %w", err)
 	}
 
-	tkn, err := s.settingsGetter.GetGithubToken(ctx)
-	if err != nil {
-		return model.Release{}, fmt.Errorf("getting github token: %w", err)
+	if err := input.Validate(); err != nil {
+		return model.Release{}, svcerrors.NewReleaseInvalidError().Wrap(err).WithMessage(err.Error())
 	}
 
-	p, err := s.projectGetter.GetProject(ctx, projectID, authUserID)
+	p, repo, err := s.getProjectWithGitRepo(ctx, projectID, authUserID)
 	if err != nil {
-		return model.Release{}, fmt.Errorf("getting project: %w", err)
+		return model.Release{}, err
 	}
 
-	if !p.IsGithubRepoSet() {
-		return model.Release{}, svcerrors.NewGithubRepoNotSetForProjectError()
+	if err := s.validateProjectGitTag(ctx, p, input.GitTagName); err != nil {
+		return model.Release{}, err
 	}
 
-	tag, err := s.githubManager.ReadTag(ctx, tkn, *p.GithubRepo, input.GitTagName)
-	if err != nil {
-		return model.Release{}, fmt.Errorf("reading tag: %w", err)
+	// The tag to be created gets its URL from the provider once it exists
+	tag := model.GitTag{Name: input.GitTagName}
+	if input.GitTagTarget == nil {
+		tag, err = repo.ReadTag(ctx, input.GitTagName)
+		if err != nil {
+			return model.Release{}, fmt.Errorf("reading tag: %w", err)
+		}
 	}
 
 	rls, err := model.NewRelease(input, tag, projectID, authUserID)
@@ -74,7 +96,31 @@ func (s *ReleaseService) CreateRelease(
 		return model.Release{}, svcerrors.NewReleaseInvalidError().Wrap(err).WithMessage(err.Error())
 	}
 
+	rls.SetVersion(p)
+	rls.SetNotesTemplate(p)
+	if err := rls.SetGithubOptions(input.GithubOptions); err != nil {
+		return model.Release{}, svcerrors.NewReleaseInvalidError().Wrap(err).WithMessage(err.Error())
+	}
+	if err := s.validateReleaseVersion(ctx, rls); err != nil {
+		return model.Release{}, err
+	}
+
+	// The tag is created only for a valid release, so an invalid input does not leave the tag behind
+	if input.GitTagTarget != nil {
+		rls.Tag, err = repo.CreateTag(ctx, input.GitTagName, *input.GitTagTarget, rls.ReleaseTitle)
+		if err != nil {
+			return model.Release{}, fmt.Errorf("creating tag: %w", err)
+		}
+	}
+
 	if err := s.repo.CreateRelease(ctx, rls); err != nil {
+		// The tag is kept if it is already used by a release, e.g. the draft created by the tag creation webhook.
+		if input.GitTagTarget != nil && !svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeReleaseGitTagAlreadyUsed) {
+			if err := repo.DeleteTag(ctx, input.GitTagName); err != nil {
+				slog.Error("deleting git tag of release which was not created", "tag", input.GitTagName, "error", err)
+			}
+		}
+
 		return model.Release{}, fmt.Errorf("creating release: %w", err)
 	}
 
@@ -94,22 +140,16 @@ func (s *ReleaseService) GetRelease(ctx context.Context, releaseID id.Release, a
 	return rls, nil
 }
 
-// DeleteRelease deletes a release. If deleteGithubRelease is true, it will also delete associacted GitHub release (if exists).
-// Deleting GitHub release is idempotent, so if the release does not exist on GitHub, it will not return an error.
+// DeleteRelease deletes a release. If DeleteGitRelease is true, it will also delete associacted GitHub or GitLab release (if exists).
+// Deleting the provider release is idempotent, so if the release does not exist on GitHub or GitLab, it will not return an error.
 func (s *ReleaseService) DeleteRelease(ctx context.Context, input model.DeleteReleaseInput, releaseID id.Release, authUserID id.AuthUser) error {
 	if err := s.authGuard.AuthorizeReleaseEditor(ctx, releaseID, authUserID); err != nil {
 		return fmt.Errorf("authorizing release editor: %w", err)
 	}
 
-	if input.DeleteGithubRelease {
-		if err := s.deleteGithubRelease(ctx, releaseID, authUserID); err != nil {
-			switch {
-			case svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGithubReleaseNotFound):
-				// If the release does not exist on GitHub, it is not an error.
-				slog.Debug("skipping deleting GitHub release: release not found on GitHub")
-			default:
-				return fmt.Errorf("deleting github release: %w", err)
-			}
+	if input.DeleteGitRelease {
+		if err := s.deleteGitRelease(ctx, releaseID, authUserID); err != nil {
+			return fmt.Errorf("deleting git release: %w", err)
 		}
 	}
 
@@ -130,12 +170,13 @@ func (s *ReleaseService) UpdateRelease(
 		return fmt.Errorf("authorizing release editor: %w", err)
 	}
 
-	if err := s.repo.UpdateRelease(ctx, releaseID, func(rls model.Release) (model.Release, error) {
+	if err := s.repo.UpdateRelease(ctx, releaseID, func(rls model.Release) (model.Release, *model.ReleaseRevision, error) {
+		before := rls
 		if err := rls.Update(input); err != nil {
-			return model.Release{}, svcerrors.NewReleaseInvalidError().Wrap(err).WithMessage(err.Error())
+			return model.Release{}, nil, svcerrors.NewReleaseInvalidError().Wrap(err).WithMessage(err.Error())
 		}
 
-		return rls, nil
+		return rls, model.NewReleaseRevision(before, rls, authUserID), nil
 	}); err != nil {
 		return fmt.Errorf("updating release: %w", err)
 	}
@@ -143,17 +184,169 @@ func (s *ReleaseService) UpdateRelease(
 	return nil
 }
 
-func (s *ReleaseService) ListReleasesForProject(ctx context.Context, projectID id.Project, authUserID id.AuthUser) ([]model.Release, error) {
+// UpdateReleaseStatus moves the release to another lifecycle status, only allowed transitions are accepted.
+func (s *ReleaseService) UpdateReleaseStatus(
+	ctx context.Context,
+	status model.ReleaseStatus,
+	releaseID id.Release,
+	authUserID id.AuthUser,
+) error {
+	if err := s.authGuard.AuthorizeReleaseEditor(ctx, releaseID, authUserID); err != nil {
+		return fmt.Errorf("authorizing release editor: %w", err)
+	}
+
+	var updated model.Release
+	// Revisions track only the title and notes, status changes do not create a revision.
+	if err := s.repo.UpdateRelease(ctx, releaseID, func(rls model.Release) (model.Release, *model.ReleaseRevision, error) {
+		published := rls.IsPublished()
+		if err := rls.UpdateStatus(status); err != nil {
+			return model.Release{}, nil, svcerrors.NewReleaseInvalidError().Wrap(err).WithMessage(err.Error())
+		}
+
+		if !published && rls.IsPublished() {
+			updated = rls
+		}
+		return rls, nil, nil
+	}); err != nil {
+		return fmt.Errorf("updating release status: %w", err)
+	}
+
+	// Linked items are synced when the release is published, so the snapshot matches the published release.
+	if updated.IsPublished() {
+		s.syncReleaseLinkedItemsOnPublish(ctx, updated, authUserID)
+	}
+
+	return nil
+}
+
+// SyncReleaseLinkedItems replaces the snapshot of pull requests merged and issues closed
+// between the git tag of the previous published release and the git tag of the release.
+func (s *ReleaseService) SyncReleaseLinkedItems(ctx context.Context, releaseID id.Release, authUserID id.AuthUser) (model.Release, error) {
+	if err := s.authGuard.AuthorizeReleaseEditor(ctx, releaseID, authUserID); err != nil {
+		return model.Release{}, fmt.Errorf("authorizing release editor: %w", err)
+	}
+
+	rls, err := s.repo.ReadRelease(ctx, releaseID)
+	if err != nil {
+		return model.Release{}, fmt.Errorf("reading release: %w", err)
+	}
+
+	p, tkn, err := s.getProjectWithGithubRepo(ctx, rls.ProjectID, authUserID)
+	if err != nil {
+		return model.Release{}, err
+	}
+
+	return s.syncReleaseLinkedItems(ctx, tkn, *p.GithubRepo, rls)
+}
+
+func (s *ReleaseService) ListReleasesForProject(
+	ctx context.Context,
+	params model.ListReleasesFilterParams,
+	projectID id.Project,
+	authUserID id.AuthUser,
+) (model.ReleasePage, error) {
 	if err := s.authGuard.AuthorizeProjectRoleViewer(ctx, projectID, authUserID); err != nil {
-		return nil, fmt.Errorf("authorizing project member: %w", err)
+		return model.ReleasePage{}, fmt.Errorf("authorizing project member: %w", err)
+	}
+
+	if err := params.Validate(); err != nil {
+		return model.ReleasePage{}, svcerrors.NewReleaseInvalidError().Wrap(err).WithMessage(err.Error())
 	}
 
-	rls, err := s.repo.ListReleasesForProject(ctx, projectID)
+	page, err := s.repo.ListReleasesForProject(ctx, params, projectID)
 	if err != nil {
-		return nil, fmt.Errorf("listing releases: %w", err)
+		return model.ReleasePage{}, fmt.Errorf("listing releases: %w", err)
 	}
 
-	return rls, nil
+	return page, nil
+}
+
+// SearchReleases searches titles, notes and git tag names of releases in all projects the user can see.
+func (s *ReleaseService) SearchReleases(
+	ctx context.Context,
+	params model.SearchReleasesParams,
+	authUserID id.AuthUser,
+) ([]model.ReleaseSearchHit, error) {
+	if err := params.Validate(); err != nil {
+		return nil, svcerrors.NewReleaseInvalidError().Wrap(err).WithMessage(err.Error())
+	}
+
+	// Admin user can see all projects, other users only projects they are members of
+	projects, err := s.projectGetter.ListProjects(ctx, authUserID)
+	if err != nil {
+		return nil, fmt.Errorf("listing projects: %w", err)
+	}
+	if len(projects) == 0 {
+		return []model.ReleaseSearchHit{}, nil
+	}
+
+	projectIDs := make([]id.Project, 0, len(projects))
+	for _, p := range projects {
+		projectIDs = append(projectIDs, p.ID)
+	}
+
+	hits, err := s.repo.SearchReleases(ctx, params, projectIDs)
+	if err != nil {
+		return nil, fmt.Errorf("searching releases: %w", err)
+	}
+
+	return hits, nil
+}
+
+// GetNextReleaseVersion suggests the version of the next release based on the last published release of the project.
+func (s *ReleaseService) GetNextReleaseVersion(
+	ctx context.Context,
+	bump model.VersionBump,
+	projectID id.Project,
+	authUserID id.AuthUser,
+) (model.NextReleaseVersion, error) {
+	if err := s.authGuard.AuthorizeProjectRoleViewer(ctx, projectID, authUserID); err != nil {
+		return model.NextReleaseVersion{}, fmt.Errorf("authorizing project member: %w", err)
+	}
+
+	if err := bump.Validate(); err != nil {
+		return model.NextReleaseVersion{}, svcerrors.NewReleaseInvalidError().Wrap(err).WithMessage(err.Error())
+	}
+
+	p, err := s.projectGetter.GetProject(ctx, projectID, authUserID)
+	if err != nil {
+		return model.NextReleaseVersion{}, fmt.Errorf("getting project: %w", err)
+	}
+
+	last, err := s.getLastPublishedRelease(ctx, projectID)
+	if err != nil {
+		return model.NextReleaseVersion{}, fmt.Errorf("getting last published release: %w", err)
+	}
+
+	return model.NewNextReleaseVersion(p, last, bump), nil
+}
+
+// ExportChangelog returns published releases of the project rendered as a changelog in the requested format.
+func (s *ReleaseService) ExportChangelog(
+	ctx context.Context,
+	params model.ExportChangelogParams,
+	projectID id.Project,
+	authUserID id.AuthUser,
+) (model.Changelog, error) {
+	if err := s.authGuard.AuthorizeProjectRoleViewer(ctx, projectID, authUserID); err != nil {
+		return model.Changelog{}, fmt.Errorf("authorizing project member: %w", err)
+	}
+
+	if err := params.Validate(); err != nil {
+		return model.Changelog{}, svcerrors.NewReleaseInvalidError().Wrap(err).WithMessage(err.Error())
+	}
+
+	p, err := s.projectGetter.GetProject(ctx, projectID, authUserID)
+	if err != nil {
+		return model.Changelog{}, fmt.Errorf("getting project: %w", err)
+	}
+
+	page, err := s.repo.ListReleasesForProject(ctx, params.ListReleasesFilterParams(), projectID)
+	if err != nil {
+		return model.Changelog{}, fmt.Errorf("listing releases: %w", err)
+	}
+
+	return model.NewChangelog(p, page.Releases, params.Format), nil
 }
 
 func (s *ReleaseService) SendReleaseNotification(ctx context.Context, releaseID id.Release, authUserID id.AuthUser) error {
@@ -192,71 +385,132 @@ func (s *ReleaseService) SendReleaseNotification(ctx context.Context, releaseID
 	return nil
 }
 
-func (s *ReleaseService) UpsertGithubRelease(ctx context.Context, releaseID id.Release, authUserID id.AuthUser) error {
+func (s *ReleaseService) UpsertGitRelease(ctx context.Context, releaseID id.Release, authUserID id.AuthUser) error {
 	if err := s.authGuard.AuthorizeReleaseEditor(ctx, releaseID, authUserID); err != nil {
 		return fmt.Errorf("authorizing release editor: %w", err)
 	}
 
-	tkn, err := s.settingsGetter.GetGithubToken(ctx)
-	if err != nil {
-		return fmt.Errorf("getting github token: %w", err)
-	}
-
 	rls, err := s.repo.ReadRelease(ctx, releaseID)
 	if err != nil {
 		return fmt.Errorf("reading release: %w", err)
 	}
 
-	p, err := s.projectGetter.GetProject(ctx, rls.ProjectID, authUserID)
-	if err != nil {
-		return fmt.Errorf("getting project: %w", err)
+	// Only published releases are pushed to GitHub or GitLab.
+	if !rls.IsPublished() {
+		return svcerrors.NewReleaseNotPublishedError()
 	}
 
-	if !p.IsGithubRepoSet() {
-		return svcerrors.NewGithubRepoNotSetForProjectError()
+	_, repo, err := s.getProjectWithGitRepo(ctx, rls.ProjectID, authUserID)
+	if err != nil {
+		return err
 	}
 
-	if err := s.githubManager.UpsertRelease(ctx, tkn, *p.GithubRepo, rls); err != nil {
-		return fmt.Errorf("upserting github release: %w", err)
+	if err := repo.UpsertRelease(ctx, rls); err != nil {
+		return fmt.Errorf("upserting git release: %w", err)
 	}
 
 	return nil
 }
 
-func (s *ReleaseService) GenerateGithubReleaseNotes(
+func (s *ReleaseService) GenerateGitReleaseNotes(
 	ctx context.Context,
-	input model.GithubReleaseNotesInput,
+	input model.GitReleaseNotesInput,
 	projectID id.Project,
 	authUserID id.AuthUser,
-) (model.GithubReleaseNotes, error) {
+) (model.GitReleaseNotes, error) {
 	if err := s.authGuard.AuthorizeProjectRoleEditor(ctx, projectID, authUserID); err != nil {
-		return model.GithubReleaseNotes{}, fmt.Errorf("authorizing project member: %w", err)
+		return model.GitReleaseNotes{}, fmt.Errorf("authorizing project member: %w", err)
 	}
 
-	tkn, err := s.settingsGetter.GetGithubToken(ctx)
+	project, repo, err := s.getProjectWithGitRepo(ctx, projectID, authUserID)
 	if err != nil {
-		return model.GithubReleaseNotes{}, fmt.Errorf("getting Github token: %w", err)
+		return model.GitReleaseNotes{}, err
+	}
+
+	if err := input.Validate(); err != nil {
+		return model.GitReleaseNotes{}, svcerrors.NewGithubNotesInvalidInputError().WithMessage(err.Error())
+	}
+
+	// Linked items are listed from GitHub only
+	if input.IncludeLinkedItems && !project.IsGithubRepoSet() {
+		return model.GitReleaseNotes{}, svcerrors.NewGithubRepoNotSetForProjectError()
+	}
+
+	if input.PreviousGitTagName == nil {
+		previous, err := s.getPreviousProjectGitTagName(ctx, project, repo, *input.GitTagName)
+		if err != nil {
+			return model.GitReleaseNotes{}, err
+		}
+		input.PreviousGitTagName = previous
 	}
 
-	project, err := s.projectGetter.GetProject(ctx, projectID, authUserID)
+	notes, err := repo.GenerateReleaseNotes(ctx, input)
 	if err != nil {
-		return model.GithubReleaseNotes{}, fmt.Errorf("reading project: %w", err)
+		return model.GitReleaseNotes{}, fmt.Errorf("generating release notes: %w", err)
 	}
 
-	if !project.IsGithubRepoSet() {
-		return model.GithubReleaseNotes{}, svcerrors.NewGithubRepoNotSetForProjectError()
+	if input.IncludeLinkedItems {
+		tkn, err := s.settingsGetter.GetGithubToken(ctx)
+		if err != nil {
+			return model.GitReleaseNotes{}, fmt.Errorf("getting github token: %w", err)
+		}
+
+		items, err := s.listLinkedItemsForNotes(ctx, tkn, *project.GithubRepo, input, projectID)
+		if err != nil {
+			return model.GitReleaseNotes{}, fmt.Errorf("listing linked items: %w", err)
+		}
+
+		notes.AppendLinkedItems(items)
+	}
+
+	return notes, nil
+}
+
+// CompareReleases compares git tags of two releases of the project.
+func (s *ReleaseService) CompareReleases(
+	ctx context.Context,
+	input model.CompareReleasesInput,
+	projectID id.Project,
+	authUserID id.AuthUser,
+) (model.ReleaseComparison, error) {
+	if err := s.authGuard.AuthorizeProjectRoleViewer(ctx, projectID, authUserID); err != nil {
+		return model.ReleaseComparison{}, fmt.Errorf("authorizing project member: %w", err)
 	}
 
 	if err := input.Validate(); err != nil {
-		return model.GithubReleaseNotes{}, svcerrors.NewGithubNotesInvalidInputError().WithMessage(err.Error())
+		return model.ReleaseComparison{}, svcerrors.NewReleaseInvalidError().Wrap(err).WithMessage(err.Error())
 	}
 
-	notes, err := s.githubManager.GenerateReleaseNotes(ctx, tkn, *project.GithubRepo, input)
+	tkn, err := s.settingsGetter.GetGithubToken(ctx)
 	if err != nil {
-		return model.GithubReleaseNotes{}, fmt.Errorf("generating release notes: %w", err)
+		return model.ReleaseComparison{}, fmt.Errorf("getting github token: %w", err)
 	}
 
-	return notes, nil
+	p, err := s.projectGetter.GetProject(ctx, projectID, authUserID)
+	if err != nil {
+		return model.ReleaseComparison{}, fmt.Errorf("getting project: %w", err)
+	}
+
+	if !p.IsGithubRepoSet() {
+		return model.ReleaseComparison{}, svcerrors.NewGithubRepoNotSetForProjectError()
+	}
+
+	base, err := s.repo.ReadReleaseForProject(ctx, projectID, input.BaseReleaseID)
+	if err != nil {
+		return model.ReleaseComparison{}, fmt.Errorf("reading base release: %w", err)
+	}
+
+	head, err := s.repo.ReadReleaseForProject(ctx, projectID, input.HeadReleaseID)
+	if err != nil {
+		return model.ReleaseComparison{}, fmt.Errorf("reading head release: %w", err)
+	}
+
+	cmp, err := s.githubManager.CompareTags(ctx, tkn, *p.GithubRepo, base.Tag.Name, head.Tag.Name)
+	if err != nil {
+		return model.ReleaseComparison{}, fmt.Errorf("comparing git tags: %w", err)
+	}
+
+	return model.NewReleaseComparison(base, head, cmp), nil
 }
 
 func (s *ReleaseService) CreateDeployment(
@@ -279,6 +533,11 @@ func (s *ReleaseService) CreateDeployment(
 		return model.Deployment{}, fmt.Errorf("getting release: %w", err)
 	}
 
+	// Only published releases can be deployed to environments.
+	if !rls.IsPublished() {
+		return model.Deployment{}, svcerrors.NewReleaseNotPublishedError()
+	}
+
 	env, err := s.environmentGetter.GetEnvironment(ctx, projectID, input.EnvironmentID, authUserID)
 	if err != nil {
 		return model.Deployment{}, fmt.Errorf("getting environment: %w", err)
@@ -290,9 +549,40 @@ func (s *ReleaseService) CreateDeployment(
 		return model.Deployment{}, fmt.Errorf("creating deployment: %w", err)
 	}
 
+	s.mirrorDeploymentToGithub(ctx, projectID, &dpl, authUserID)
+
+	if dpl.IsPendingApproval() {
+		s.notifyDeploymentApprovers(ctx, dpl, authUserID)
+	}
+
 	return dpl, nil
 }
 
+// ApproveDeployment records approval of a pending deployment.
+// The deployment is deployed once the environment approval policy is satisfied.
+func (s *ReleaseService) ApproveDeployment(
+	ctx context.Context,
+	projectID id.Project,
+	deploymentID id.Deployment,
+	authUserID id.AuthUser,
+) (model.Deployment, error) {
+	return s.decideDeployment(ctx, projectID, deploymentID, authUserID, func(dpl *model.Deployment, approver model.ProjectMember) error {
+		return dpl.Approve(approver)
+	})
+}
+
+// RejectDeployment rejects a pending deployment, a single rejection is enough.
+func (s *ReleaseService) RejectDeployment(
+	ctx context.Context,
+	projectID id.Project,
+	deploymentID id.Deployment,
+	authUserID id.AuthUser,
+) (model.Deployment, error) {
+	return s.decideDeployment(ctx, projectID, deploymentID, authUserID, func(dpl *model.Deployment, approver model.ProjectMember) error {
+		return dpl.Reject(approver)
+	})
+}
+
 func (s *ReleaseService) ListDeploymentsForProject(
 	ctx context.Context,
 	params model.ListDeploymentsFilterParams,
@@ -303,6 +593,10 @@ func (s *ReleaseService) ListDeploymentsForProject(
 		return nil, fmt.Errorf("authorizing project member: %w", err)
 	}
 
+	if err := params.Validate(); err != nil {
+		return nil, svcerrors.NewDeploymentInvalidError().Wrap(err).WithMessage(err.Error())
+	}
+
 	// If releaseID is provided, need to check if the release exists within given project.
 	if params.ReleaseID != nil {
 		if _, err := s.repo.ReadReleaseForProject(ctx, projectID, *params.ReleaseID); err != nil {
@@ -325,7 +619,115 @@ func (s *ReleaseService) ListDeploymentsForProject(
 	return dpls, nil
 }
 
+func (s *ReleaseService) UploadReleaseAttachment(
+	ctx context.Context,
+	input model.ReleaseAttachmentInput,
+	releaseID id.Release,
+	authUserID id.AuthUser,
+) (model.ReleaseAttachment, error) {
+	if err := s.authGuard.AuthorizeReleaseEditor(ctx, releaseID, authUserID); err != nil {
+		return model.ReleaseAttachment{}, fmt.Errorf("authorizing release editor: %w", err)
+	}
+
+	a, err := model.NewReleaseAttachment(input, releaseID)
+	if err != nil {
+		return model.ReleaseAttachment{}, svcerrors.NewReleaseAttachmentInvalidError().Wrap(err).WithMessage(err.Error())
+	}
+
+	if err := s.uploadReleaseAttachmentFile(ctx, a.FilePath, input); err != nil {
+		return model.ReleaseAttachment{}, err
+	}
+
+	if err := s.repo.CreateReleaseAttachment(ctx, releaseID, a); err != nil {
+		// The uploaded file would not be referenced by any attachment.
+		s.deleteOrphanedReleaseAttachmentFile(ctx, a.FilePath)
+		return model.ReleaseAttachment{}, fmt.Errorf("creating release attachment: %w", err)
+	}
+
+	// Attachment is read again to get the signed URL of the uploaded file.
+	a, err = s.repo.ReadReleaseAttachment(ctx, releaseID, a.ID)
+	if err != nil {
+		return model.ReleaseAttachment{}, fmt.Errorf("reading release attachment: %w", err)
+	}
+
+	return a, nil
+}
+
+// ReplaceReleaseAttachment uploads a new file for an existing attachment.
+// The file is uploaded before the attachment is updated, so no transaction is open while the file is streamed.
+// The previous file is deleted only after the attachment points to the new file.
+func (s *ReleaseService) ReplaceReleaseAttachment(
+	ctx context.Context,
+	input model.ReleaseAttachmentInput,
+	releaseID id.Release,
+	attachmentID uuid.UUID,
+	authUserID id.AuthUser,
+) (model.ReleaseAttachment, error) {
+	if err := s.authGuard.AuthorizeReleaseEditor(ctx, releaseID, authUserID); err != nil {
+		return model.ReleaseAttachment{}, fmt.Errorf("authorizing release editor: %w", err)
+	}
+
+	a, err := s.repo.ReadReleaseAttachment(ctx, releaseID, attachmentID)
+	if err != nil {
+		return model.ReleaseAttachment{}, fmt.Errorf("reading release attachment: %w", err)
+	}
+
+	if err := a.Replace(input, releaseID); err != nil {
+		return model.ReleaseAttachment{}, svcerrors.NewReleaseAttachmentInvalidError().Wrap(err).WithMessage(err.Error())
+	}
+
+	if err := s.uploadReleaseAttachmentFile(ctx, a.FilePath, input); err != nil {
+		return model.ReleaseAttachment{}, err
+	}
+
+	var replaced model.ReleaseAttachment
+	if err := s.repo.UpdateReleaseAttachment(ctx, releaseID, attachmentID, func(current model.ReleaseAttachment) (model.ReleaseAttachment, error) {
+		replaced = current
+		return a, nil
+	}); err != nil {
+		// The uploaded file would not be referenced by any attachment.
+		s.deleteOrphanedReleaseAttachmentFile(ctx, a.FilePath)
+		return model.ReleaseAttachment{}, fmt.Errorf("updating release attachment: %w", err)
+	}
+
+	if replaced.IsStored() {
+		s.deleteOrphanedReleaseAttachmentFile(ctx, replaced.FilePath)
+	}
+
+	a, err = s.repo.ReadReleaseAttachment(ctx, releaseID, attachmentID)
+	if err != nil {
+		return model.ReleaseAttachment{}, fmt.Errorf("reading release attachment: %w", err)
+	}
+
+	return a, nil
+}
+
+// DeleteReleaseAttachment deletes the attachment, its file in the storage is deleted after the attachment.
+// A file which cannot be deleted is only orphaned. Files of external attachments are not deleted.
+func (s *ReleaseService) DeleteReleaseAttachment(
+	ctx context.Context,
+	releaseID id.Release,
+	attachmentID uuid.UUID,
+	authUserID id.AuthUser,
+) error {
+	if err := s.authGuard.AuthorizeReleaseEditor(ctx, releaseID, authUserID); err != nil {
+		return fmt.Errorf("authorizing release editor: %w", err)
+	}
+
+	a, err := s.repo.DeleteReleaseAttachment(ctx, releaseID, attachmentID)
+	if err != nil {
+		return fmt.Errorf("deleting release attachment: %w", err)
+	}
+
+	if a.IsStored() {
+		s.deleteOrphanedReleaseAttachmentFile(ctx, a.FilePath)
+	}
+
+	return nil
+}
+
 // DeleteReleaseOnGitTagRemoval is used when the git tag is deleted on GitHub and webhook is triggered to delete the release associated with the tag.
+// Only the release of the project the tag is routed to is deleted, other projects sharing the repo are not affected.
 func (s *ReleaseService) DeleteReleaseOnGitTagRemoval(ctx context.Context, input model.GithubTagDeletionWebhookInput) error {
 	github, err := s.settingsGetter.GetGithubSettings(ctx)
 	if err != nil {
@@ -336,45 +738,439 @@ func (s *ReleaseService) DeleteReleaseOnGitTagRemoval(ctx context.Context, input
 		return svcerrors.NewGithubIntegrationNotEnabledError()
 	}
 
-	output, err := s.githubManager.ParseTagDeletionWebhook(ctx, input, github.Token, github.WebhookSecret)
+	secret, err := s.getGithubWebhookSecret(ctx, github, input.RawPayload, input.Signature)
+	if err != nil {
+		return err
+	}
+
+	tkn, err := s.settingsGetter.GetGithubToken(ctx)
+	if err != nil {
+		return fmt.Errorf("getting github token: %w", err)
+	}
+
+	output, err := s.githubManager.ParseTagDeletionWebhook(ctx, input, tkn, secret)
 	if err != nil {
 		return fmt.Errorf("parsing webhook delete tag event: %w", err)
 	}
 
-	if err := s.repo.DeleteReleaseByGitTag(ctx, output.Repo, output.TagName); err != nil {
+	p, err := s.projectGetter.GetProjectByGithubRepo(ctx, output.Repo, output.TagName)
+	if err != nil {
+		return fmt.Errorf("getting project by github repo: %w", err)
+	}
+
+	if err := s.repo.DeleteReleaseForProjectByGitTag(ctx, p.ID, output.TagName); err != nil {
 		return fmt.Errorf("deleting release by git tag: %w", err)
 	}
 
 	return nil
 }
 
-func (s *ReleaseService) deleteGithubRelease(ctx context.Context, releaseID id.Release, authUserID id.AuthUser) error {
+// CreateDraftReleaseOnGitTagCreation is used when a git tag is created on GitHub and webhook is triggered to create a draft release for the tag.
+// Notes are generated by GitHub against the tag of the last published release. The release has no author.
+// If the tag already has a release (e.g. the tag was created together with the release), nothing is created.
+func (s *ReleaseService) CreateDraftReleaseOnGitTagCreation(ctx context.Context, input model.GithubTagCreationWebhookInput) error {
+	github, err := s.settingsGetter.GetGithubSettings(ctx)
+	if err != nil {
+		return fmt.Errorf("getting github settings: %w", err)
+	}
+
+	if !github.Enabled {
+		return svcerrors.NewGithubIntegrationNotEnabledError()
+	}
+
+	secret, err := s.getGithubWebhookSecret(ctx, github, input.RawPayload, input.Signature)
+	if err != nil {
+		return err
+	}
+
 	tkn, err := s.settingsGetter.GetGithubToken(ctx)
 	if err != nil {
 		return fmt.Errorf("getting github token: %w", err)
 	}
 
+	output, err := s.githubManager.ParseTagCreationWebhook(ctx, input, tkn, secret)
+	if err != nil {
+		return fmt.Errorf("parsing webhook create tag event: %w", err)
+	}
+
+	p, err := s.projectGetter.GetProjectByGithubRepo(ctx, output.Repo, output.Tag.Name)
+	if err != nil {
+		return fmt.Errorf("getting project by github repo: %w", err)
+	}
+
+	last, err := s.getLastPublishedRelease(ctx, p.ID)
+	if err != nil {
+		return fmt.Errorf("getting last published release: %w", err)
+	}
+
+	notesInput := model.GitReleaseNotesInput{GitTagName: &output.Tag.Name}
+	if last != nil {
+		notesInput.PreviousGitTagName = &last.Tag.Name
+	}
+
+	notes, err := s.githubManager.GenerateReleaseNotes(ctx, tkn, output.Repo, notesInput)
+	if err != nil {
+		return fmt.Errorf("generating release notes: %w", err)
+	}
+
+	return s.createDraftReleaseForGitTag(ctx, p, output.Tag, notes, last)
+}
+
+// createDraftReleaseForGitTag creates the draft release of a tag created on GitHub or GitLab, the release has no author.
+// The version must be higher than the version of the last published release, if there is one.
+func (s *ReleaseService) createDraftReleaseForGitTag(
+	ctx context.Context,
+	p model.Project,
+	tag model.GitTag,
+	notes model.GitReleaseNotes,
+	last *model.Release,
+) error {
+	title := notes.Title
+	if title == "" {
+		title = tag.Name
+	}
+
+	rls, err := model.NewRelease(model.CreateReleaseInput{
+		ReleaseTitle: title,
+		ReleaseNotes: notes.Notes,
+		GitTagName:   tag.Name,
+	}, tag, p.ID, id.AuthUser{})
+	if err != nil {
+		return svcerrors.NewReleaseInvalidError().Wrap(err).WithMessage(err.Error())
+	}
+
+	rls.SetVersion(p)
+	rls.SetNotesTemplate(p)
+	if err := rls.SetGithubOptions(model.GithubReleaseOptionsInput{}); err != nil {
+		return svcerrors.NewReleaseInvalidError().Wrap(err).WithMessage(err.Error())
+	}
+	if last != nil {
+		if err := rls.ValidateVersionAfter(*last); err != nil {
+			return svcerrors.NewReleaseVersionNotIncreasedError().Wrap(err).WithMessage(err.Error())
+		}
+	}
+
+	if err := s.repo.CreateRelease(ctx, rls); err != nil {
+		if svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeReleaseGitTagAlreadyUsed) {
+			return nil
+		}
+
+		return fmt.Errorf("creating release: %w", err)
+	}
+
+	return nil
+}
+
+// getPreviousProjectGitTagName returns the tag of the previous version of the project if its repo is shared by several projects.
+// The git provider would pick the previous tag of the whole repo, which may be a tag of another project.
+// Nil is returned for repos which are not shared and if the project has no previous tag.
+func (s *ReleaseService) getPreviousProjectGitTagName(ctx context.Context, p model.Project, repo gitRepo, tagName string) (*string, error) {
+	shared, err := s.projectGetter.IsGitRepoShared(ctx, p)
+	if err != nil {
+		return nil, fmt.Errorf("checking if git repo is shared: %w", err)
+	}
+	if !shared {
+		return nil, nil
+	}
+
+	tags, err := repo.ReadTags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("reading tags for git repo: %w", err)
+	}
+
+	previous, ok := model.PreviousVersionTagName(tags, tagName, p.VersionTagPrefix)
+	if !ok {
+		return nil, nil
+	}
+
+	return &previous, nil
+}
+
+// getGithubWebhookSecret returns the secret of the webhook registered on the repo the delivery was sent from.
+// Each project sharing the repo registers its own webhook, the secret of the webhook which signed the delivery is returned.
+// Deliveries of manually configured webhooks and of repos which are not linked to any project use the global secret.
+func (s *ReleaseService) getGithubWebhookSecret(
+	ctx context.Context,
+	github model.GithubSettings,
+	rawPayload []byte,
+	signature string,
+) (model.GithubWebhookSecret, error) {
+	repo, ok := s.githubManager.ReadWebhookRepo(rawPayload)
+	if !ok {
+		return github.WebhookSecret, nil
+	}
+
+	projects, err := s.projectGetter.ListProjectsByGithubRepo(ctx, repo)
+	if err != nil {
+		return "", fmt.Errorf("listing projects by github repo: %w", err)
+	}
+
+	// The signature of a repo linked to a single project is verified when the payload is parsed
+	if len(projects) == 1 {
+		return projects[0].GithubWebhookSecret(github.WebhookSecret), nil
+	}
+
+	input := model.GithubWebhookDeliveryInput{RawPayload: rawPayload, Signature: signature}
+	for _, p := range projects {
+		secret := p.GithubWebhookSecret(github.WebhookSecret)
+		if s.githubManager.ReadWebhookDeliveryInfo(input, secret).SignatureValid {
+			return secret, nil
+		}
+	}
+
+	return github.WebhookSecret, nil
+}
+
+// deleteGitRelease deletes the GitHub or GitLab release of the release tag, a release which does not exist is skipped.
+func (s *ReleaseService) deleteGitRelease(ctx context.Context, releaseID id.Release, authUserID id.AuthUser) error {
 	rls, err := s.repo.ReadRelease(ctx, releaseID)
 	if err != nil {
 		return fmt.Errorf("reading release: %w", err)
 	}
 
-	p, err := s.projectGetter.GetProject(ctx, rls.ProjectID, authUserID)
+	_, repo, err := s.getProjectWithGitRepo(ctx, rls.ProjectID, authUserID)
 	if err != nil {
-		return fmt.Errorf("getting project: %w", err)
+		return err
 	}
 
-	if !p.IsGithubRepoSet() {
-		return svcerrors.NewGithubRepoNotSetForProjectError()
+	if err := repo.DeleteReleaseByTag(ctx, rls.Tag); err != nil {
+		return fmt.Errorf("deleting release by tag: %w", err)
 	}
 
-	if err := s.githubManager.DeleteReleaseByTag(ctx, tkn, *p.GithubRepo, rls.Tag); err != nil {
-		return fmt.Errorf("deleting github release: %w", err)
+	return nil
+}
+
+func (s *ReleaseService) uploadReleaseAttachmentFile(ctx context.Context, filePath string, input model.ReleaseAttachmentInput) error {
+	content := model.NewReleaseAttachmentContent(input.Content)
+	if err := s.fileStorage.UploadFile(ctx, filePath, input.ContentType, content); err != nil {
+		if content.IsTooLarge() {
+			return svcerrors.NewReleaseAttachmentTooLargeError().Wrap(err)
+		}
+		if readErr := content.ReadErr(); readErr != nil {
+			return fmt.Errorf("reading release attachment content: %w", readErr)
+		}
+
+		return fmt.Errorf("uploading release attachment file: %w", err)
 	}
 
 	return nil
 }
 
+// deleteOrphanedReleaseAttachmentFile deletes a file which is no longer referenced by any attachment.
+// Failure is only logged, since the file is not accessible through the API anymore.
+func (s *ReleaseService) deleteOrphanedReleaseAttachmentFile(ctx context.Context, filePath string) {
+	if err := s.fileStorage.DeleteFile(ctx, filePath); err != nil {
+		slog.Error("deleting orphaned release attachment file", "file_path", filePath, "error", err)
+	}
+}
+
+func (s *ReleaseService) decideDeployment(
+	ctx context.Context,
+	projectID id.Project,
+	deploymentID id.Deployment,
+	authUserID id.AuthUser,
+	decideFn func(dpl *model.Deployment, approver model.ProjectMember) error,
+) (model.Deployment, error) {
+	if err := s.authGuard.AuthorizeProjectRoleViewer(ctx, projectID, authUserID); err != nil {
+		return model.Deployment{}, fmt.Errorf("authorizing project member: %w", err)
+	}
+
+	dpl, err := s.repo.ReadDeployment(ctx, projectID, deploymentID)
+	if err != nil {
+		return model.Deployment{}, fmt.Errorf("reading deployment: %w", err)
+	}
+
+	approver, err := s.getDeploymentApprover(ctx, projectID, dpl.Environment.ID, authUserID)
+	if err != nil {
+		return model.Deployment{}, err
+	}
+
+	if err := s.repo.UpdateDeployment(ctx, projectID, deploymentID, func(d model.Deployment) (model.Deployment, error) {
+		if err := decideFn(&d, approver); err != nil {
+			return model.Deployment{}, svcerrors.NewDeploymentInvalidError().Wrap(err).WithMessage(err.Error())
+		}
+
+		dpl = d
+		return d, nil
+	}); err != nil {
+		return model.Deployment{}, fmt.Errorf("updating deployment: %w", err)
+	}
+
+	// Deployments which were not mirrored when created are not mirrored later, the GitHub deployment would not match the release.
+	if dpl.IsMirroredToGithub() {
+		s.mirrorDeploymentToGithub(ctx, projectID, &dpl, authUserID)
+	}
+
+	return dpl, nil
+}
+
+func (s *ReleaseService) syncReleaseLinkedItems(
+	ctx context.Context,
+	tkn model.GithubToken,
+	repo model.GithubRepo,
+	rls model.Release,
+) (model.Release, error) {
+	previous, err := s.repo.ReadPreviousPublishedRelease(ctx, rls)
+	if err != nil {
+		if svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeReleaseNotFound) {
+			return model.Release{}, svcerrors.NewPreviousReleaseNotFoundError().Wrap(err)
+		}
+
+		return model.Release{}, fmt.Errorf("reading previous published release: %w", err)
+	}
+
+	items, err := s.githubManager.ListReleaseLinkedItems(ctx, tkn, repo, previous.Tag.Name, rls.Tag.Name)
+	if err != nil {
+		return model.Release{}, fmt.Errorf("listing linked items: %w", err)
+	}
+
+	if err := s.repo.UpdateRelease(ctx, rls.ID, func(r model.Release) (model.Release, *model.ReleaseRevision, error) {
+		r.SetLinkedItems(items)
+		rls = r
+		return r, nil, nil
+	}); err != nil {
+		return model.Release{}, fmt.Errorf("updating release: %w", err)
+	}
+
+	return rls, nil
+}
+
+// syncReleaseLinkedItemsOnPublish is best effort, publishing does not fail if GitHub is not enabled,
+// the repo is not set, there is no previous release or GitHub cannot be reached.
+func (s *ReleaseService) syncReleaseLinkedItemsOnPublish(ctx context.Context, rls model.Release, authUserID id.AuthUser) {
+	p, tkn, err := s.getProjectWithGithubRepo(ctx, rls.ProjectID, authUserID)
+	if err == nil {
+		_, err = s.syncReleaseLinkedItems(ctx, tkn, *p.GithubRepo, rls)
+	}
+
+	switch {
+	case err == nil:
+	case svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGithubIntegrationNotEnabled),
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGithubRepoNotSetForProject),
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodePreviousReleaseNotFound):
+		slog.Debug("skipping syncing linked items of published release", "release_id", rls.ID, "error", err)
+	default:
+		slog.Error("syncing linked items of published release", "release_id", rls.ID, "error", err)
+	}
+}
+
+// listLinkedItemsForNotes compares the tag with the previous tag of the input,
+// the tag of the last published release is used if the previous tag is not set.
+// No items are listed if there is no tag to compare with.
+func (s *ReleaseService) listLinkedItemsForNotes(
+	ctx context.Context,
+	tkn model.GithubToken,
+	repo model.GithubRepo,
+	input model.GitReleaseNotesInput,
+	projectID id.Project,
+) (model.ReleaseLinkedItems, error) {
+	previousTagName := input.PreviousGitTagName
+	if previousTagName == nil {
+		last, err := s.getLastPublishedRelease(ctx, projectID)
+		if err != nil {
+			return model.ReleaseLinkedItems{}, err
+		}
+		if last == nil || last.Tag.Name == input.GetGitTagName() {
+			return model.ReleaseLinkedItems{}, nil
+		}
+
+		previousTagName = &last.Tag.Name
+	}
+
+	return s.githubManager.ListReleaseLinkedItems(ctx, tkn, repo, *previousTagName, input.GetGitTagName())
+}
+
+// mirrorDeploymentToGithub creates the GitHub deployment if it does not exist yet and reports the status of the deployment to it.
+// Mirroring is best effort, the deployment does not fail if GitHub is not enabled, the repo is not set or GitHub cannot be reached.
+func (s *ReleaseService) mirrorDeploymentToGithub(ctx context.Context, projectID id.Project, dpl *model.Deployment, authUserID id.AuthUser) {
+	p, tkn, err := s.getProjectWithGithubRepo(ctx, projectID, authUserID)
+	if err != nil {
+		switch {
+		case svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGithubIntegrationNotEnabled),
+			svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGithubRepoNotSetForProject):
+			slog.Debug("skipping mirroring deployment to GitHub", "deployment_id", dpl.ID, "error", err)
+		default:
+			slog.Error("getting github repo for deployment", "deployment_id", dpl.ID, "error", err)
+		}
+		return
+	}
+
+	if !dpl.IsMirroredToGithub() {
+		githubDeploymentID, err := s.githubManager.CreateDeployment(ctx, tkn, *p.GithubRepo, *dpl)
+		if err != nil {
+			slog.Error("creating github deployment", "deployment_id", dpl.ID, "error", err)
+			return
+		}
+
+		if err := s.repo.UpdateDeployment(ctx, projectID, dpl.ID, func(d model.Deployment) (model.Deployment, error) {
+			d.GithubDeploymentID = &githubDeploymentID
+			return d, nil
+		}); err != nil {
+			slog.Error("storing github deployment id", "deployment_id", dpl.ID, "github_deployment_id", githubDeploymentID, "error", err)
+			return
+		}
+
+		dpl.GithubDeploymentID = &githubDeploymentID
+	}
+
+	if err := s.githubManager.CreateDeploymentStatus(ctx, tkn, *p.GithubRepo, *dpl.GithubDeploymentID, *dpl); err != nil {
+		slog.Error("creating github deployment status", "deployment_id", dpl.ID, "error", err)
+	}
+}
+
+func (s *ReleaseService) getDeploymentApprover(
+	ctx context.Context,
+	projectID id.Project,
+	envID id.Environment,
+	authUserID id.AuthUser,
+) (model.ProjectMember, error) {
+	approvers, err := s.memberGetter.ListEnvironmentApprovers(ctx, projectID, envID, authUserID)
+	if err != nil {
+		return model.ProjectMember{}, fmt.Errorf("listing environment approvers: %w", err)
+	}
+
+	for _, a := range approvers {
+		if a.User.ID == id.User(authUserID) {
+			return a, nil
+		}
+	}
+
+	return model.ProjectMember{}, svcerrors.NewDeploymentApproverNotAllowedError()
+}
+
+// notifyDeploymentApprovers emails the approvers of the pending deployment.
+// Notifying is best effort, the deployment is already created, so it does not fail if the approvers cannot be notified.
+func (s *ReleaseService) notifyDeploymentApprovers(ctx context.Context, dpl model.Deployment, authUserID id.AuthUser) {
+	p, err := s.projectGetter.GetProject(ctx, dpl.Release.ProjectID, authUserID)
+	if err != nil {
+		slog.Error("getting project to notify deployment approvers", "deployment_id", dpl.ID, "error", err)
+		return
+	}
+
+	approvers, err := s.memberGetter.ListEnvironmentApprovers(ctx, dpl.Release.ProjectID, dpl.Environment.ID, authUserID)
+	if err != nil {
+		slog.Error("listing environment approvers", "deployment_id", dpl.ID, "error", err)
+		return
+	}
+
+	recipients := make([]string, 0, len(approvers))
+	for _, a := range approvers {
+		// The requester cannot approve own deployment.
+		if a.User.ID == id.User(authUserID) {
+			continue
+		}
+
+		recipients = append(recipients, a.User.Email)
+	}
+
+	if len(recipients) == 0 {
+		slog.Warn("no approvers to notify about pending deployment", "deployment_id", dpl.ID)
+		return
+	}
+
+	s.emailSender.SendDeploymentApprovalRequestEmailAsync(ctx, model.NewDeploymentApprovalRequestEmailData(p.Name, dpl), recipients)
+}
+
 // getLastDeploymentForRelease returns pointer to the last deployment for the release,
 // or nil if no deployment exists for the release.
 func (s *ReleaseService) getLastDeploymentForRelease(ctx context.Context, releaseID id.Release) (*model.Deployment, error) {
@@ -390,3 +1186,127 @@ func (s *ReleaseService) getLastDeploymentForRelease(ctx context.Context, releas
 
 	return &dpl, nil
 }
+
+// getLastPublishedRelease returns pointer to the published release with the highest version,
+// or nil if no release with a version was published yet.
+func (s *ReleaseService) getLastPublishedRelease(ctx context.Context, projectID id.Project) (*model.Release, error) {
+	rls, err := s.repo.ReadLastPublishedRelease(ctx, projectID)
+	if err != nil {
+		switch {
+		case svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeReleaseNotFound):
+			return nil, nil
+		default:
+			return nil, fmt.Errorf("reading last published release: %w", err)
+		}
+	}
+
+	return &rls, nil
+}
+
+// validateReleaseVersion checks that a new release has greater version than the last published release.
+func (s *ReleaseService) validateReleaseVersion(ctx context.Context, rls model.Release) error {
+	if rls.Version == nil {
+		return nil
+	}
+
+	last, err := s.getLastPublishedRelease(ctx, rls.ProjectID)
+	if err != nil {
+		return fmt.Errorf("getting last published release: %w", err)
+	}
+	if last == nil {
+		return nil
+	}
+
+	if err := rls.ValidateVersionAfter(*last); err != nil {
+		return svcerrors.NewReleaseVersionNotIncreasedError().Wrap(err).WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// readGitTag reads the tag from the GitHub, GitLab or Bitbucket repository of the project.
+// The project is returned as well, since it is needed to set the version and notes template of the release.
+func (s *ReleaseService) readGitTag(
+	ctx context.Context,
+	projectID id.Project,
+	tagName string,
+	authUserID id.AuthUser,
+) (model.Project, model.GitTag, error) {
+	p, repo, err := s.getProjectWithGitRepo(ctx, projectID, authUserID)
+	if err != nil {
+		return model.Project{}, model.GitTag{}, err
+	}
+
+	if err := s.validateProjectGitTag(ctx, p, tagName); err != nil {
+		return model.Project{}, model.GitTag{}, err
+	}
+
+	tag, err := repo.ReadTag(ctx, tagName)
+	if err != nil {
+		return model.Project{}, model.GitTag{}, fmt.Errorf("reading tag: %w", err)
+	}
+
+	return p, tag, nil
+}
+
+// validateProjectGitTag rejects a tag of another project if the git repo of the project is shared by several projects (monorepo).
+// Tags of a shared repo are routed by the version tag prefix, a tag without the prefix of the project would not get a version.
+func (s *ReleaseService) validateProjectGitTag(ctx context.Context, p model.Project, tagName string) error {
+	if p.HasVersionTagPrefix(tagName) {
+		return nil
+	}
+
+	shared, err := s.projectGetter.IsGitRepoShared(ctx, p)
+	if err != nil {
+		return fmt.Errorf("checking if git repo is shared: %w", err)
+	}
+	if shared {
+		return svcerrors.NewReleaseInvalidError().WithMessage(
+			fmt.Sprintf("git tag must start with the version tag prefix %q of the project, the git repo is shared by several projects", p.VersionTagPrefix),
+		)
+	}
+
+	return nil
+}
+
+// getProjectWithGitRepo returns the project along with its GitHub, GitLab or Bitbucket repo, it fails if none is set.
+func (s *ReleaseService) getProjectWithGitRepo(
+	ctx context.Context,
+	projectID id.Project,
+	authUserID id.AuthUser,
+) (model.Project, gitRepo, error) {
+	p, err := s.projectGetter.GetProject(ctx, projectID, authUserID)
+	if err != nil {
+		return model.Project{}, nil, fmt.Errorf("getting project: %w", err)
+	}
+
+	repo, err := s.gitRepos.resolve(ctx, p)
+	if err != nil {
+		return model.Project{}, nil, err
+	}
+
+	return p, repo, nil
+}
+
+// getProjectWithGithubRepo returns the project along with the GitHub token, it fails if the GitHub repository is not set.
+func (s *ReleaseService) getProjectWithGithubRepo(
+	ctx context.Context,
+	projectID id.Project,
+	authUserID id.AuthUser,
+) (model.Project, model.GithubToken, error) {
+	tkn, err := s.settingsGetter.GetGithubToken(ctx)
+	if err != nil {
+		return model.Project{}, "", fmt.Errorf("getting github token: %w", err)
+	}
+
+	p, err := s.projectGetter.GetProject(ctx, projectID, authUserID)
+	if err != nil {
+		return model.Project{}, "", fmt.Errorf("getting project: %w", err)
+	}
+
+	if !p.IsGithubRepoSet() {
+		return model.Project{}, "", svcerrors.NewGithubRepoNotSetForProjectError()
+	}
+
+	return p, tkn, nil
+}
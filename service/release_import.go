@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"release-manager/pkg/id"
+	svcerrors "release-manager/service/errors"
+	"release-manager/service/model"
+)
+
+// ImportGithubReleases creates releases from the existing GitHub releases of the project repository.
+// Tags which already have a release are skipped, so the import can be run repeatedly.
+// A GitHub release which is not a valid release is reported in the summary and does not stop the import.
+func (s *ReleaseService) ImportGithubReleases(
+	ctx context.Context,
+	projectID id.Project,
+	authUserID id.AuthUser,
+) (model.GithubReleaseImportSummary, error) {
+	if err := s.authGuard.AuthorizeProjectRoleEditor(ctx, projectID, authUserID); err != nil {
+		return model.GithubReleaseImportSummary{}, fmt.Errorf("authorizing project member: %w", err)
+	}
+
+	p, tkn, err := s.getProjectWithGithubRepo(ctx, projectID, authUserID)
+	if err != nil {
+		return model.GithubReleaseImportSummary{}, err
+	}
+
+	githubReleases, err := s.githubManager.ListReleases(ctx, tkn, *p.GithubRepo)
+	if err != nil {
+		return model.GithubReleaseImportSummary{}, fmt.Errorf("listing github releases: %w", err)
+	}
+
+	var summary model.GithubReleaseImportSummary
+	for _, gr := range githubReleases {
+		rls, err := model.NewImportedRelease(gr, p, authUserID)
+		if err != nil {
+			summary.AddFailed(gr.Tag.Name, err)
+			continue
+		}
+
+		if err := s.repo.ImportRelease(ctx, rls); err != nil {
+			if svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeReleaseGitTagAlreadyUsed) {
+				summary.AddSkipped(gr.Tag.Name)
+				continue
+			}
+
+			return model.GithubReleaseImportSummary{}, fmt.Errorf("importing release %s: %w", gr.Tag.Name, err)
+		}
+
+		summary.AddImported(gr.Tag.Name)
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	bitbucket "release-manager/bitbucket/mock"
+	github "release-manager/github/mock"
+	gitlab "release-manager/gitlab/mock"
+	"release-manager/pkg/id"
+	repo "release-manager/repository/mock"
+	resend "release-manager/resend/mock"
+	svcerrors "release-manager/service/errors"
+	svc "release-manager/service/mock"
+	"release-manager/service/model"
+	slack "release-manager/slack/mock"
+	storage "release-manager/storage/mock"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReleaseService_ImportGithubReleases(t *testing.T) {
+	project := model.Project{
+		VersionTagPrefix: "v",
+		GithubRepo: &model.GithubRepo{
+			OwnerSlug: "owner",
+			RepoSlug:  "repo",
+		},
+	}
+	githubReleases := []model.GithubRelease{
+		{Tag: model.GitTag{Name: "v1.1.0"}, Name: "Second release"},
+		{Tag: model.GitTag{Name: "v1.0.0"}, Name: "First release"},
+		{Tag: model.GitTag{}},
+	}
+
+	testCases := []struct {
+		name        string
+		mockSetup   func(*svc.AuthorizationService, *svc.SettingsService, *svc.ProjectService, *github.Client, *repo.ReleaseRepository)
+		wantSummary model.GithubReleaseImportSummary
+		wantErr     bool
+	}{
+		{
+			name: "Already imported releases are skipped",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(project, nil)
+				github.On("ListReleases", mock.Anything, mock.Anything, mock.Anything).Return(githubReleases, nil)
+				releaseRepo.On("ImportRelease", mock.Anything, mock.MatchedBy(func(r model.Release) bool {
+					return r.Tag.Name == "v1.1.0"
+				})).Return(nil)
+				releaseRepo.On("ImportRelease", mock.Anything, mock.MatchedBy(func(r model.Release) bool {
+					return r.Tag.Name == "v1.0.0"
+				})).Return(svcerrors.NewReleaseGitTagAlreadyUsedError())
+			},
+			wantSummary: model.GithubReleaseImportSummary{
+				Imported: []string{"v1.1.0"},
+				Skipped:  []string{"v1.0.0"},
+				Failed: []model.GithubReleaseImportFailure{
+					{GitTagName: "", Reason: "release title is required"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Unauthorized",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewInsufficientProjectRoleError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "GitHub repo not set",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Database error stops the import",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(project, nil)
+				github.On("ListReleases", mock.Anything, mock.Anything, mock.Anything).Return(githubReleases, nil)
+				releaseRepo.On("ImportRelease", mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authSvc := new(svc.AuthorizationService)
+			projectSvc := new(svc.ProjectService)
+			settingsSvc := new(svc.SettingsService)
+			releaseRepo := new(repo.ReleaseRepository)
+			slackClient := new(slack.Client)
+			githubClient := new(github.Client)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
+
+			tc.mockSetup(authSvc, settingsSvc, projectSvc, githubClient, releaseRepo)
+
+			summary, err := service.ImportGithubReleases(context.TODO(), id.Project{}, id.AuthUser{})
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.wantSummary, summary)
+			}
+
+			authSvc.AssertExpectations(t)
+			settingsSvc.AssertExpectations(t)
+			projectSvc.AssertExpectations(t)
+			githubClient.AssertExpectations(t)
+			releaseRepo.AssertExpectations(t)
+		})
+	}
+}
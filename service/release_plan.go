@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"release-manager/pkg/id"
+	svcerrors "release-manager/service/errors"
+	"release-manager/service/model"
+)
+
+func (s *ReleaseService) CreateReleasePlan(
+	ctx context.Context,
+	input model.CreateReleasePlanInput,
+	projectID id.Project,
+	authUserID id.AuthUser,
+) (model.ReleasePlan, error) {
+	if err := s.authGuard.AuthorizeProjectRoleEditor(ctx, projectID, authUserID); err != nil {
+		return model.ReleasePlan{}, fmt.Errorf("authorizing project member: %w", err)
+	}
+
+	p, err := model.NewReleasePlan(input, projectID, authUserID)
+	if err != nil {
+		return model.ReleasePlan{}, svcerrors.NewReleasePlanInvalidError().Wrap(err).WithMessage(err.Error())
+	}
+
+	if err := s.validateReleasePlanOwner(ctx, projectID, p.OwnerUserID, authUserID); err != nil {
+		return model.ReleasePlan{}, err
+	}
+
+	if err := s.repo.CreateReleasePlan(ctx, p); err != nil {
+		return model.ReleasePlan{}, fmt.Errorf("creating release plan: %w", err)
+	}
+
+	return p, nil
+}
+
+func (s *ReleaseService) GetReleasePlan(
+	ctx context.Context,
+	projectID id.Project,
+	planID id.ReleasePlan,
+	authUserID id.AuthUser,
+) (model.ReleasePlan, error) {
+	if err := s.authGuard.AuthorizeProjectRoleViewer(ctx, projectID, authUserID); err != nil {
+		return model.ReleasePlan{}, fmt.Errorf("authorizing project member: %w", err)
+	}
+
+	p, err := s.repo.ReadReleasePlan(ctx, projectID, planID)
+	if err != nil {
+		return model.ReleasePlan{}, fmt.Errorf("reading release plan: %w", err)
+	}
+
+	return p, nil
+}
+
+func (s *ReleaseService) ListReleasePlansForProject(
+	ctx context.Context,
+	params model.ListReleasePlansFilterParams,
+	projectID id.Project,
+	authUserID id.AuthUser,
+) ([]model.ReleasePlan, error) {
+	if err := s.authGuard.AuthorizeProjectRoleViewer(ctx, projectID, authUserID); err != nil {
+		return nil, fmt.Errorf("authorizing project member: %w", err)
+	}
+
+	if err := params.Validate(); err != nil {
+		return nil, svcerrors.NewReleasePlanInvalidError().Wrap(err).WithMessage(err.Error())
+	}
+
+	plans, err := s.repo.ListReleasePlansForProject(ctx, params, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("listing release plans: %w", err)
+	}
+
+	return plans, nil
+}
+
+func (s *ReleaseService) UpdateReleasePlan(
+	ctx context.Context,
+	input model.UpdateReleasePlanInput,
+	projectID id.Project,
+	planID id.ReleasePlan,
+	authUserID id.AuthUser,
+) error {
+	if err := s.authGuard.AuthorizeProjectRoleEditor(ctx, projectID, authUserID); err != nil {
+		return fmt.Errorf("authorizing project member: %w", err)
+	}
+
+	if !input.UnsetOwner {
+		if err := s.validateReleasePlanOwner(ctx, projectID, input.OwnerUserID, authUserID); err != nil {
+			return err
+		}
+	}
+
+	if err := s.repo.UpdateReleasePlan(ctx, projectID, planID, func(p model.ReleasePlan) (model.ReleasePlan, error) {
+		if err := p.Update(input); err != nil {
+			return model.ReleasePlan{}, svcerrors.NewReleasePlanInvalidError().Wrap(err).WithMessage(err.Error())
+		}
+
+		return p, nil
+	}); err != nil {
+		return fmt.Errorf("updating release plan: %w", err)
+	}
+
+	return nil
+}
+
+func (s *ReleaseService) DeleteReleasePlan(
+	ctx context.Context,
+	projectID id.Project,
+	planID id.ReleasePlan,
+	authUserID id.AuthUser,
+) error {
+	if err := s.authGuard.AuthorizeProjectRoleEditor(ctx, projectID, authUserID); err != nil {
+		return fmt.Errorf("authorizing project member: %w", err)
+	}
+
+	if err := s.repo.DeleteReleasePlan(ctx, projectID, planID); err != nil {
+		return fmt.Errorf("deleting release plan: %w", err)
+	}
+
+	return nil
+}
+
+// ConvertReleasePlan creates a release from the plan once the git tag of the release exists.
+// The plan is kept and linked to the created release.
+func (s *ReleaseService) ConvertReleasePlan(
+	ctx context.Context,
+	input model.ConvertReleasePlanInput,
+	projectID id.Project,
+	planID id.ReleasePlan,
+	authUserID id.AuthUser,
+) (model.Release, error) {
+	if err := s.authGuard.AuthorizeProjectRoleEditor(ctx, projectID, authUserID); err != nil {
+		return model.Release{}, fmt.Errorf("authorizing project member: %w", err)
+	}
+
+	project, tag, err := s.readGitTag(ctx, projectID, input.GitTagName, authUserID)
+	if err != nil {
+		return model.Release{}, err
+	}
+
+	var rls model.Release
+	if err := s.repo.ConvertReleasePlan(ctx, projectID, planID, func(p model.ReleasePlan) (model.ReleasePlan, model.Release, error) {
+		rls, err = p.NewRelease(input, tag, authUserID)
+		if err != nil {
+			return model.ReleasePlan{}, model.Release{}, svcerrors.NewReleasePlanInvalidError().Wrap(err).WithMessage(err.Error())
+		}
+
+		rls.SetVersion(project)
+		rls.SetNotesTemplate(project)
+		if err := rls.SetGithubOptions(model.GithubReleaseOptionsInput{}); err != nil {
+			return model.ReleasePlan{}, model.Release{}, svcerrors.NewReleasePlanInvalidError().Wrap(err).WithMessage(err.Error())
+		}
+		if err := s.validateReleaseVersion(ctx, rls); err != nil {
+			return model.ReleasePlan{}, model.Release{}, err
+		}
+
+		return p, rls, nil
+	}); err != nil {
+		return model.Release{}, fmt.Errorf("converting release plan: %w", err)
+	}
+
+	return rls, nil
+}
+
+// validateReleasePlanOwner checks that the owner of the plan (if set) is a member of the project.
+func (s *ReleaseService) validateReleasePlanOwner(ctx context.Context, projectID id.Project, ownerUserID *id.User, authUserID id.AuthUser) error {
+	if ownerUserID == nil {
+		return nil
+	}
+
+	if _, err := s.memberGetter.GetMember(ctx, projectID, *ownerUserID, authUserID); err != nil {
+		if svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeProjectMemberNotFound) {
+			return svcerrors.NewReleasePlanInvalidError().Wrap(err).WithMessage("Release plan owner must be a project member")
+		}
+
+		return fmt.Errorf("getting release plan owner: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,444 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	bitbucket "release-manager/bitbucket/mock"
+	github "release-manager/github/mock"
+	gitlab "release-manager/gitlab/mock"
+	"release-manager/pkg/id"
+	"release-manager/pkg/pointer"
+	repo "release-manager/repository/mock"
+	resend "release-manager/resend/mock"
+	svcerrors "release-manager/service/errors"
+	svc "release-manager/service/mock"
+	"release-manager/service/model"
+	slack "release-manager/slack/mock"
+	storage "release-manager/storage/mock"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReleaseService_CreateReleasePlan(t *testing.T) {
+	ownerID := id.User(uuid.New())
+
+	testCases := []struct {
+		name      string
+		input     model.CreateReleasePlanInput
+		mockSetup func(*svc.AuthorizationService, *svc.ProjectService, *repo.ReleaseRepository)
+		wantErr   bool
+	}{
+		{
+			name: "Create release plan",
+			input: model.CreateReleasePlanInput{
+				Title:       "Release 1.2",
+				TargetDate:  time.Now(),
+				OwnerUserID: &ownerID,
+				ScopeItems:  []model.ReleasePlanScopeItem{{Title: "Dark mode"}},
+			},
+			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				projectSvc.On("GetMember", mock.Anything, mock.Anything, ownerID, mock.Anything).Return(model.ProjectMember{}, nil)
+				releaseRepo.On("CreateReleasePlan", mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Create release plan without owner",
+			input: model.CreateReleasePlanInput{
+				Title:      "Release 1.2",
+				TargetDate: time.Now(),
+			},
+			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("CreateReleasePlan", mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Owner is not a project member",
+			input: model.CreateReleasePlanInput{
+				Title:       "Release 1.2",
+				TargetDate:  time.Now(),
+				OwnerUserID: &ownerID,
+			},
+			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				projectSvc.On("GetMember", mock.Anything, mock.Anything, ownerID, mock.Anything).Return(model.ProjectMember{}, svcerrors.NewProjectMemberNotFoundError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "Missing target date",
+			input: model.CreateReleasePlanInput{
+				Title: "Release 1.2",
+			},
+			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Unauthorized",
+			input: model.CreateReleasePlanInput{
+				Title:      "Release 1.2",
+				TargetDate: time.Now(),
+			},
+			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewInsufficientProjectRoleError())
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authSvc := new(svc.AuthorizationService)
+			projectSvc := new(svc.ProjectService)
+			settingsSvc := new(svc.SettingsService)
+			releaseRepo := new(repo.ReleaseRepository)
+			slackClient := new(slack.Client)
+			githubClient := new(github.Client)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
+
+			tc.mockSetup(authSvc, projectSvc, releaseRepo)
+
+			_, err := service.CreateReleasePlan(context.Background(), tc.input, id.NewProject(), id.AuthUser{})
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			authSvc.AssertExpectations(t)
+			projectSvc.AssertExpectations(t)
+			releaseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReleaseService_ListReleasePlansForProject(t *testing.T) {
+	from := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 11, 30, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		params    model.ListReleasePlansFilterParams
+		mockSetup func(*svc.AuthorizationService, *repo.ReleaseRepository)
+		wantErr   bool
+	}{
+		{
+			name:   "List release plans",
+			params: model.ListReleasePlansFilterParams{TargetDateFrom: &from, TargetDateTo: &to},
+			mockSetup: func(auth *svc.AuthorizationService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ListReleasePlansForProject", mock.Anything, mock.Anything, mock.Anything).Return([]model.ReleasePlan{}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:   "Invalid date range",
+			params: model.ListReleasePlansFilterParams{TargetDateFrom: &to, TargetDateTo: &from},
+			mockSetup: func(auth *svc.AuthorizationService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:   "Unauthorized",
+			params: model.ListReleasePlansFilterParams{},
+			mockSetup: func(auth *svc.AuthorizationService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewProjectNotFoundError())
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authSvc := new(svc.AuthorizationService)
+			projectSvc := new(svc.ProjectService)
+			settingsSvc := new(svc.SettingsService)
+			releaseRepo := new(repo.ReleaseRepository)
+			slackClient := new(slack.Client)
+			githubClient := new(github.Client)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
+
+			tc.mockSetup(authSvc, releaseRepo)
+
+			_, err := service.ListReleasePlansForProject(context.Background(), tc.params, id.NewProject(), id.AuthUser{})
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			authSvc.AssertExpectations(t)
+			releaseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReleaseService_UpdateReleasePlan(t *testing.T) {
+	ownerID := id.User(uuid.New())
+
+	testCases := []struct {
+		name      string
+		input     model.UpdateReleasePlanInput
+		mockSetup func(*svc.AuthorizationService, *svc.ProjectService, *repo.ReleaseRepository)
+		wantErr   bool
+	}{
+		{
+			name: "Update release plan",
+			input: model.UpdateReleasePlanInput{
+				Title:       pointer.StringPtr("Release 1.3"),
+				OwnerUserID: &ownerID,
+			},
+			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				projectSvc.On("GetMember", mock.Anything, mock.Anything, ownerID, mock.Anything).Return(model.ProjectMember{}, nil)
+				releaseRepo.On("UpdateReleasePlan", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Unset owner",
+			input: model.UpdateReleasePlanInput{
+				OwnerUserID: &ownerID,
+				UnsetOwner:  true,
+			},
+			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("UpdateReleasePlan", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Owner is not a project member",
+			input: model.UpdateReleasePlanInput{
+				OwnerUserID: &ownerID,
+			},
+			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				projectSvc.On("GetMember", mock.Anything, mock.Anything, ownerID, mock.Anything).Return(model.ProjectMember{}, svcerrors.NewProjectMemberNotFoundError())
+			},
+			wantErr: true,
+		},
+		{
+			name:  "Non existing release plan",
+			input: model.UpdateReleasePlanInput{},
+			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("UpdateReleasePlan", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewReleasePlanNotFoundError())
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authSvc := new(svc.AuthorizationService)
+			projectSvc := new(svc.ProjectService)
+			settingsSvc := new(svc.SettingsService)
+			releaseRepo := new(repo.ReleaseRepository)
+			slackClient := new(slack.Client)
+			githubClient := new(github.Client)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
+
+			tc.mockSetup(authSvc, projectSvc, releaseRepo)
+
+			err := service.UpdateReleasePlan(context.Background(), tc.input, id.NewProject(), id.NewReleasePlan(), id.AuthUser{})
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			authSvc.AssertExpectations(t)
+			projectSvc.AssertExpectations(t)
+			releaseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReleaseService_DeleteReleasePlan(t *testing.T) {
+	testCases := []struct {
+		name      string
+		mockSetup func(*svc.AuthorizationService, *repo.ReleaseRepository)
+		wantErr   bool
+	}{
+		{
+			name: "Delete release plan",
+			mockSetup: func(auth *svc.AuthorizationService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("DeleteReleasePlan", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Non existing release plan",
+			mockSetup: func(auth *svc.AuthorizationService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("DeleteReleasePlan", mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewReleasePlanNotFoundError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "Insufficient role",
+			mockSetup: func(auth *svc.AuthorizationService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewInsufficientProjectRoleError())
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authSvc := new(svc.AuthorizationService)
+			projectSvc := new(svc.ProjectService)
+			settingsSvc := new(svc.SettingsService)
+			releaseRepo := new(repo.ReleaseRepository)
+			slackClient := new(slack.Client)
+			githubClient := new(github.Client)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
+
+			tc.mockSetup(authSvc, releaseRepo)
+
+			err := service.DeleteReleasePlan(context.Background(), id.NewProject(), id.NewReleasePlan(), id.AuthUser{})
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			authSvc.AssertExpectations(t)
+			releaseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReleaseService_ConvertReleasePlan(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     model.ConvertReleasePlanInput
+		mockSetup func(*svc.AuthorizationService, *svc.SettingsService, *svc.ProjectService, *github.Client, *repo.ReleaseRepository)
+		wantErr   bool
+	}{
+		{
+			name:  "Convert release plan",
+			input: model.ConvertReleasePlanInput{GitTagName: "v1.2.0"},
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
+					GithubRepo: &model.GithubRepo{
+						OwnerSlug: "owner",
+						RepoSlug:  "repo",
+					},
+				}, nil)
+				github.On("ReadTag", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.GitTag{Name: "v1.2.0"}, nil)
+				releaseRepo.On("ConvertReleasePlan", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:  "Git tag not found",
+			input: model.ConvertReleasePlanInput{GitTagName: "v1.2.0"},
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
+					GithubRepo: &model.GithubRepo{
+						OwnerSlug: "owner",
+						RepoSlug:  "repo",
+					},
+				}, nil)
+				github.On("ReadTag", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.GitTag{}, svcerrors.NewGitTagNotFoundError())
+			},
+			wantErr: true,
+		},
+		{
+			name:  "Github integration not enabled",
+			input: model.ConvertReleasePlanInput{GitTagName: "v1.2.0"},
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{GithubRepo: &model.GithubRepo{OwnerSlug: "owner", RepoSlug: "repo"}}, nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken(""), svcerrors.NewGithubIntegrationNotEnabledError())
+			},
+			wantErr: true,
+		},
+		{
+			name:  "Release plan already converted",
+			input: model.ConvertReleasePlanInput{GitTagName: "v1.2.0"},
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
+					GithubRepo: &model.GithubRepo{
+						OwnerSlug: "owner",
+						RepoSlug:  "repo",
+					},
+				}, nil)
+				github.On("ReadTag", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.GitTag{Name: "v1.2.0"}, nil)
+				releaseRepo.On("ConvertReleasePlan", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewReleasePlanInvalidError())
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authSvc := new(svc.AuthorizationService)
+			projectSvc := new(svc.ProjectService)
+			settingsSvc := new(svc.SettingsService)
+			releaseRepo := new(repo.ReleaseRepository)
+			slackClient := new(slack.Client)
+			githubClient := new(github.Client)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
+
+			tc.mockSetup(authSvc, settingsSvc, projectSvc, githubClient, releaseRepo)
+
+			_, err := service.ConvertReleasePlan(context.Background(), tc.input, id.NewProject(), id.NewReleasePlan(), id.AuthUser{})
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			authSvc.AssertExpectations(t)
+			projectSvc.AssertExpectations(t)
+			settingsSvc.AssertExpectations(t)
+			githubClient.AssertExpectations(t)
+			releaseRepo.AssertExpectations(t)
+		})
+	}
+}
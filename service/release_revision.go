@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"release-manager/pkg/id"
+	svcerrors "release-manager/service/errors"
+	"release-manager/service/model"
+)
+
+func (s *ReleaseService) ListReleaseRevisions(
+	ctx context.Context,
+	releaseID id.Release,
+	authUserID id.AuthUser,
+) ([]model.ReleaseRevision, error) {
+	if err := s.authGuard.AuthorizeReleaseViewer(ctx, releaseID, authUserID); err != nil {
+		return nil, fmt.Errorf("authorizing release viewer: %w", err)
+	}
+
+	revisions, err := s.repo.ListReleaseRevisions(ctx, releaseID)
+	if err != nil {
+		return nil, fmt.Errorf("listing release revisions: %w", err)
+	}
+
+	return revisions, nil
+}
+
+// DiffReleaseRevisions compares the title and notes of the release after two revisions.
+func (s *ReleaseService) DiffReleaseRevisions(
+	ctx context.Context,
+	params model.DiffReleaseRevisionsParams,
+	releaseID id.Release,
+	authUserID id.AuthUser,
+) (model.ReleaseRevisionDiff, error) {
+	if err := s.authGuard.AuthorizeReleaseViewer(ctx, releaseID, authUserID); err != nil {
+		return model.ReleaseRevisionDiff{}, fmt.Errorf("authorizing release viewer: %w", err)
+	}
+
+	if err := params.Validate(); err != nil {
+		return model.ReleaseRevisionDiff{}, svcerrors.NewReleaseInvalidError().Wrap(err).WithMessage(err.Error())
+	}
+
+	to, err := s.repo.ReadReleaseRevision(ctx, releaseID, params.ToNumber)
+	if err != nil {
+		return model.ReleaseRevisionDiff{}, fmt.Errorf("reading release revision: %w", err)
+	}
+
+	from, err := s.getReleaseContentAfterRevision(ctx, releaseID, params.FromNumber)
+	if err != nil {
+		return model.ReleaseRevisionDiff{}, err
+	}
+
+	return model.NewReleaseRevisionDiff(params.FromNumber, from, to), nil
+}
+
+// RestoreReleaseRevision sets the title and notes of the release to their state after the revision.
+// Restoring creates a new revision, so it can be reverted as well.
+func (s *ReleaseService) RestoreReleaseRevision(
+	ctx context.Context,
+	releaseID id.Release,
+	revisionNumber int,
+	authUserID id.AuthUser,
+) error {
+	if err := s.authGuard.AuthorizeReleaseEditor(ctx, releaseID, authUserID); err != nil {
+		return fmt.Errorf("authorizing release editor: %w", err)
+	}
+
+	rev, err := s.repo.ReadReleaseRevision(ctx, releaseID, revisionNumber)
+	if err != nil {
+		return fmt.Errorf("reading release revision: %w", err)
+	}
+
+	if err := s.repo.UpdateRelease(ctx, releaseID, func(rls model.Release) (model.Release, *model.ReleaseRevision, error) {
+		before := rls
+		if err := rls.Update(rev.Restore()); err != nil {
+			return model.Release{}, nil, svcerrors.NewReleaseInvalidError().Wrap(err).WithMessage(err.Error())
+		}
+
+		return rls, model.NewReleaseRevision(before, rls, authUserID), nil
+	}); err != nil {
+		return fmt.Errorf("restoring release revision: %w", err)
+	}
+
+	return nil
+}
+
+// getReleaseContentAfterRevision returns the content before the first revision if the revision number is 0.
+func (s *ReleaseService) getReleaseContentAfterRevision(ctx context.Context, releaseID id.Release, revisionNumber int) (model.ReleaseContent, error) {
+	if revisionNumber == 0 {
+		first, err := s.repo.ReadReleaseRevision(ctx, releaseID, 1)
+		if err != nil {
+			return model.ReleaseContent{}, fmt.Errorf("reading first release revision: %w", err)
+		}
+
+		return first.Before, nil
+	}
+
+	rev, err := s.repo.ReadReleaseRevision(ctx, releaseID, revisionNumber)
+	if err != nil {
+		return model.ReleaseContent{}, fmt.Errorf("reading release revision: %w", err)
+	}
+
+	return rev.After, nil
+}
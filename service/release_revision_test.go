@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	bitbucket "release-manager/bitbucket/mock"
+	github "release-manager/github/mock"
+	gitlab "release-manager/gitlab/mock"
+	"release-manager/pkg/id"
+	repo "release-manager/repository/mock"
+	resend "release-manager/resend/mock"
+	svcerrors "release-manager/service/errors"
+	svc "release-manager/service/mock"
+	"release-manager/service/model"
+	slack "release-manager/slack/mock"
+	storage "release-manager/storage/mock"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReleaseService_ListReleaseRevisions(t *testing.T) {
+	testCases := []struct {
+		name      string
+		mockSetup func(*svc.AuthorizationService, *repo.ReleaseRepository)
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			mockSetup: func(auth *svc.AuthorizationService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ListReleaseRevisions", mock.Anything, mock.Anything).Return([]model.ReleaseRevision{{Number: 2}, {Number: 1}}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Unauthorized",
+			mockSetup: func(auth *svc.AuthorizationService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseViewer", mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewInsufficientProjectRoleError())
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authSvc := new(svc.AuthorizationService)
+			projectSvc := new(svc.ProjectService)
+			settingsSvc := new(svc.SettingsService)
+			releaseRepo := new(repo.ReleaseRepository)
+			slackClient := new(slack.Client)
+			githubClient := new(github.Client)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
+
+			tc.mockSetup(authSvc, releaseRepo)
+
+			revisions, err := service.ListReleaseRevisions(context.Background(), id.NewRelease(), id.AuthUser{})
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, revisions, 2)
+			}
+
+			authSvc.AssertExpectations(t)
+			releaseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReleaseService_DiffReleaseRevisions(t *testing.T) {
+	first := model.ReleaseRevision{
+		Number: 1,
+		Before: model.ReleaseContent{ReleaseTitle: "Release", ReleaseNotes: "## Features"},
+		After:  model.ReleaseContent{ReleaseTitle: "Release", ReleaseNotes: "## Features\n- login"},
+	}
+	second := model.ReleaseRevision{
+		Number: 2,
+		Before: first.After,
+		After:  model.ReleaseContent{ReleaseTitle: "Release 1.0", ReleaseNotes: "## Features\n- login\n- logout"},
+	}
+
+	testCases := []struct {
+		name      string
+		params    model.DiffReleaseRevisionsParams
+		mockSetup func(*svc.AuthorizationService, *repo.ReleaseRepository)
+		want      model.ReleaseRevisionDiff
+		wantErr   bool
+	}{
+		{
+			name:   "Diff two revisions",
+			params: model.DiffReleaseRevisionsParams{FromNumber: 1, ToNumber: 2},
+			mockSetup: func(auth *svc.AuthorizationService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadReleaseRevision", mock.Anything, mock.Anything, 2).Return(second, nil)
+				releaseRepo.On("ReadReleaseRevision", mock.Anything, mock.Anything, 1).Return(first, nil)
+			},
+			want: model.ReleaseRevisionDiff{
+				From:         first.After,
+				To:           second.After,
+				FromNumber:   1,
+				ToNumber:     2,
+				TitleChanged: true,
+				NotesDiff: []model.DiffLine{
+					{Kind: model.DiffLineKindEqual, Text: "## Features"},
+					{Kind: model.DiffLineKindEqual, Text: "- login"},
+					{Kind: model.DiffLineKindAdded, Text: "- logout"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:   "Diff with content before the first revision",
+			params: model.DiffReleaseRevisionsParams{FromNumber: 0, ToNumber: 1},
+			mockSetup: func(auth *svc.AuthorizationService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadReleaseRevision", mock.Anything, mock.Anything, 1).Return(first, nil)
+			},
+			want: model.ReleaseRevisionDiff{
+				From:         first.Before,
+				To:           first.After,
+				FromNumber:   0,
+				ToNumber:     1,
+				TitleChanged: false,
+				NotesDiff: []model.DiffLine{
+					{Kind: model.DiffLineKindEqual, Text: "## Features"},
+					{Kind: model.DiffLineKindAdded, Text: "- login"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:   "Invalid params",
+			params: model.DiffReleaseRevisionsParams{FromNumber: 1, ToNumber: 1},
+			mockSetup: func(auth *svc.AuthorizationService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:   "Revision not found",
+			params: model.DiffReleaseRevisionsParams{FromNumber: 1, ToNumber: 5},
+			mockSetup: func(auth *svc.AuthorizationService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadReleaseRevision", mock.Anything, mock.Anything, 5).Return(model.ReleaseRevision{}, svcerrors.NewReleaseRevisionNotFoundError())
+			},
+			wantErr: true,
+		},
+		{
+			name:   "Unauthorized",
+			params: model.DiffReleaseRevisionsParams{FromNumber: 1, ToNumber: 2},
+			mockSetup: func(auth *svc.AuthorizationService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseViewer", mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewInsufficientProjectRoleError())
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authSvc := new(svc.AuthorizationService)
+			projectSvc := new(svc.ProjectService)
+			settingsSvc := new(svc.SettingsService)
+			releaseRepo := new(repo.ReleaseRepository)
+			slackClient := new(slack.Client)
+			githubClient := new(github.Client)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
+
+			tc.mockSetup(authSvc, releaseRepo)
+
+			diff, err := service.DiffReleaseRevisions(context.Background(), tc.params, id.NewRelease(), id.AuthUser{})
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.want, diff)
+			}
+
+			authSvc.AssertExpectations(t)
+			releaseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReleaseService_RestoreReleaseRevision(t *testing.T) {
+	testCases := []struct {
+		name      string
+		mockSetup func(*svc.AuthorizationService, *repo.ReleaseRepository)
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			mockSetup: func(auth *svc.AuthorizationService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadReleaseRevision", mock.Anything, mock.Anything, 1).Return(model.ReleaseRevision{
+					Number: 1,
+					After:  model.ReleaseContent{ReleaseTitle: "Release", ReleaseNotes: "Notes"},
+				}, nil)
+				releaseRepo.On("UpdateRelease", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Revision not found",
+			mockSetup: func(auth *svc.AuthorizationService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadReleaseRevision", mock.Anything, mock.Anything, 1).Return(model.ReleaseRevision{}, svcerrors.NewReleaseRevisionNotFoundError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "Unauthorized",
+			mockSetup: func(auth *svc.AuthorizationService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewInsufficientProjectRoleError())
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authSvc := new(svc.AuthorizationService)
+			projectSvc := new(svc.ProjectService)
+			settingsSvc := new(svc.SettingsService)
+			releaseRepo := new(repo.ReleaseRepository)
+			slackClient := new(slack.Client)
+			githubClient := new(github.Client)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
+
+			tc.mockSetup(authSvc, releaseRepo)
+
+			err := service.RestoreReleaseRevision(context.Background(), id.NewRelease(), 1, id.AuthUser{})
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			authSvc.AssertExpectations(t)
+			releaseRepo.AssertExpectations(t)
+		})
+	}
+}
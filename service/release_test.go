@@ -2,16 +2,27 @@ package service
 
 import (
 	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
 	"testing"
+	"testing/iotest"
+	"time"
 
+	bitbucket "release-manager/bitbucket/mock"
 	github "release-manager/github/mock"
+	gitlab "release-manager/gitlab/mock"
 	"release-manager/pkg/id"
 	"release-manager/pkg/pointer"
 	repo "release-manager/repository/mock"
+	resend "release-manager/resend/mock"
 	svcerrors "release-manager/service/errors"
 	svc "release-manager/service/mock"
 	"release-manager/service/model"
 	slack "release-manager/slack/mock"
+	storage "release-manager/storage/mock"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -46,6 +57,78 @@ func TestReleaseService_CreateRelease(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Create release with greater version than last published release",
+			release: model.CreateReleaseInput{
+				ReleaseTitle: "Test release",
+				ReleaseNotes: "Test release notes",
+				GitTagName:   "v1.1.0",
+			},
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
+					GithubRepo: &model.GithubRepo{
+						OwnerSlug: "owner",
+						RepoSlug:  "repo",
+					},
+					VersionTagPrefix: "v",
+				}, nil)
+				github.On("ReadTag", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.GitTag{Name: "v1.1.0"}, nil)
+				releaseRepo.On("ReadLastPublishedRelease", mock.Anything, mock.Anything).Return(model.Release{
+					Version: &model.Version{Major: 1},
+				}, nil)
+				releaseRepo.On("CreateRelease", mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Create first release with version",
+			release: model.CreateReleaseInput{
+				ReleaseTitle: "Test release",
+				ReleaseNotes: "Test release notes",
+				GitTagName:   "v1.0.0",
+			},
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
+					GithubRepo: &model.GithubRepo{
+						OwnerSlug: "owner",
+						RepoSlug:  "repo",
+					},
+					VersionTagPrefix: "v",
+				}, nil)
+				github.On("ReadTag", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.GitTag{Name: "v1.0.0"}, nil)
+				releaseRepo.On("ReadLastPublishedRelease", mock.Anything, mock.Anything).Return(model.Release{}, svcerrors.NewReleaseNotFoundError())
+				releaseRepo.On("CreateRelease", mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Version not greater than last published release",
+			release: model.CreateReleaseInput{
+				ReleaseTitle: "Test release",
+				ReleaseNotes: "Test release notes",
+				GitTagName:   "v1.0.0",
+			},
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
+					GithubRepo: &model.GithubRepo{
+						OwnerSlug: "owner",
+						RepoSlug:  "repo",
+					},
+					VersionTagPrefix: "v",
+				}, nil)
+				github.On("ReadTag", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.GitTag{Name: "v1.0.0"}, nil)
+				releaseRepo.On("ReadLastPublishedRelease", mock.Anything, mock.Anything).Return(model.Release{
+					Version: &model.Version{Major: 1},
+				}, nil)
+			},
+			wantErr: true,
+		},
 		{
 			name: "Github integration not enabled",
 			release: model.CreateReleaseInput{
@@ -55,6 +138,7 @@ func TestReleaseService_CreateRelease(t *testing.T) {
 			},
 			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
 				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{GithubRepo: &model.GithubRepo{OwnerSlug: "owner", RepoSlug: "repo"}}, nil)
 				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken(""), svcerrors.NewGithubIntegrationNotEnabledError())
 			},
 			wantErr: true,
@@ -64,9 +148,11 @@ func TestReleaseService_CreateRelease(t *testing.T) {
 			release: model.CreateReleaseInput{
 				ReleaseTitle: "Test release",
 				ReleaseNotes: "Test release notes",
+				GitTagName:   "v1.0.0",
 			},
 			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
 				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{GithubRepo: &model.GithubRepo{OwnerSlug: "owner", RepoSlug: "repo"}}, nil)
 				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken(""), svcerrors.NewGithubIntegrationNotEnabledError())
 			},
 			wantErr: true,
@@ -80,7 +166,6 @@ func TestReleaseService_CreateRelease(t *testing.T) {
 			},
 			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
 				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
 				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{}, nil)
 			},
 			wantErr: true,
@@ -106,15 +191,155 @@ func TestReleaseService_CreateRelease(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "Project not found",
+			name: "Create release with a new tag from a branch",
 			release: model.CreateReleaseInput{
 				ReleaseTitle: "Test release",
 				ReleaseNotes: "Test release notes",
 				GitTagName:   "v1.0.0",
+				GitTagTarget: pointer.StringPtr("main"),
+			},
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
+					GithubRepo: &model.GithubRepo{
+						OwnerSlug: "owner",
+						RepoSlug:  "repo",
+					},
+				}, nil)
+				github.On("CreateTag", mock.Anything, mock.Anything, mock.Anything, "v1.0.0", "main", "Test release").Return(model.GitTag{Name: "v1.0.0"}, nil)
+				releaseRepo.On("CreateRelease", mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "New tag already exists",
+			release: model.CreateReleaseInput{
+				ReleaseTitle: "Test release",
+				GitTagName:   "v1.0.0",
+				GitTagTarget: pointer.StringPtr("3f786850e387550fdab836ed7e6dc881de23001b"),
+			},
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
+					GithubRepo: &model.GithubRepo{
+						OwnerSlug: "owner",
+						RepoSlug:  "repo",
+					},
+				}, nil)
+				github.On("CreateTag", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.GitTag{}, svcerrors.NewGitTagAlreadyExistsError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "New tag is deleted when release cannot be created",
+			release: model.CreateReleaseInput{
+				ReleaseTitle: "Test release",
+				GitTagName:   "v1.0.0",
+				GitTagTarget: pointer.StringPtr("main"),
+			},
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
+					GithubRepo: &model.GithubRepo{
+						OwnerSlug: "owner",
+						RepoSlug:  "repo",
+					},
+				}, nil)
+				github.On("CreateTag", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.GitTag{Name: "v1.0.0"}, nil)
+				releaseRepo.On("CreateRelease", mock.Anything, mock.Anything).Return(errors.New("db error"))
+				github.On("DeleteTag", mock.Anything, mock.Anything, mock.Anything, "v1.0.0").Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "New tag is kept when it is already used by a release",
+			release: model.CreateReleaseInput{
+				ReleaseTitle: "Test release",
+				GitTagName:   "v1.0.0",
+				GitTagTarget: pointer.StringPtr("main"),
+			},
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
+					GithubRepo: &model.GithubRepo{
+						OwnerSlug: "owner",
+						RepoSlug:  "repo",
+					},
+				}, nil)
+				github.On("CreateTag", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.GitTag{Name: "v1.0.0"}, nil)
+				releaseRepo.On("CreateRelease", mock.Anything, mock.Anything).Return(svcerrors.NewReleaseGitTagAlreadyUsedError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "Empty tag target",
+			release: model.CreateReleaseInput{
+				ReleaseTitle: "Test release",
+				GitTagName:   "v1.0.0",
+				GitTagTarget: pointer.StringPtr(" "),
+			},
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Git tag of another project sharing the repo",
+			release: model.CreateReleaseInput{
+				ReleaseTitle: "Test release",
+				ReleaseNotes: "Test release notes",
+				GitTagName:   "web/v3.0.0",
+			},
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
+					GithubRepo: &model.GithubRepo{
+						OwnerSlug: "owner",
+						RepoSlug:  "repo",
+					},
+					VersionTagPrefix: "api/v",
+				}, nil)
+				projectSvc.On("IsGitRepoShared", mock.Anything, mock.Anything).Return(true, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Git tag without version tag prefix in repo which is not shared",
+			release: model.CreateReleaseInput{
+				ReleaseTitle: "Test release",
+				ReleaseNotes: "Test release notes",
+				GitTagName:   "release-1",
 			},
 			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
 				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
+					GithubRepo: &model.GithubRepo{
+						OwnerSlug: "owner",
+						RepoSlug:  "repo",
+					},
+					VersionTagPrefix: "v",
+				}, nil)
+				projectSvc.On("IsGitRepoShared", mock.Anything, mock.Anything).Return(false, nil)
+				github.On("ReadTag", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.GitTag{Name: "release-1"}, nil)
+				releaseRepo.On("CreateRelease", mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Project not found",
+			release: model.CreateReleaseInput{
+				ReleaseTitle: "Test release",
+				ReleaseNotes: "Test release notes",
+				GitTagName:   "v1.0.0",
+			},
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{}, svcerrors.NewProjectNotFoundError())
 			},
 			wantErr: true,
@@ -129,7 +354,11 @@ func TestReleaseService_CreateRelease(t *testing.T) {
 			releaseRepo := new(repo.ReleaseRepository)
 			slackClient := new(slack.Client)
 			githubClient := new(github.Client)
-			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, slackClient, githubClient, releaseRepo)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
 
 			tc.mockSetup(authSvc, settingsSvc, projectSvc, githubClient, releaseRepo)
 
@@ -145,6 +374,7 @@ func TestReleaseService_CreateRelease(t *testing.T) {
 			projectSvc.AssertExpectations(t)
 			settingsSvc.AssertExpectations(t)
 			slackClient.AssertExpectations(t)
+			githubClient.AssertExpectations(t)
 			releaseRepo.AssertExpectations(t)
 		})
 	}
@@ -181,7 +411,11 @@ func TestReleaseService_GetRelease(t *testing.T) {
 			releaseRepo := new(repo.ReleaseRepository)
 			slackClient := new(slack.Client)
 			githubClient := new(github.Client)
-			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, slackClient, githubClient, releaseRepo)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
 
 			tc.mockSetup(authSvc, releaseRepo)
 
@@ -209,7 +443,7 @@ func TestReleaseService_DeleteRelease(t *testing.T) {
 		{
 			name: "Success without deleting github release",
 			deleteReleaseInput: model.DeleteReleaseInput{
-				DeleteGithubRelease: false,
+				DeleteGitRelease: false,
 			},
 			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
 				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
@@ -220,7 +454,7 @@ func TestReleaseService_DeleteRelease(t *testing.T) {
 		{
 			name: "Success with deleting github release",
 			deleteReleaseInput: model.DeleteReleaseInput{
-				DeleteGithubRelease: true,
+				DeleteGitRelease: true,
 			},
 			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
 				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
@@ -240,10 +474,12 @@ func TestReleaseService_DeleteRelease(t *testing.T) {
 		{
 			name: "Github integration not enabled",
 			deleteReleaseInput: model.DeleteReleaseInput{
-				DeleteGithubRelease: true,
+				DeleteGitRelease: true,
 			},
 			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
 				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadRelease", mock.Anything, mock.Anything).Return(model.Release{Status: model.ReleaseStatusPublished}, nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{GithubRepo: &model.GithubRepo{OwnerSlug: "owner", RepoSlug: "repo"}}, nil)
 				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken(""), svcerrors.NewGithubIntegrationNotEnabledError())
 			},
 			wantErr: true,
@@ -251,11 +487,10 @@ func TestReleaseService_DeleteRelease(t *testing.T) {
 		{
 			name: "Repo not set for project",
 			deleteReleaseInput: model.DeleteReleaseInput{
-				DeleteGithubRelease: true,
+				DeleteGitRelease: true,
 			},
 			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
 				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
 				releaseRepo.On("ReadRelease", mock.Anything, mock.Anything).Return(model.Release{}, nil)
 				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{}, nil)
 			},
@@ -264,7 +499,7 @@ func TestReleaseService_DeleteRelease(t *testing.T) {
 		{
 			name: "Success (deleting non-existing github release)",
 			deleteReleaseInput: model.DeleteReleaseInput{
-				DeleteGithubRelease: true,
+				DeleteGitRelease: true,
 			},
 			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
 				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
@@ -291,7 +526,11 @@ func TestReleaseService_DeleteRelease(t *testing.T) {
 			releaseRepo := new(repo.ReleaseRepository)
 			slackClient := new(slack.Client)
 			githubClient := new(github.Client)
-			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, slackClient, githubClient, releaseRepo)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
 
 			tc.mockSetup(authSvc, settingsSvc, projectSvc, githubClient, releaseRepo)
 
@@ -313,8 +552,13 @@ func TestReleaseService_DeleteRelease(t *testing.T) {
 }
 
 func TestReleaseService_ListReleasesForProject(t *testing.T) {
+	createdFrom := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limit := 20
+	tooHighLimit := model.ReleasesPageMaxLimit + 1
+
 	testCases := []struct {
 		name      string
+		params    model.ListReleasesFilterParams
 		mockSetup func(*svc.AuthorizationService, *svc.ProjectService, *repo.ReleaseRepository)
 		wantErr   bool
 	}{
@@ -322,10 +566,10 @@ func TestReleaseService_ListReleasesForProject(t *testing.T) {
 			name: "Success",
 			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
 				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				releaseRepo.On("ListReleasesForProject", mock.Anything, mock.Anything).Return([]model.Release{
+				releaseRepo.On("ListReleasesForProject", mock.Anything, mock.Anything, mock.Anything).Return(model.ReleasePage{Releases: []model.Release{
 					{ID: id.NewRelease()},
 					{ID: id.NewRelease()},
-				}, nil)
+				}}, nil)
 			},
 			wantErr: false,
 		},
@@ -333,10 +577,90 @@ func TestReleaseService_ListReleasesForProject(t *testing.T) {
 			name: "no releases",
 			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
 				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				releaseRepo.On("ListReleasesForProject", mock.Anything, mock.Anything).Return([]model.Release{}, nil)
+				releaseRepo.On("ListReleasesForProject", mock.Anything, mock.Anything, mock.Anything).Return(model.ReleasePage{}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Sorted by version within range",
+			params: model.ListReleasesFilterParams{
+				SortBy:     model.ReleaseSortByVersion,
+				MinVersion: &model.Version{Major: 1},
+				MaxVersion: &model.Version{Major: 2},
+			},
+			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ListReleasesForProject", mock.Anything, mock.Anything, mock.Anything).Return(model.ReleasePage{}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Invalid sort by",
+			params: model.ListReleasesFilterParams{
+				SortBy: "title",
+			},
+			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Invalid version range",
+			params: model.ListReleasesFilterParams{
+				MinVersion: &model.Version{Major: 2},
+				MaxVersion: &model.Version{Major: 1},
+			},
+			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Filtered page after cursor",
+			params: model.ListReleasesFilterParams{
+				SortOrder:    model.ReleaseSortOrderAsc,
+				CreatedFrom:  &createdFrom,
+				GitTagPrefix: pointer.StringPtr("v1."),
+				Limit:        &limit,
+				Cursor: &model.ReleaseCursor{
+					SortBy:    model.ReleaseSortByCreatedAt,
+					SortOrder: model.ReleaseSortOrderAsc,
+					CreatedAt: createdFrom,
+					ReleaseID: id.NewRelease(),
+				},
+			},
+			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ListReleasesForProject", mock.Anything, mock.Anything, mock.Anything).Return(model.ReleasePage{}, nil)
 			},
 			wantErr: false,
 		},
+		{
+			name: "Invalid limit",
+			params: model.ListReleasesFilterParams{
+				Limit: &tooHighLimit,
+			},
+			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Cursor for a different sort",
+			params: model.ListReleasesFilterParams{
+				SortBy: model.ReleaseSortByVersion,
+				Limit:  &limit,
+				Cursor: &model.ReleaseCursor{
+					SortBy:    model.ReleaseSortByCreatedAt,
+					SortOrder: model.ReleaseSortOrderDesc,
+					ReleaseID: id.NewRelease(),
+				},
+			},
+			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -347,11 +671,15 @@ func TestReleaseService_ListReleasesForProject(t *testing.T) {
 			releaseRepo := new(repo.ReleaseRepository)
 			slackClient := new(slack.Client)
 			githubClient := new(github.Client)
-			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, slackClient, githubClient, releaseRepo)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
 
 			tc.mockSetup(authSvc, projectSvc, releaseRepo)
 
-			_, err := service.ListReleasesForProject(context.Background(), id.NewProject(), id.AuthUser{})
+			_, err := service.ListReleasesForProject(context.Background(), tc.params, id.NewProject(), id.AuthUser{})
 
 			if tc.wantErr {
 				assert.Error(t, err)
@@ -366,43 +694,49 @@ func TestReleaseService_ListReleasesForProject(t *testing.T) {
 	}
 }
 
-func TestReleaseService_UpdateRelease(t *testing.T) {
+func TestReleaseService_SearchReleases(t *testing.T) {
+	projectID := id.NewProject()
+	params := model.SearchReleasesParams{Query: "login bug", Limit: model.ReleaseSearchDefaultLimit}
+
 	testCases := []struct {
 		name      string
-		update    model.UpdateReleaseInput
-		mockSetup func(*svc.AuthorizationService, *repo.ReleaseRepository)
+		params    model.SearchReleasesParams
+		mockSetup func(*svc.ProjectService, *repo.ReleaseRepository)
+		wantCount int
 		wantErr   bool
 	}{
 		{
-			name: "Valid release update",
-			update: model.UpdateReleaseInput{
-				ReleaseTitle: pointer.StringPtr("Test release"),
-				ReleaseNotes: pointer.StringPtr("Test release notes"),
-			},
-			mockSetup: func(auth *svc.AuthorizationService, repo *repo.ReleaseRepository) {
-				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				repo.On("UpdateRelease", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			name:   "Success",
+			params: params,
+			mockSetup: func(projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				projectSvc.On("ListProjects", mock.Anything, mock.Anything).Return([]model.Project{{ID: projectID}}, nil)
+				releaseRepo.On("SearchReleases", mock.Anything, params, []id.Project{projectID}).Return([]model.ReleaseSearchHit{
+					{ReleaseID: id.NewRelease(), ProjectID: projectID},
+				}, nil)
 			},
-			wantErr: false,
+			wantCount: 1,
+			wantErr:   false,
 		},
 		{
-			name: "Empty release title",
-			update: model.UpdateReleaseInput{
-				ReleaseTitle: pointer.StringPtr(""),
-				ReleaseNotes: pointer.StringPtr("Test release notes"),
-			},
-			mockSetup: func(auth *svc.AuthorizationService, repo *repo.ReleaseRepository) {
-				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				repo.On("UpdateRelease", mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewReleaseInvalidError())
+			name:   "No accessible projects",
+			params: params,
+			mockSetup: func(projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				projectSvc.On("ListProjects", mock.Anything, mock.Anything).Return([]model.Project{}, nil)
 			},
-			wantErr: true,
+			wantCount: 0,
+			wantErr:   false,
 		},
 		{
-			name:   "Non existing release",
-			update: model.UpdateReleaseInput{},
-			mockSetup: func(auth *svc.AuthorizationService, repo *repo.ReleaseRepository) {
-				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				repo.On("UpdateRelease", mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewReleaseNotFoundError())
+			name:      "Empty query",
+			params:    model.SearchReleasesParams{Limit: model.ReleaseSearchDefaultLimit},
+			mockSetup: func(projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {},
+			wantErr:   true,
+		},
+		{
+			name:   "Listing projects fails",
+			params: params,
+			mockSetup: func(projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				projectSvc.On("ListProjects", mock.Anything, mock.Anything).Return([]model.Project{}, errors.New("db error"))
 			},
 			wantErr: true,
 		},
@@ -416,76 +750,237 @@ func TestReleaseService_UpdateRelease(t *testing.T) {
 			releaseRepo := new(repo.ReleaseRepository)
 			slackClient := new(slack.Client)
 			githubClient := new(github.Client)
-			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, slackClient, githubClient, releaseRepo)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
 
-			tc.mockSetup(authSvc, releaseRepo)
+			tc.mockSetup(projectSvc, releaseRepo)
 
-			err := service.UpdateRelease(context.Background(), tc.update, id.NewRelease(), id.AuthUser{})
+			hits, err := service.SearchReleases(context.Background(), tc.params, id.AuthUser{})
 
 			if tc.wantErr {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
+				assert.Len(t, hits, tc.wantCount)
 			}
 
-			authSvc.AssertExpectations(t)
+			projectSvc.AssertExpectations(t)
 			releaseRepo.AssertExpectations(t)
 		})
 	}
 }
 
-func TestReleaseService_SendReleaseNotification(t *testing.T) {
+func TestReleaseService_GetNextReleaseVersion(t *testing.T) {
 	testCases := []struct {
 		name      string
-		mockSetup func(*svc.AuthorizationService, *svc.ProjectService, *svc.SettingsService, *slack.Client, *repo.ReleaseRepository)
+		bump      model.VersionBump
+		mockSetup func(*svc.AuthorizationService, *svc.ProjectService, *repo.ReleaseRepository)
+		want      model.NextReleaseVersion
 		wantErr   bool
 	}{
 		{
-			name: "Send release notification with deployment",
-			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, settingsSvc *svc.SettingsService, slackClient *slack.Client, releaseRepo *repo.ReleaseRepository) {
-				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				settingsSvc.On("GetSlackToken", mock.Anything).Return(model.SlackToken("token"), nil)
-				releaseRepo.On("ReadRelease", mock.Anything, mock.Anything).Return(model.Release{}, nil)
-				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
-					SlackChannelID: "channel",
+			name: "Minor bump of last published release",
+			bump: model.VersionBumpMinor,
+			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{VersionTagPrefix: "v"}, nil)
+				releaseRepo.On("ReadLastPublishedRelease", mock.Anything, mock.Anything).Return(model.Release{
+					Version: &model.Version{Major: 1, Minor: 2, Patch: 3},
 				}, nil)
-				releaseRepo.On("ReadLastDeploymentForRelease", mock.Anything, mock.Anything, mock.Anything).Return(model.Deployment{}, nil)
-				slackClient.On("SendReleaseNotification", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			want: model.NextReleaseVersion{
+				PreviousVersion: &model.Version{Major: 1, Minor: 2, Patch: 3},
+				Version:         model.Version{Major: 1, Minor: 3},
+				GitTagName:      "v1.3.0",
 			},
 			wantErr: false,
 		},
 		{
-			name: "Send release notification without deployment",
-			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, settingsSvc *svc.SettingsService, slackClient *slack.Client, releaseRepo *repo.ReleaseRepository) {
+			name: "No published release",
+			bump: model.VersionBumpMajor,
+			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{VersionTagPrefix: "v"}, nil)
+				releaseRepo.On("ReadLastPublishedRelease", mock.Anything, mock.Anything).Return(model.Release{}, svcerrors.NewReleaseNotFoundError())
+			},
+			want: model.NextReleaseVersion{
+				Version:    model.Version{Major: 1},
+				GitTagName: "v1.0.0",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Invalid bump",
+			bump: "build",
+			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Unauthorized",
+			bump: model.VersionBumpPatch,
+			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewProjectNotFoundError())
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authSvc := new(svc.AuthorizationService)
+			projectSvc := new(svc.ProjectService)
+			settingsSvc := new(svc.SettingsService)
+			releaseRepo := new(repo.ReleaseRepository)
+			slackClient := new(slack.Client)
+			githubClient := new(github.Client)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
+
+			tc.mockSetup(authSvc, projectSvc, releaseRepo)
+
+			v, err := service.GetNextReleaseVersion(context.Background(), tc.bump, id.NewProject(), id.AuthUser{})
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.want, v)
+			}
+
+			authSvc.AssertExpectations(t)
+			projectSvc.AssertExpectations(t)
+			releaseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReleaseService_ExportChangelog(t *testing.T) {
+	testCases := []struct {
+		name      string
+		params    model.ExportChangelogParams
+		mockSetup func(*svc.AuthorizationService, *svc.ProjectService, *repo.ReleaseRepository)
+		wantCount int
+		wantErr   bool
+	}{
+		{
+			name:   "Only published releases",
+			params: model.ExportChangelogParams{Format: model.ChangelogFormatKeepAChangelog},
+			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{}, nil)
+				releaseRepo.On("ListReleasesForProject", mock.Anything, model.ListReleasesFilterParams{SortBy: model.ReleaseSortByVersion}, mock.Anything).Return(model.ReleasePage{Releases: []model.Release{
+					{Status: model.ReleaseStatusDraft},
+					{Status: model.ReleaseStatusPublished},
+					{Status: model.ReleaseStatusDeprecated},
+				}}, nil)
+			},
+			wantCount: 2,
+			wantErr:   false,
+		},
+		{
+			name:   "Invalid format",
+			params: model.ExportChangelogParams{Format: "html"},
+			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:   "Project not found",
+			params: model.ExportChangelogParams{Format: model.ChangelogFormatMarkdown},
+			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{}, svcerrors.NewProjectNotFoundError())
+			},
+			wantErr: true,
+		},
+		{
+			name:   "Unauthorized",
+			params: model.ExportChangelogParams{Format: model.ChangelogFormatJSON},
+			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewProjectNotFoundError())
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authSvc := new(svc.AuthorizationService)
+			projectSvc := new(svc.ProjectService)
+			settingsSvc := new(svc.SettingsService)
+			releaseRepo := new(repo.ReleaseRepository)
+			slackClient := new(slack.Client)
+			githubClient := new(github.Client)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
+
+			tc.mockSetup(authSvc, projectSvc, releaseRepo)
+
+			c, err := service.ExportChangelog(context.Background(), tc.params, id.NewProject(), id.AuthUser{})
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, c.Releases, tc.wantCount)
+			}
+
+			authSvc.AssertExpectations(t)
+			projectSvc.AssertExpectations(t)
+			releaseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReleaseService_UpdateRelease(t *testing.T) {
+	testCases := []struct {
+		name      string
+		update    model.UpdateReleaseInput
+		mockSetup func(*svc.AuthorizationService, *repo.ReleaseRepository)
+		wantErr   bool
+	}{
+		{
+			name: "Valid release update",
+			update: model.UpdateReleaseInput{
+				ReleaseTitle: pointer.StringPtr("Test release"),
+				ReleaseNotes: pointer.StringPtr("Test release notes"),
+			},
+			mockSetup: func(auth *svc.AuthorizationService, repo *repo.ReleaseRepository) {
 				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				settingsSvc.On("GetSlackToken", mock.Anything).Return(model.SlackToken("token"), nil)
-				releaseRepo.On("ReadRelease", mock.Anything, mock.Anything, mock.Anything).Return(model.Release{}, nil)
-				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
-					SlackChannelID: "channel",
-				}, nil)
-				releaseRepo.On("ReadLastDeploymentForRelease", mock.Anything, mock.Anything, mock.Anything).Return(model.Deployment{}, svcerrors.NewDeploymentNotFoundError())
-				slackClient.On("SendReleaseNotification", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				repo.On("UpdateRelease", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 			},
 			wantErr: false,
 		},
 		{
-			name: "Slack integration not enabled",
-			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, settingsSvc *svc.SettingsService, slackClient *slack.Client, releaseRepo *repo.ReleaseRepository) {
+			name: "Empty release title",
+			update: model.UpdateReleaseInput{
+				ReleaseTitle: pointer.StringPtr(""),
+				ReleaseNotes: pointer.StringPtr("Test release notes"),
+			},
+			mockSetup: func(auth *svc.AuthorizationService, repo *repo.ReleaseRepository) {
 				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				settingsSvc.On("GetSlackToken", mock.Anything).Return(model.SlackToken(""), svcerrors.NewSlackIntegrationNotEnabledError())
+				repo.On("UpdateRelease", mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewReleaseInvalidError())
 			},
 			wantErr: true,
 		},
 		{
-			name: "Project has no slack channel",
-			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, settingsSvc *svc.SettingsService, slackClient *slack.Client, releaseRepo *repo.ReleaseRepository) {
+			name:   "Non existing release",
+			update: model.UpdateReleaseInput{},
+			mockSetup: func(auth *svc.AuthorizationService, repo *repo.ReleaseRepository) {
 				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				settingsSvc.On("GetSlackToken", mock.Anything).Return(model.SlackToken("token"), nil)
-				releaseRepo.On("ReadRelease", mock.Anything, mock.Anything).Return(model.Release{}, nil)
-				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
-					SlackChannelID: "",
-				}, nil)
-				releaseRepo.On("ReadLastDeploymentForRelease", mock.Anything, mock.Anything, mock.Anything).Return(model.Deployment{}, nil)
+				repo.On("UpdateRelease", mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewReleaseNotFoundError())
 			},
 			wantErr: true,
 		},
@@ -499,11 +994,118 @@ func TestReleaseService_SendReleaseNotification(t *testing.T) {
 			releaseRepo := new(repo.ReleaseRepository)
 			slackClient := new(slack.Client)
 			githubClient := new(github.Client)
-			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, slackClient, githubClient, releaseRepo)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
 
-			tc.mockSetup(authSvc, projectSvc, settingsSvc, slackClient, releaseRepo)
+			tc.mockSetup(authSvc, releaseRepo)
 
-			err := service.SendReleaseNotification(context.TODO(), id.NewRelease(), id.AuthUser{})
+			err := service.UpdateRelease(context.Background(), tc.update, id.NewRelease(), id.AuthUser{})
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			authSvc.AssertExpectations(t)
+			releaseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReleaseService_UpdateReleaseStatus(t *testing.T) {
+	readyRls := model.Release{ReleaseTitle: "Release 2.0.0", Status: model.ReleaseStatusReady, Tag: model.GitTag{Name: "v2.0.0"}}
+	githubRepo := &model.GithubRepo{OwnerSlug: "owner", RepoSlug: "repo"}
+
+	testCases := []struct {
+		name      string
+		status    model.ReleaseStatus
+		mockSetup func(*svc.AuthorizationService, *svc.SettingsService, *svc.ProjectService, *github.Client, *repo.ReleaseRepository)
+		wantErr   bool
+	}{
+		{
+			name:   "Valid status update",
+			status: model.ReleaseStatusPublished,
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, repo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				repo.On("UpdateRelease", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:   "Published release syncs linked items",
+			status: model.ReleaseStatusPublished,
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, repo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				repo.On("UpdateRelease", mock.Anything, mock.Anything, mock.Anything).Run(runReleaseUpdate(readyRls)).Return(nil).Twice()
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{GithubRepo: githubRepo}, nil)
+				repo.On("ReadPreviousPublishedRelease", mock.Anything, mock.Anything).Return(model.Release{Tag: model.GitTag{Name: "v1.0.0"}}, nil)
+				github.On("ListReleaseLinkedItems", mock.Anything, mock.Anything, *githubRepo, "v1.0.0", "v2.0.0").Return(model.ReleaseLinkedItems{}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:   "Published first release without linked items",
+			status: model.ReleaseStatusPublished,
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, repo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				repo.On("UpdateRelease", mock.Anything, mock.Anything, mock.Anything).Run(runReleaseUpdate(readyRls)).Return(nil).Once()
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{GithubRepo: githubRepo}, nil)
+				repo.On("ReadPreviousPublishedRelease", mock.Anything, mock.Anything).Return(model.Release{}, svcerrors.NewReleaseNotFoundError())
+			},
+			wantErr: false,
+		},
+		{
+			name:   "Publishing does not fail when linked items cannot be synced",
+			status: model.ReleaseStatusPublished,
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, repo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				repo.On("UpdateRelease", mock.Anything, mock.Anything, mock.Anything).Run(runReleaseUpdate(readyRls)).Return(nil).Once()
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken(""), svcerrors.NewGithubIntegrationNotEnabledError())
+			},
+			wantErr: false,
+		},
+		{
+			name:   "Transition not allowed",
+			status: model.ReleaseStatusDraft,
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, repo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				repo.On("UpdateRelease", mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewReleaseInvalidError())
+			},
+			wantErr: true,
+		},
+		{
+			name:   "Unauthorized",
+			status: model.ReleaseStatusPublished,
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, repo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewInsufficientProjectRoleError())
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authSvc := new(svc.AuthorizationService)
+			projectSvc := new(svc.ProjectService)
+			settingsSvc := new(svc.SettingsService)
+			releaseRepo := new(repo.ReleaseRepository)
+			slackClient := new(slack.Client)
+			githubClient := new(github.Client)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
+
+			tc.mockSetup(authSvc, settingsSvc, projectSvc, githubClient, releaseRepo)
+
+			err := service.UpdateReleaseStatus(context.Background(), tc.status, id.NewRelease(), id.AuthUser{})
 
 			if tc.wantErr {
 				assert.Error(t, err)
@@ -512,15 +1114,25 @@ func TestReleaseService_SendReleaseNotification(t *testing.T) {
 			}
 
 			authSvc.AssertExpectations(t)
-			projectSvc.AssertExpectations(t)
 			settingsSvc.AssertExpectations(t)
-			slackClient.AssertExpectations(t)
+			projectSvc.AssertExpectations(t)
+			githubClient.AssertExpectations(t)
 			releaseRepo.AssertExpectations(t)
 		})
 	}
 }
 
-func TestReleaseService_UpsertGithubRelease(t *testing.T) {
+func runReleaseUpdate(rls model.Release) func(args mock.Arguments) {
+	return func(args mock.Arguments) {
+		updateFn := args.Get(2).(func(model.Release) (model.Release, *model.ReleaseRevision, error))
+		_, _, _ = updateFn(rls)
+	}
+}
+
+func TestReleaseService_SyncReleaseLinkedItems(t *testing.T) {
+	rls := model.Release{Status: model.ReleaseStatusPublished, Tag: model.GitTag{Name: "v2.0.0"}}
+	githubRepo := &model.GithubRepo{OwnerSlug: "owner", RepoSlug: "repo"}
+
 	testCases := []struct {
 		name      string
 		mockSetup func(*svc.AuthorizationService, *svc.SettingsService, *svc.ProjectService, *github.Client, *repo.ReleaseRepository)
@@ -530,33 +1142,1320 @@ func TestReleaseService_UpsertGithubRelease(t *testing.T) {
 			name: "Success",
 			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
 				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
-				releaseRepo.On("ReadRelease", mock.Anything, mock.Anything).Return(model.Release{}, nil)
-				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
-					GithubRepo: &model.GithubRepo{
-						OwnerSlug: "owner",
-						RepoSlug:  "repo",
-					},
-				}, nil)
-				github.On("UpsertRelease", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadRelease", mock.Anything, mock.Anything).Return(rls, nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{GithubRepo: githubRepo}, nil)
+				releaseRepo.On("ReadPreviousPublishedRelease", mock.Anything, rls).Return(model.Release{Tag: model.GitTag{Name: "v1.0.0"}}, nil)
+				github.On("ListReleaseLinkedItems", mock.Anything, mock.Anything, *githubRepo, "v1.0.0", "v2.0.0").Return(model.ReleaseLinkedItems{
+					PreviousGitTagName: "v1.0.0",
+					PullRequests:       []model.GithubPullRequest{{Number: 1}},
+				}, nil)
+				releaseRepo.On("UpdateRelease", mock.Anything, mock.Anything, mock.Anything).Run(runReleaseUpdate(rls)).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Unauthorized",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewInsufficientProjectRoleError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "Github repo not set for project",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadRelease", mock.Anything, mock.Anything).Return(rls, nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "No previous release",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadRelease", mock.Anything, mock.Anything).Return(rls, nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{GithubRepo: githubRepo}, nil)
+				releaseRepo.On("ReadPreviousPublishedRelease", mock.Anything, rls).Return(model.Release{}, svcerrors.NewReleaseNotFoundError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "Git tag not found",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadRelease", mock.Anything, mock.Anything).Return(rls, nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{GithubRepo: githubRepo}, nil)
+				releaseRepo.On("ReadPreviousPublishedRelease", mock.Anything, rls).Return(model.Release{Tag: model.GitTag{Name: "v1.0.0"}}, nil)
+				github.On("ListReleaseLinkedItems", mock.Anything, mock.Anything, *githubRepo, "v1.0.0", "v2.0.0").Return(model.ReleaseLinkedItems{}, svcerrors.NewGitTagNotFoundError())
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authSvc := new(svc.AuthorizationService)
+			projectSvc := new(svc.ProjectService)
+			settingsSvc := new(svc.SettingsService)
+			releaseRepo := new(repo.ReleaseRepository)
+			slackClient := new(slack.Client)
+			githubClient := new(github.Client)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
+
+			tc.mockSetup(authSvc, settingsSvc, projectSvc, githubClient, releaseRepo)
+
+			synced, err := service.SyncReleaseLinkedItems(context.TODO(), id.NewRelease(), id.AuthUser{})
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, synced.LinkedItems)
+				assert.Len(t, synced.LinkedItems.PullRequests, 1)
+			}
+
+			authSvc.AssertExpectations(t)
+			settingsSvc.AssertExpectations(t)
+			projectSvc.AssertExpectations(t)
+			githubClient.AssertExpectations(t)
+			releaseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReleaseService_SendReleaseNotification(t *testing.T) {
+	testCases := []struct {
+		name      string
+		mockSetup func(*svc.AuthorizationService, *svc.ProjectService, *svc.SettingsService, *slack.Client, *repo.ReleaseRepository)
+		wantErr   bool
+	}{
+		{
+			name: "Send release notification with deployment",
+			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, settingsSvc *svc.SettingsService, slackClient *slack.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetSlackToken", mock.Anything).Return(model.SlackToken("token"), nil)
+				releaseRepo.On("ReadRelease", mock.Anything, mock.Anything).Return(model.Release{}, nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
+					SlackChannelID: "channel",
+				}, nil)
+				releaseRepo.On("ReadLastDeploymentForRelease", mock.Anything, mock.Anything, mock.Anything).Return(model.Deployment{}, nil)
+				slackClient.On("SendReleaseNotification", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Send release notification without deployment",
+			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, settingsSvc *svc.SettingsService, slackClient *slack.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetSlackToken", mock.Anything).Return(model.SlackToken("token"), nil)
+				releaseRepo.On("ReadRelease", mock.Anything, mock.Anything, mock.Anything).Return(model.Release{}, nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
+					SlackChannelID: "channel",
+				}, nil)
+				releaseRepo.On("ReadLastDeploymentForRelease", mock.Anything, mock.Anything, mock.Anything).Return(model.Deployment{}, svcerrors.NewDeploymentNotFoundError())
+				slackClient.On("SendReleaseNotification", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Slack integration not enabled",
+			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, settingsSvc *svc.SettingsService, slackClient *slack.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetSlackToken", mock.Anything).Return(model.SlackToken(""), svcerrors.NewSlackIntegrationNotEnabledError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "Project has no slack channel",
+			mockSetup: func(auth *svc.AuthorizationService, projectSvc *svc.ProjectService, settingsSvc *svc.SettingsService, slackClient *slack.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetSlackToken", mock.Anything).Return(model.SlackToken("token"), nil)
+				releaseRepo.On("ReadRelease", mock.Anything, mock.Anything).Return(model.Release{}, nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
+					SlackChannelID: "",
+				}, nil)
+				releaseRepo.On("ReadLastDeploymentForRelease", mock.Anything, mock.Anything, mock.Anything).Return(model.Deployment{}, nil)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authSvc := new(svc.AuthorizationService)
+			projectSvc := new(svc.ProjectService)
+			settingsSvc := new(svc.SettingsService)
+			releaseRepo := new(repo.ReleaseRepository)
+			slackClient := new(slack.Client)
+			githubClient := new(github.Client)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
+
+			tc.mockSetup(authSvc, projectSvc, settingsSvc, slackClient, releaseRepo)
+
+			err := service.SendReleaseNotification(context.TODO(), id.NewRelease(), id.AuthUser{})
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			authSvc.AssertExpectations(t)
+			projectSvc.AssertExpectations(t)
+			settingsSvc.AssertExpectations(t)
+			slackClient.AssertExpectations(t)
+			releaseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReleaseService_UpsertGitRelease(t *testing.T) {
+	testCases := []struct {
+		name      string
+		mockSetup func(*svc.AuthorizationService, *svc.SettingsService, *svc.ProjectService, *github.Client, *repo.ReleaseRepository)
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				releaseRepo.On("ReadRelease", mock.Anything, mock.Anything).Return(model.Release{Status: model.ReleaseStatusPublished}, nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
+					GithubRepo: &model.GithubRepo{
+						OwnerSlug: "owner",
+						RepoSlug:  "repo",
+					},
+				}, nil)
+				github.On("UpsertRelease", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Github integration not enabled",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadRelease", mock.Anything, mock.Anything).Return(model.Release{Status: model.ReleaseStatusPublished}, nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{GithubRepo: &model.GithubRepo{OwnerSlug: "owner", RepoSlug: "repo"}}, nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken(""), svcerrors.NewGithubIntegrationNotEnabledError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "Release not published",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadRelease", mock.Anything, mock.Anything).Return(model.Release{Status: model.ReleaseStatusDraft}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Github repo not set for project",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadRelease", mock.Anything, mock.Anything).Return(model.Release{Status: model.ReleaseStatusPublished}, nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{}, nil)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authSvc := new(svc.AuthorizationService)
+			projectSvc := new(svc.ProjectService)
+			settingsSvc := new(svc.SettingsService)
+			releaseRepo := new(repo.ReleaseRepository)
+			slackClient := new(slack.Client)
+			githubClient := new(github.Client)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
+
+			tc.mockSetup(authSvc, settingsSvc, projectSvc, githubClient, releaseRepo)
+
+			err := service.UpsertGitRelease(context.TODO(), id.NewRelease(), id.AuthUser{})
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			authSvc.AssertExpectations(t)
+			settingsSvc.AssertExpectations(t)
+			projectSvc.AssertExpectations(t)
+			githubClient.AssertExpectations(t)
+			releaseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReleaseService_GenerateGitReleaseNotes(t *testing.T) {
+	testCases := []struct {
+		name      string
+		mockSetup func(*svc.AuthorizationService, *svc.SettingsService, *svc.ProjectService, *github.Client, *repo.ReleaseRepository)
+		input     model.GitReleaseNotesInput
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
+					GithubRepo: &model.GithubRepo{
+						OwnerSlug: "owner",
+						RepoSlug:  "repo",
+					},
+				}, nil)
+				github.On("GenerateReleaseNotes", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.GitReleaseNotes{}, nil)
+			},
+			input: model.GitReleaseNotesInput{
+				GitTagName:         pointer.StringPtr("v2.0.0"),
+				PreviousGitTagName: pointer.StringPtr("v1.0.0"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success - linked items included",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
+					GithubRepo: &model.GithubRepo{
+						OwnerSlug: "owner",
+						RepoSlug:  "repo",
+					},
+				}, nil)
+				github.On("GenerateReleaseNotes", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.GitReleaseNotes{}, nil)
+				github.On("ListReleaseLinkedItems", mock.Anything, mock.Anything, mock.Anything, "v1.0.0", "v2.0.0").Return(model.ReleaseLinkedItems{}, nil)
+			},
+			input: model.GitReleaseNotesInput{
+				GitTagName:         pointer.StringPtr("v2.0.0"),
+				PreviousGitTagName: pointer.StringPtr("v1.0.0"),
+				IncludeLinkedItems: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success - linked items compared with last published release",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
+					GithubRepo: &model.GithubRepo{
+						OwnerSlug: "owner",
+						RepoSlug:  "repo",
+					},
+				}, nil)
+				projectSvc.On("IsGitRepoShared", mock.Anything, mock.Anything).Return(false, nil)
+				github.On("GenerateReleaseNotes", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.GitReleaseNotes{}, nil)
+				releaseRepo.On("ReadLastPublishedRelease", mock.Anything, mock.Anything).Return(model.Release{Tag: model.GitTag{Name: "v1.5.0"}}, nil)
+				github.On("ListReleaseLinkedItems", mock.Anything, mock.Anything, mock.Anything, "v1.5.0", "v2.0.0").Return(model.ReleaseLinkedItems{}, nil)
+			},
+			input: model.GitReleaseNotesInput{
+				GitTagName:         pointer.StringPtr("v2.0.0"),
+				IncludeLinkedItems: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success - no previous release to list linked items",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
+					GithubRepo: &model.GithubRepo{
+						OwnerSlug: "owner",
+						RepoSlug:  "repo",
+					},
+				}, nil)
+				projectSvc.On("IsGitRepoShared", mock.Anything, mock.Anything).Return(false, nil)
+				github.On("GenerateReleaseNotes", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.GitReleaseNotes{}, nil)
+				releaseRepo.On("ReadLastPublishedRelease", mock.Anything, mock.Anything).Return(model.Release{}, svcerrors.NewReleaseNotFoundError())
+			},
+			input: model.GitReleaseNotesInput{
+				GitTagName:         pointer.StringPtr("v2.0.0"),
+				IncludeLinkedItems: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success - previous tag of the project in shared repo",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
+					GithubRepo: &model.GithubRepo{
+						OwnerSlug: "owner",
+						RepoSlug:  "repo",
+					},
+					VersionTagPrefix: "api/v",
+				}, nil)
+				projectSvc.On("IsGitRepoShared", mock.Anything, mock.Anything).Return(true, nil)
+				github.On("ReadTagsForRepo", mock.Anything, mock.Anything, mock.Anything).Return([]model.RepoGitTag{
+					{Tag: model.GitTag{Name: "api/v1.0.0"}},
+					{Tag: model.GitTag{Name: "api/v1.1.0"}},
+					{Tag: model.GitTag{Name: "web/v3.0.0"}},
+					{Tag: model.GitTag{Name: "api/v2.0.0"}},
+				}, nil)
+				github.On("GenerateReleaseNotes", mock.Anything, mock.Anything, mock.Anything, mock.MatchedBy(func(input model.GitReleaseNotesInput) bool {
+					return input.PreviousGitTagName != nil && *input.PreviousGitTagName == "api/v1.1.0"
+				})).Return(model.GitReleaseNotes{}, nil)
+			},
+			input: model.GitReleaseNotesInput{
+				GitTagName: pointer.StringPtr("api/v2.0.0"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Invalid input",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
+					GithubRepo: &model.GithubRepo{
+						OwnerSlug: "owner",
+						RepoSlug:  "repo",
+					},
+				}, nil)
+			},
+			input: model.GitReleaseNotesInput{
+				GitTagName:         nil,
+				PreviousGitTagName: pointer.StringPtr("v1.0.0"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Github integration not enabled",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{GithubRepo: &model.GithubRepo{OwnerSlug: "owner", RepoSlug: "repo"}}, nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken(""), svcerrors.NewGithubIntegrationNotEnabledError())
+			},
+			input: model.GitReleaseNotesInput{
+				GitTagName:         pointer.StringPtr("v2.0.0"),
+				PreviousGitTagName: pointer.StringPtr("v1.0.0"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Project not found",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{}, svcerrors.NewProjectNotFoundError())
+			},
+			input: model.GitReleaseNotesInput{
+				GitTagName:         pointer.StringPtr("v2.0.0"),
+				PreviousGitTagName: pointer.StringPtr("v1.0.0"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Github repo not set for project",
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{}, nil)
+			},
+			input: model.GitReleaseNotesInput{
+				GitTagName:         pointer.StringPtr("v2.0.0"),
+				PreviousGitTagName: pointer.StringPtr("v1.0.0"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authSvc := new(svc.AuthorizationService)
+			projectSvc := new(svc.ProjectService)
+			settingsSvc := new(svc.SettingsService)
+			releaseRepo := new(repo.ReleaseRepository)
+			slackClient := new(slack.Client)
+			githubClient := new(github.Client)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
+
+			tc.mockSetup(authSvc, settingsSvc, projectSvc, githubClient, releaseRepo)
+
+			_, err := service.GenerateGitReleaseNotes(context.TODO(), tc.input, id.NewProject(), id.AuthUser{})
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			authSvc.AssertExpectations(t)
+			settingsSvc.AssertExpectations(t)
+			projectSvc.AssertExpectations(t)
+			githubClient.AssertExpectations(t)
+			releaseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReleaseService_CompareReleases(t *testing.T) {
+	baseReleaseID := id.NewRelease()
+	headReleaseID := id.NewRelease()
+	githubRepo := &model.GithubRepo{
+		OwnerSlug: "owner",
+		RepoSlug:  "repo",
+	}
+
+	testCases := []struct {
+		name      string
+		input     model.CompareReleasesInput
+		mockSetup func(*svc.AuthorizationService, *svc.SettingsService, *svc.ProjectService, *github.Client, *repo.ReleaseRepository)
+		wantErr   bool
+	}{
+		{
+			name:  "Success",
+			input: model.CompareReleasesInput{BaseReleaseID: baseReleaseID, HeadReleaseID: headReleaseID},
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{GithubRepo: githubRepo}, nil)
+				releaseRepo.On("ReadReleaseForProject", mock.Anything, mock.Anything, baseReleaseID).Return(model.Release{Tag: model.GitTag{Name: "v1.4.0"}}, nil)
+				releaseRepo.On("ReadReleaseForProject", mock.Anything, mock.Anything, headReleaseID).Return(model.Release{Tag: model.GitTag{Name: "v1.5.0"}}, nil)
+				github.On("CompareTags", mock.Anything, mock.Anything, *githubRepo, "v1.4.0", "v1.5.0").Return(model.GitTagComparison{}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:  "Same base and head release",
+			input: model.CompareReleasesInput{BaseReleaseID: baseReleaseID, HeadReleaseID: baseReleaseID},
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "Missing head release",
+			input: model.CompareReleasesInput{BaseReleaseID: baseReleaseID},
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "Github repo not set for project",
+			input: model.CompareReleasesInput{BaseReleaseID: baseReleaseID, HeadReleaseID: headReleaseID},
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "Head release not found",
+			input: model.CompareReleasesInput{BaseReleaseID: baseReleaseID, HeadReleaseID: headReleaseID},
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{GithubRepo: githubRepo}, nil)
+				releaseRepo.On("ReadReleaseForProject", mock.Anything, mock.Anything, baseReleaseID).Return(model.Release{Tag: model.GitTag{Name: "v1.4.0"}}, nil)
+				releaseRepo.On("ReadReleaseForProject", mock.Anything, mock.Anything, headReleaseID).Return(model.Release{}, svcerrors.NewReleaseNotFoundError())
+			},
+			wantErr: true,
+		},
+		{
+			name:  "Git tag not found",
+			input: model.CompareReleasesInput{BaseReleaseID: baseReleaseID, HeadReleaseID: headReleaseID},
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{GithubRepo: githubRepo}, nil)
+				releaseRepo.On("ReadReleaseForProject", mock.Anything, mock.Anything, baseReleaseID).Return(model.Release{Tag: model.GitTag{Name: "v1.4.0"}}, nil)
+				releaseRepo.On("ReadReleaseForProject", mock.Anything, mock.Anything, headReleaseID).Return(model.Release{Tag: model.GitTag{Name: "v1.5.0"}}, nil)
+				github.On("CompareTags", mock.Anything, mock.Anything, *githubRepo, "v1.4.0", "v1.5.0").Return(model.GitTagComparison{}, svcerrors.NewGitTagNotFoundError())
+			},
+			wantErr: true,
+		},
+		{
+			name:  "Unauthorized",
+			input: model.CompareReleasesInput{BaseReleaseID: baseReleaseID, HeadReleaseID: headReleaseID},
+			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewProjectNotFoundError())
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authSvc := new(svc.AuthorizationService)
+			projectSvc := new(svc.ProjectService)
+			settingsSvc := new(svc.SettingsService)
+			releaseRepo := new(repo.ReleaseRepository)
+			slackClient := new(slack.Client)
+			githubClient := new(github.Client)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
+
+			tc.mockSetup(authSvc, settingsSvc, projectSvc, githubClient, releaseRepo)
+
+			_, err := service.CompareReleases(context.Background(), tc.input, id.NewProject(), id.AuthUser{})
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			authSvc.AssertExpectations(t)
+			settingsSvc.AssertExpectations(t)
+			projectSvc.AssertExpectations(t)
+			githubClient.AssertExpectations(t)
+			releaseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReleaseService_CreateDeployment(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     model.CreateDeploymentInput
+		mockSetup func(*svc.AuthorizationService, *svc.ProjectService, *svc.SettingsService, *github.Client, *resend.Client, *repo.ReleaseRepository)
+		wantErr   bool
+	}{
+		{
+			name: "success",
+			input: model.CreateDeploymentInput{
+				ReleaseID:     id.NewRelease(),
+				EnvironmentID: id.NewEnvironment(),
+			},
+			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, settingsSvc *svc.SettingsService, githubClient *github.Client, emailClient *resend.Client, releaseRepo *repo.ReleaseRepository) {
+				authSvc.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadReleaseForProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Release{Status: model.ReleaseStatusPublished}, nil)
+				projectSvc.On("GetEnvironment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.Environment{}, nil)
+				releaseRepo.On("CreateDeployment", mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken(""), svcerrors.NewGithubIntegrationNotEnabledError())
+			},
+			wantErr: false,
+		},
+		{
+			name: "success - pending approval",
+			input: model.CreateDeploymentInput{
+				ReleaseID:     id.NewRelease(),
+				EnvironmentID: id.NewEnvironment(),
+			},
+			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, settingsSvc *svc.SettingsService, githubClient *github.Client, emailClient *resend.Client, releaseRepo *repo.ReleaseRepository) {
+				authSvc.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadReleaseForProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Release{Status: model.ReleaseStatusPublished}, nil)
+				projectSvc.On("GetEnvironment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.Environment{
+					ApprovalPolicy: model.ApprovalPolicy{RequiredApprovals: 1, ApproverRoles: []model.ProjectRole{model.ProjectRoleOwner}},
+				}, nil)
+				releaseRepo.On("CreateDeployment", mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken(""), svcerrors.NewGithubIntegrationNotEnabledError())
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{Name: "project"}, nil)
+				projectSvc.On("ListEnvironmentApprovers", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]model.ProjectMember{
+					{User: model.User{ID: id.User(uuid.New()), Email: "owner@example.com"}, ProjectRole: model.ProjectRoleOwner},
+				}, nil)
+				emailClient.On("SendDeploymentApprovalRequestEmailAsync", mock.Anything, mock.Anything, []string{"owner@example.com"}).Return()
+			},
+			wantErr: false,
+		},
+		{
+			name: "success - approvers cannot be listed",
+			input: model.CreateDeploymentInput{
+				ReleaseID:     id.NewRelease(),
+				EnvironmentID: id.NewEnvironment(),
+			},
+			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, settingsSvc *svc.SettingsService, githubClient *github.Client, emailClient *resend.Client, releaseRepo *repo.ReleaseRepository) {
+				authSvc.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadReleaseForProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Release{Status: model.ReleaseStatusPublished}, nil)
+				projectSvc.On("GetEnvironment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.Environment{
+					ApprovalPolicy: model.ApprovalPolicy{RequiredApprovals: 1, ApproverRoles: []model.ProjectRole{model.ProjectRoleOwner}},
+				}, nil)
+				releaseRepo.On("CreateDeployment", mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken(""), svcerrors.NewGithubIntegrationNotEnabledError())
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{Name: "project"}, nil)
+				projectSvc.On("ListEnvironmentApprovers", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]model.ProjectMember{}, errors.New("db error"))
+			},
+			wantErr: false,
+		},
+		{
+			name: "success - mirrored to github",
+			input: model.CreateDeploymentInput{
+				ReleaseID:     id.NewRelease(),
+				EnvironmentID: id.NewEnvironment(),
+			},
+			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, settingsSvc *svc.SettingsService, githubClient *github.Client, emailClient *resend.Client, releaseRepo *repo.ReleaseRepository) {
+				authSvc.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadReleaseForProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Release{Status: model.ReleaseStatusPublished}, nil)
+				projectSvc.On("GetEnvironment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.Environment{}, nil)
+				releaseRepo.On("CreateDeployment", mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
+					GithubRepo: &model.GithubRepo{OwnerSlug: "owner", RepoSlug: "repo"},
+				}, nil)
+				githubClient.On("CreateDeployment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(int64(42), nil)
+				releaseRepo.On("UpdateDeployment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				githubClient.On("CreateDeploymentStatus", mock.Anything, mock.Anything, mock.Anything, int64(42), mock.MatchedBy(func(d model.Deployment) bool {
+					return d.GithubDeploymentState() == model.GithubDeploymentStateSuccess
+				})).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "success - github repo not set",
+			input: model.CreateDeploymentInput{
+				ReleaseID:     id.NewRelease(),
+				EnvironmentID: id.NewEnvironment(),
+			},
+			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, settingsSvc *svc.SettingsService, githubClient *github.Client, emailClient *resend.Client, releaseRepo *repo.ReleaseRepository) {
+				authSvc.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadReleaseForProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Release{Status: model.ReleaseStatusPublished}, nil)
+				projectSvc.On("GetEnvironment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.Environment{}, nil)
+				releaseRepo.On("CreateDeployment", mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "success - github deployment failed",
+			input: model.CreateDeploymentInput{
+				ReleaseID:     id.NewRelease(),
+				EnvironmentID: id.NewEnvironment(),
+			},
+			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, settingsSvc *svc.SettingsService, githubClient *github.Client, emailClient *resend.Client, releaseRepo *repo.ReleaseRepository) {
+				authSvc.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadReleaseForProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Release{Status: model.ReleaseStatusPublished}, nil)
+				projectSvc.On("GetEnvironment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.Environment{}, nil)
+				releaseRepo.On("CreateDeployment", mock.Anything, mock.Anything).Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
+					GithubRepo: &model.GithubRepo{OwnerSlug: "owner", RepoSlug: "repo"},
+				}, nil)
+				githubClient.On("CreateDeployment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(int64(0), svcerrors.NewGithubRepoNotFoundError())
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid input",
+			input: model.CreateDeploymentInput{
+				ReleaseID:     id.Release(uuid.Nil),
+				EnvironmentID: id.Environment(uuid.Nil),
+			},
+			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, settingsSvc *svc.SettingsService, githubClient *github.Client, emailClient *resend.Client, releaseRepo *repo.ReleaseRepository) {
+				authSvc.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "release not found",
+			input: model.CreateDeploymentInput{
+				ReleaseID:     id.NewRelease(),
+				EnvironmentID: id.NewEnvironment(),
+			},
+			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, settingsSvc *svc.SettingsService, githubClient *github.Client, emailClient *resend.Client, releaseRepo *repo.ReleaseRepository) {
+				authSvc.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadReleaseForProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Release{}, svcerrors.NewReleaseNotFoundError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "release not published",
+			input: model.CreateDeploymentInput{
+				ReleaseID:     id.NewRelease(),
+				EnvironmentID: id.NewEnvironment(),
+			},
+			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, settingsSvc *svc.SettingsService, githubClient *github.Client, emailClient *resend.Client, releaseRepo *repo.ReleaseRepository) {
+				authSvc.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadReleaseForProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Release{Status: model.ReleaseStatusReady}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "env not found",
+			input: model.CreateDeploymentInput{
+				ReleaseID:     id.NewRelease(),
+				EnvironmentID: id.NewEnvironment(),
+			},
+			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, settingsSvc *svc.SettingsService, githubClient *github.Client, emailClient *resend.Client, releaseRepo *repo.ReleaseRepository) {
+				authSvc.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadReleaseForProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Release{Status: model.ReleaseStatusPublished}, nil)
+				projectSvc.On("GetEnvironment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.Environment{}, svcerrors.NewEnvironmentNotFoundError())
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authSvc := new(svc.AuthorizationService)
+			projectSvc := new(svc.ProjectService)
+			settingsSvc := new(svc.SettingsService)
+			releaseRepo := new(repo.ReleaseRepository)
+			slackClient := new(slack.Client)
+			githubClient := new(github.Client)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
+
+			tc.mockSetup(authSvc, projectSvc, settingsSvc, githubClient, emailClient, releaseRepo)
+
+			_, err := service.CreateDeployment(context.TODO(), tc.input, id.NewProject(), id.AuthUser{})
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			authSvc.AssertExpectations(t)
+			projectSvc.AssertExpectations(t)
+			settingsSvc.AssertExpectations(t)
+			githubClient.AssertExpectations(t)
+			emailClient.AssertExpectations(t)
+			releaseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReleaseService_ListDeploymentsForProject(t *testing.T) {
+	envID := id.NewEnvironment()
+	rlsID := id.NewRelease()
+
+	testCases := []struct {
+		name      string
+		params    model.ListDeploymentsFilterParams
+		mockSetup func(*svc.AuthorizationService, *svc.ProjectService, *repo.ReleaseRepository)
+		wantErr   bool
+	}{
+		{
+			name:   "Deployments fetched successfully - without params",
+			params: model.ListDeploymentsFilterParams{},
+			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				authSvc.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ListDeploymentsForProject", mock.Anything, mock.Anything, mock.Anything).Return([]model.Deployment{
+					{
+						ID: id.NewDeployment(),
+					},
+					{
+						ID: id.NewDeployment(),
+					},
+				}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Deployments fetched successfully - with valid params",
+			params: model.ListDeploymentsFilterParams{
+				EnvironmentID: &envID,
+				ReleaseID:     &rlsID,
+			},
+			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				authSvc.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadReleaseForProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Release{}, nil)
+				projectSvc.On("GetEnvironment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.Environment{}, nil)
+				releaseRepo.On("ListDeploymentsForProject", mock.Anything, mock.Anything, mock.Anything).Return([]model.Deployment{
+					{
+						ID: id.NewDeployment(),
+					},
+					{
+						ID: id.NewDeployment(),
+					},
+				}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Deployments fetched successfully - release provided in params not found",
+			params: model.ListDeploymentsFilterParams{
+				EnvironmentID: &envID,
+				ReleaseID:     &rlsID,
+			},
+			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				authSvc.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadReleaseForProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Release{}, svcerrors.NewReleaseNotFoundError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "Deployments fetched successfully - env provided in params not found",
+			params: model.ListDeploymentsFilterParams{
+				EnvironmentID: &envID,
+				ReleaseID:     &rlsID,
+			},
+			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				authSvc.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadReleaseForProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Release{}, nil)
+				projectSvc.On("GetEnvironment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.Environment{}, svcerrors.NewEnvironmentNotFoundError())
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authSvc := new(svc.AuthorizationService)
+			projectSvc := new(svc.ProjectService)
+			settingsSvc := new(svc.SettingsService)
+			releaseRepo := new(repo.ReleaseRepository)
+			slackClient := new(slack.Client)
+			githubClient := new(github.Client)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
+
+			tc.mockSetup(authSvc, projectSvc, releaseRepo)
+
+			_, err := service.ListDeploymentsForProject(context.TODO(), tc.params, id.NewProject(), id.AuthUser{})
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			authSvc.AssertExpectations(t)
+			projectSvc.AssertExpectations(t)
+			releaseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReleaseService_DeleteReleaseOnGitTagRemoval(t *testing.T) {
+	webProjectID := id.NewProject()
+
+	testCases := []struct {
+		name            string
+		mockSetup       func(*svc.SettingsService, *svc.ProjectService, *github.Client, *repo.ReleaseRepository)
+		deletedTagInput model.GithubTagDeletionWebhookInput
+		wantErr         bool
+	}{
+		{
+			name: "Success",
+			deletedTagInput: model.GithubTagDeletionWebhookInput{
+				RawPayload: make([]byte, 0),
+				Signature:  "signature",
+			},
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGithubSettings", mock.Anything).Return(model.GithubSettings{
+					Enabled:       true,
+					Token:         "token",
+					WebhookSecret: "secret",
+				}, nil)
+				github.On("ReadWebhookRepo", mock.Anything).Return(model.GithubRepo{}, false)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				github.On("ParseTagDeletionWebhook", mock.Anything, mock.Anything, mock.Anything, model.GithubWebhookSecret("secret")).Return(model.GithubTagDeletionWebhookOutput{}, nil)
+				projectSvc.On("GetProjectByGithubRepo", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{}, nil)
+				releaseRepo.On("DeleteReleaseForProjectByGitTag", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Secret of the webhook registered for the project is used",
+			deletedTagInput: model.GithubTagDeletionWebhookInput{
+				RawPayload: make([]byte, 0),
+				Signature:  "signature",
+			},
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGithubSettings", mock.Anything).Return(model.GithubSettings{
+					Enabled:       true,
+					Token:         "token",
+					WebhookSecret: "secret",
+				}, nil)
+				github.On("ReadWebhookRepo", mock.Anything).Return(model.GithubRepo{OwnerSlug: "owner", RepoSlug: "repo"}, true)
+				projectSvc.On("ListProjectsByGithubRepo", mock.Anything, mock.Anything).Return([]model.Project{{
+					GithubWebhook: &model.GithubRepoWebhook{ID: 1, Secret: "project-secret"},
+				}}, nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				github.On("ParseTagDeletionWebhook", mock.Anything, mock.Anything, mock.Anything, model.GithubWebhookSecret("project-secret")).Return(model.GithubTagDeletionWebhookOutput{}, nil)
+				projectSvc.On("GetProjectByGithubRepo", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{}, nil)
+				releaseRepo.On("DeleteReleaseForProjectByGitTag", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Repo not linked to any project uses the global secret",
+			deletedTagInput: model.GithubTagDeletionWebhookInput{
+				RawPayload: make([]byte, 0),
+				Signature:  "signature",
+			},
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGithubSettings", mock.Anything).Return(model.GithubSettings{
+					Enabled:       true,
+					Token:         "token",
+					WebhookSecret: "secret",
+				}, nil)
+				github.On("ReadWebhookRepo", mock.Anything).Return(model.GithubRepo{OwnerSlug: "owner", RepoSlug: "repo"}, true)
+				projectSvc.On("ListProjectsByGithubRepo", mock.Anything, mock.Anything).Return([]model.Project{}, nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				github.On("ParseTagDeletionWebhook", mock.Anything, mock.Anything, mock.Anything, model.GithubWebhookSecret("secret")).Return(model.GithubTagDeletionWebhookOutput{}, nil)
+				projectSvc.On("GetProjectByGithubRepo", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{}, nil)
+				releaseRepo.On("DeleteReleaseForProjectByGitTag", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Secret of the webhook which signed the delivery is used for shared repo",
+			deletedTagInput: model.GithubTagDeletionWebhookInput{
+				RawPayload: make([]byte, 0),
+				Signature:  "signature",
+			},
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGithubSettings", mock.Anything).Return(model.GithubSettings{
+					Enabled:       true,
+					Token:         "token",
+					WebhookSecret: "secret",
+				}, nil)
+				github.On("ReadWebhookRepo", mock.Anything).Return(model.GithubRepo{OwnerSlug: "owner", RepoSlug: "repo"}, true)
+				projectSvc.On("ListProjectsByGithubRepo", mock.Anything, mock.Anything).Return([]model.Project{
+					{VersionTagPrefix: "api/v", GithubWebhook: &model.GithubRepoWebhook{ID: 1, Secret: "api-secret"}},
+					{VersionTagPrefix: "web/v", GithubWebhook: &model.GithubRepoWebhook{ID: 2, Secret: "web-secret"}},
+				}, nil)
+				github.On("ReadWebhookDeliveryInfo", mock.Anything, model.GithubWebhookSecret("api-secret")).Return(model.GithubWebhookDeliveryInfo{SignatureValid: false})
+				github.On("ReadWebhookDeliveryInfo", mock.Anything, model.GithubWebhookSecret("web-secret")).Return(model.GithubWebhookDeliveryInfo{SignatureValid: true})
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				github.On("ParseTagDeletionWebhook", mock.Anything, mock.Anything, mock.Anything, model.GithubWebhookSecret("web-secret")).Return(model.GithubTagDeletionWebhookOutput{
+					Repo:    model.GithubRepo{OwnerSlug: "owner", RepoSlug: "repo"},
+					TagName: "web/v1.0.0",
+				}, nil)
+				projectSvc.On("GetProjectByGithubRepo", mock.Anything, model.GithubRepo{OwnerSlug: "owner", RepoSlug: "repo"}, "web/v1.0.0").Return(model.Project{ID: webProjectID}, nil)
+				releaseRepo.On("DeleteReleaseForProjectByGitTag", mock.Anything, webProjectID, "web/v1.0.0").Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "No project of the shared repo matches the tag",
+			deletedTagInput: model.GithubTagDeletionWebhookInput{
+				RawPayload: make([]byte, 0),
+				Signature:  "signature",
+			},
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGithubSettings", mock.Anything).Return(model.GithubSettings{
+					Enabled:       true,
+					Token:         "token",
+					WebhookSecret: "secret",
+				}, nil)
+				github.On("ReadWebhookRepo", mock.Anything).Return(model.GithubRepo{}, false)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				github.On("ParseTagDeletionWebhook", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.GithubTagDeletionWebhookOutput{TagName: "docs/v1.0.0"}, nil)
+				projectSvc.On("GetProjectByGithubRepo", mock.Anything, mock.Anything, "docs/v1.0.0").Return(model.Project{}, svcerrors.NewProjectNotFoundError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "Github settings not enabled",
+			deletedTagInput: model.GithubTagDeletionWebhookInput{
+				RawPayload: make([]byte, 0),
+				Signature:  "signature",
+			},
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGithubSettings", mock.Anything).Return(model.GithubSettings{
+					Enabled:       false,
+					Token:         "token",
+					WebhookSecret: "secret",
+				}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error parsing delete tag event",
+			deletedTagInput: model.GithubTagDeletionWebhookInput{
+				RawPayload: make([]byte, 0),
+				Signature:  "signature",
+			},
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGithubSettings", mock.Anything).Return(model.GithubSettings{
+					Enabled:       true,
+					Token:         "token",
+					WebhookSecret: "secret",
+				}, nil)
+				github.On("ReadWebhookRepo", mock.Anything).Return(model.GithubRepo{}, false)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				github.On("ParseTagDeletionWebhook", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.GithubTagDeletionWebhookOutput{}, svcerrors.NewInvalidGithubTagDeletionWebhookError())
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authSvc := new(svc.AuthorizationService)
+			projectSvc := new(svc.ProjectService)
+			settingsSvc := new(svc.SettingsService)
+			releaseRepo := new(repo.ReleaseRepository)
+			slackClient := new(slack.Client)
+			githubClient := new(github.Client)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
+
+			tc.mockSetup(settingsSvc, projectSvc, githubClient, releaseRepo)
+
+			err := service.DeleteReleaseOnGitTagRemoval(context.TODO(), tc.deletedTagInput)
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			settingsSvc.AssertExpectations(t)
+			projectSvc.AssertExpectations(t)
+			githubClient.AssertExpectations(t)
+			releaseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReleaseService_CreateDraftReleaseOnGitTagCreation(t *testing.T) {
+	settings := model.GithubSettings{
+		Enabled:       true,
+		Token:         "token",
+		WebhookSecret: "secret",
+	}
+	output := model.GithubTagCreationWebhookOutput{
+		Repo: model.GithubRepo{OwnerSlug: "owner", RepoSlug: "repo"},
+		Tag:  model.GitTag{Name: "v1.1.0"},
+	}
+	project := model.Project{VersionTagPrefix: "v", GithubRepo: &output.Repo}
+	lastVersion := model.Version{Major: 1}
+
+	testCases := []struct {
+		name      string
+		mockSetup func(*svc.SettingsService, *svc.ProjectService, *github.Client, *repo.ReleaseRepository)
+		wantErr   bool
+	}{
+		{
+			name: "Draft with notes against the last published release",
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGithubSettings", mock.Anything).Return(settings, nil)
+				github.On("ReadWebhookRepo", mock.Anything).Return(model.GithubRepo{}, false)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				github.On("ParseTagCreationWebhook", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(output, nil)
+				projectSvc.On("GetProjectByGithubRepo", mock.Anything, output.Repo, output.Tag.Name).Return(project, nil)
+				releaseRepo.On("ReadLastPublishedRelease", mock.Anything, mock.Anything).Return(model.Release{
+					Tag:     model.GitTag{Name: "v1.0.0"},
+					Version: &lastVersion,
+				}, nil)
+				github.On("GenerateReleaseNotes", mock.Anything, mock.Anything, mock.Anything, model.GitReleaseNotesInput{
+					GitTagName:         pointer.StringPtr("v1.1.0"),
+					PreviousGitTagName: pointer.StringPtr("v1.0.0"),
+				}).Return(model.GitReleaseNotes{Title: "v1.1.0", Notes: "## What's Changed"}, nil)
+				releaseRepo.On("CreateRelease", mock.Anything, mock.MatchedBy(func(r model.Release) bool {
+					return r.Status == model.ReleaseStatusDraft && r.AuthorUserID.IsNil() && r.ReleaseNotes == "## What's Changed"
+				})).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "First release of the project",
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGithubSettings", mock.Anything).Return(settings, nil)
+				github.On("ReadWebhookRepo", mock.Anything).Return(model.GithubRepo{}, false)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				github.On("ParseTagCreationWebhook", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(output, nil)
+				projectSvc.On("GetProjectByGithubRepo", mock.Anything, output.Repo, output.Tag.Name).Return(project, nil)
+				releaseRepo.On("ReadLastPublishedRelease", mock.Anything, mock.Anything).Return(model.Release{}, svcerrors.NewReleaseNotFoundError())
+				github.On("GenerateReleaseNotes", mock.Anything, mock.Anything, mock.Anything, model.GitReleaseNotesInput{
+					GitTagName: pointer.StringPtr("v1.1.0"),
+				}).Return(model.GitReleaseNotes{}, nil)
+				releaseRepo.On("CreateRelease", mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Tag already has a release",
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGithubSettings", mock.Anything).Return(settings, nil)
+				github.On("ReadWebhookRepo", mock.Anything).Return(model.GithubRepo{}, false)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				github.On("ParseTagCreationWebhook", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(output, nil)
+				projectSvc.On("GetProjectByGithubRepo", mock.Anything, output.Repo, output.Tag.Name).Return(project, nil)
+				releaseRepo.On("ReadLastPublishedRelease", mock.Anything, mock.Anything).Return(model.Release{}, svcerrors.NewReleaseNotFoundError())
+				github.On("GenerateReleaseNotes", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.GitReleaseNotes{}, nil)
+				releaseRepo.On("CreateRelease", mock.Anything, mock.Anything).Return(svcerrors.NewReleaseGitTagAlreadyUsedError())
+			},
+			wantErr: false,
+		},
+		{
+			name: "Github settings not enabled",
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGithubSettings", mock.Anything).Return(model.GithubSettings{}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Invalid signature",
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGithubSettings", mock.Anything).Return(settings, nil)
+				github.On("ReadWebhookRepo", mock.Anything).Return(model.GithubRepo{}, false)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				github.On("ParseTagCreationWebhook", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.GithubTagCreationWebhookOutput{}, svcerrors.NewInvalidGithubTagCreationWebhookError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "Project not found",
+			mockSetup: func(settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+				settingsSvc.On("GetGithubSettings", mock.Anything).Return(settings, nil)
+				github.On("ReadWebhookRepo", mock.Anything).Return(model.GithubRepo{}, false)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				github.On("ParseTagCreationWebhook", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(output, nil)
+				projectSvc.On("GetProjectByGithubRepo", mock.Anything, output.Repo, output.Tag.Name).Return(model.Project{}, svcerrors.NewProjectNotFoundError())
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authSvc := new(svc.AuthorizationService)
+			projectSvc := new(svc.ProjectService)
+			settingsSvc := new(svc.SettingsService)
+			releaseRepo := new(repo.ReleaseRepository)
+			slackClient := new(slack.Client)
+			githubClient := new(github.Client)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
+
+			tc.mockSetup(settingsSvc, projectSvc, githubClient, releaseRepo)
+
+			err := service.CreateDraftReleaseOnGitTagCreation(context.TODO(), model.GithubTagCreationWebhookInput{
+				RawPayload: make([]byte, 0),
+				Signature:  "signature",
+			})
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			settingsSvc.AssertExpectations(t)
+			projectSvc.AssertExpectations(t)
+			githubClient.AssertExpectations(t)
+			releaseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReleaseService_UploadReleaseAttachment(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     model.ReleaseAttachmentInput
+		mockSetup func(*svc.AuthorizationService, *storage.Client, *repo.ReleaseRepository)
+		wantErr   bool
+		// wantMaxBytesErr is set if the error of the request body must be passed on, so it can be answered with 413
+		wantMaxBytesErr bool
+	}{
+		{
+			name: "Success",
+			input: model.ReleaseAttachmentInput{
+				Name:        "changelog.pdf",
+				ContentType: "application/pdf",
+				Content:     strings.NewReader("content"),
+			},
+			mockSetup: func(auth *svc.AuthorizationService, storage *storage.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				storage.On("UploadFile", mock.Anything, mock.Anything, "application/pdf", mock.Anything).Return(nil)
+				releaseRepo.On("CreateReleaseAttachment", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadReleaseAttachment", mock.Anything, mock.Anything, mock.Anything).Return(model.ReleaseAttachment{}, nil)
 			},
 			wantErr: false,
 		},
 		{
-			name: "Github integration not enabled",
-			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+			name: "Unauthorized",
+			input: model.ReleaseAttachmentInput{
+				Name:        "changelog.pdf",
+				ContentType: "application/pdf",
+				Content:     strings.NewReader("content"),
+			},
+			mockSetup: func(auth *svc.AuthorizationService, storage *storage.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewInsufficientProjectRoleError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "Content type not allowed",
+			input: model.ReleaseAttachmentInput{
+				Name:        "script.sh",
+				ContentType: "application/x-sh",
+				Content:     strings.NewReader("content"),
+			},
+			mockSetup: func(auth *svc.AuthorizationService, storage *storage.Client, releaseRepo *repo.ReleaseRepository) {
 				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken(""), svcerrors.NewGithubIntegrationNotEnabledError())
 			},
 			wantErr: true,
 		},
 		{
-			name: "Github repo not set for project",
-			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
+			name: "Upload error",
+			input: model.ReleaseAttachmentInput{
+				Name:        "changelog.pdf",
+				ContentType: "application/pdf",
+				Content:     strings.NewReader("content"),
+			},
+			mockSetup: func(auth *svc.AuthorizationService, storage *storage.Client, releaseRepo *repo.ReleaseRepository) {
 				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
-				releaseRepo.On("ReadRelease", mock.Anything, mock.Anything).Return(model.Release{}, nil)
-				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{}, nil)
+				storage.On("UploadFile", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("upload error"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "Request body exceeding limit while streamed",
+			input: model.ReleaseAttachmentInput{
+				Name:        "changelog.pdf",
+				ContentType: "application/pdf",
+				Content:     io.MultiReader(strings.NewReader("content"), iotest.ErrReader(&http.MaxBytesError{Limit: 7})),
+			},
+			mockSetup: func(auth *svc.AuthorizationService, storage *storage.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				// The storage client does not pass on the error of the request body
+				storage.On("UploadFile", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Run(func(args mock.Arguments) {
+						_, _ = io.Copy(io.Discard, args.Get(3).(io.Reader))
+					}).
+					Return(errors.New("connection broken"))
+			},
+			wantErr:         true,
+			wantMaxBytesErr: true,
+		},
+		{
+			name: "Uploaded file is deleted when attachment cannot be created",
+			input: model.ReleaseAttachmentInput{
+				Name:        "changelog.pdf",
+				ContentType: "application/pdf",
+				Content:     strings.NewReader("content"),
+			},
+			mockSetup: func(auth *svc.AuthorizationService, storage *storage.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				storage.On("UploadFile", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("CreateReleaseAttachment", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("db error"))
+				storage.On("DeleteFile", mock.Anything, mock.Anything).Return(nil)
 			},
 			wantErr: true,
 		},
@@ -570,106 +2469,121 @@ func TestReleaseService_UpsertGithubRelease(t *testing.T) {
 			releaseRepo := new(repo.ReleaseRepository)
 			slackClient := new(slack.Client)
 			githubClient := new(github.Client)
-			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, slackClient, githubClient, releaseRepo)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
 
-			tc.mockSetup(authSvc, settingsSvc, projectSvc, githubClient, releaseRepo)
+			tc.mockSetup(authSvc, storageClient, releaseRepo)
 
-			err := service.UpsertGithubRelease(context.TODO(), id.NewRelease(), id.AuthUser{})
+			_, err := service.UploadReleaseAttachment(context.TODO(), tc.input, id.NewRelease(), id.AuthUser{})
 
 			if tc.wantErr {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
 			}
+			var maxBytesErr *http.MaxBytesError
+			assert.Equal(t, tc.wantMaxBytesErr, errors.As(err, &maxBytesErr))
 
 			authSvc.AssertExpectations(t)
-			settingsSvc.AssertExpectations(t)
-			projectSvc.AssertExpectations(t)
-			githubClient.AssertExpectations(t)
+			storageClient.AssertExpectations(t)
 			releaseRepo.AssertExpectations(t)
 		})
 	}
 }
 
-func TestReleaseService_GenerateGithubReleaseNotes(t *testing.T) {
+func TestReleaseService_ReplaceReleaseAttachment(t *testing.T) {
 	testCases := []struct {
 		name      string
-		mockSetup func(*svc.AuthorizationService, *svc.SettingsService, *svc.ProjectService, *github.Client, *repo.ReleaseRepository)
-		input     model.GithubReleaseNotesInput
+		input     model.ReleaseAttachmentInput
+		mockSetup func(*svc.AuthorizationService, *storage.Client, *repo.ReleaseRepository)
 		wantErr   bool
 	}{
 		{
 			name: "Success",
-			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
-				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
-				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
-					GithubRepo: &model.GithubRepo{
-						OwnerSlug: "owner",
-						RepoSlug:  "repo",
-					},
-				}, nil)
-				github.On("GenerateReleaseNotes", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.GithubReleaseNotes{}, nil)
+			input: model.ReleaseAttachmentInput{
+				Name:        "changelog.pdf",
+				ContentType: "application/pdf",
+				Content:     strings.NewReader("content"),
 			},
-			input: model.GithubReleaseNotesInput{
-				GitTagName:         pointer.StringPtr("v2.0.0"),
-				PreviousGitTagName: pointer.StringPtr("v1.0.0"),
+			mockSetup: func(auth *svc.AuthorizationService, storage *storage.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadReleaseAttachment", mock.Anything, mock.Anything, mock.Anything).Return(model.ReleaseAttachment{FilePath: "releases/old/changelog.pdf"}, nil)
+				storage.On("UploadFile", mock.Anything, mock.MatchedBy(func(filePath string) bool {
+					return filePath != "releases/old/changelog.pdf"
+				}), mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("UpdateReleaseAttachment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Run(func(args mock.Arguments) {
+						updateFn := args.Get(3).(func(model.ReleaseAttachment) (model.ReleaseAttachment, error))
+						_, _ = updateFn(model.ReleaseAttachment{FilePath: "releases/old/changelog.pdf"})
+					}).
+					Return(nil)
+				storage.On("DeleteFile", mock.Anything, "releases/old/changelog.pdf").Return(nil)
 			},
 			wantErr: false,
 		},
 		{
-			name: "Invalid input",
-			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
-				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
-				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
-					GithubRepo: &model.GithubRepo{
-						OwnerSlug: "owner",
-						RepoSlug:  "repo",
-					},
-				}, nil)
+			name: "Replaced external attachment has no file to delete",
+			input: model.ReleaseAttachmentInput{
+				Name:        "changelog.pdf",
+				ContentType: "application/pdf",
+				Content:     strings.NewReader("content"),
 			},
-			input: model.GithubReleaseNotesInput{
-				GitTagName:         nil,
-				PreviousGitTagName: pointer.StringPtr("v1.0.0"),
+			mockSetup: func(auth *svc.AuthorizationService, storage *storage.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadReleaseAttachment", mock.Anything, mock.Anything, mock.Anything).Return(model.ReleaseAttachment{ExternalURL: &url.URL{Scheme: "https", Host: "github.com"}}, nil)
+				storage.On("UploadFile", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("UpdateReleaseAttachment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Run(func(args mock.Arguments) {
+						updateFn := args.Get(3).(func(model.ReleaseAttachment) (model.ReleaseAttachment, error))
+						_, _ = updateFn(model.ReleaseAttachment{ExternalURL: &url.URL{Scheme: "https", Host: "github.com"}})
+					}).
+					Return(nil)
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 		{
-			name: "Github integration not enabled",
-			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
-				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken(""), svcerrors.NewGithubIntegrationNotEnabledError())
+			name: "Unauthorized",
+			input: model.ReleaseAttachmentInput{
+				Name:        "changelog.pdf",
+				ContentType: "application/pdf",
+				Content:     strings.NewReader("content"),
 			},
-			input: model.GithubReleaseNotesInput{
-				GitTagName:         pointer.StringPtr("v2.0.0"),
-				PreviousGitTagName: pointer.StringPtr("v1.0.0"),
+			mockSetup: func(auth *svc.AuthorizationService, storage *storage.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewInsufficientProjectRoleError())
 			},
 			wantErr: true,
 		},
 		{
-			name: "Project not found",
-			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
-				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
-				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{}, svcerrors.NewProjectNotFoundError())
+			name: "Attachment not found",
+			input: model.ReleaseAttachmentInput{
+				Name:        "changelog.pdf",
+				ContentType: "application/pdf",
+				Content:     strings.NewReader("content"),
 			},
-			input: model.GithubReleaseNotesInput{
-				GitTagName:         pointer.StringPtr("v2.0.0"),
-				PreviousGitTagName: pointer.StringPtr("v1.0.0"),
+			mockSetup: func(auth *svc.AuthorizationService, storage *storage.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadReleaseAttachment", mock.Anything, mock.Anything, mock.Anything).Return(model.ReleaseAttachment{}, svcerrors.NewReleaseAttachmentNotFoundError())
 			},
 			wantErr: true,
 		},
 		{
-			name: "Github repo not set for project",
-			mockSetup: func(auth *svc.AuthorizationService, settingsSvc *svc.SettingsService, projectSvc *svc.ProjectService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
-				auth.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
-				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{}, nil)
+			name: "Uploaded file is deleted when the attachment cannot be updated",
+			input: model.ReleaseAttachmentInput{
+				Name:        "changelog.pdf",
+				ContentType: "application/pdf",
+				Content:     strings.NewReader("content"),
 			},
-			input: model.GithubReleaseNotesInput{
-				GitTagName:         pointer.StringPtr("v2.0.0"),
-				PreviousGitTagName: pointer.StringPtr("v1.0.0"),
+			mockSetup: func(auth *svc.AuthorizationService, storage *storage.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadReleaseAttachment", mock.Anything, mock.Anything, mock.Anything).Return(model.ReleaseAttachment{FilePath: "releases/old/changelog.pdf"}, nil)
+				storage.On("UploadFile", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("UpdateReleaseAttachment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewReleaseAttachmentNotFoundError())
+				storage.On("DeleteFile", mock.Anything, mock.MatchedBy(func(filePath string) bool {
+					return filePath != "releases/old/changelog.pdf"
+				})).Return(nil)
 			},
 			wantErr: true,
 		},
@@ -683,11 +2597,15 @@ func TestReleaseService_GenerateGithubReleaseNotes(t *testing.T) {
 			releaseRepo := new(repo.ReleaseRepository)
 			slackClient := new(slack.Client)
 			githubClient := new(github.Client)
-			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, slackClient, githubClient, releaseRepo)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
 
-			tc.mockSetup(authSvc, settingsSvc, projectSvc, githubClient, releaseRepo)
+			tc.mockSetup(authSvc, storageClient, releaseRepo)
 
-			_, err := service.GenerateGithubReleaseNotes(context.TODO(), tc.input, id.NewProject(), id.AuthUser{})
+			_, err := service.ReplaceReleaseAttachment(context.TODO(), tc.input, id.NewRelease(), uuid.New(), id.AuthUser{})
 
 			if tc.wantErr {
 				assert.Error(t, err)
@@ -696,68 +2614,56 @@ func TestReleaseService_GenerateGithubReleaseNotes(t *testing.T) {
 			}
 
 			authSvc.AssertExpectations(t)
-			settingsSvc.AssertExpectations(t)
-			projectSvc.AssertExpectations(t)
-			githubClient.AssertExpectations(t)
+			storageClient.AssertExpectations(t)
 			releaseRepo.AssertExpectations(t)
 		})
 	}
 }
 
-func TestReleaseService_CreateDeployment(t *testing.T) {
+func TestReleaseService_DeleteReleaseAttachment(t *testing.T) {
 	testCases := []struct {
 		name      string
-		input     model.CreateDeploymentInput
-		mockSetup func(*svc.AuthorizationService, *svc.ProjectService, *repo.ReleaseRepository)
+		mockSetup func(*svc.AuthorizationService, *storage.Client, *repo.ReleaseRepository)
 		wantErr   bool
 	}{
 		{
-			name: "success",
-			input: model.CreateDeploymentInput{
-				ReleaseID:     id.NewRelease(),
-				EnvironmentID: id.NewEnvironment(),
-			},
-			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
-				authSvc.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				releaseRepo.On("ReadReleaseForProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Release{}, nil)
-				projectSvc.On("GetEnvironment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.Environment{}, nil)
-				releaseRepo.On("CreateDeployment", mock.Anything, mock.Anything).Return(nil)
+			name: "Success",
+			mockSetup: func(auth *svc.AuthorizationService, storage *storage.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("DeleteReleaseAttachment", mock.Anything, mock.Anything, mock.Anything).Return(model.ReleaseAttachment{FilePath: "releases/changelog.pdf"}, nil)
+				storage.On("DeleteFile", mock.Anything, "releases/changelog.pdf").Return(nil)
 			},
 			wantErr: false,
 		},
 		{
-			name: "invalid input",
-			input: model.CreateDeploymentInput{
-				ReleaseID:     id.Release(uuid.Nil),
-				EnvironmentID: id.Environment(uuid.Nil),
-			},
-			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
-				authSvc.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			name: "File deletion failure does not fail deletion",
+			mockSetup: func(auth *svc.AuthorizationService, storage *storage.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("DeleteReleaseAttachment", mock.Anything, mock.Anything, mock.Anything).Return(model.ReleaseAttachment{FilePath: "releases/changelog.pdf"}, nil)
+				storage.On("DeleteFile", mock.Anything, "releases/changelog.pdf").Return(errors.New("storage unavailable"))
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 		{
-			name: "release not found",
-			input: model.CreateDeploymentInput{
-				ReleaseID:     id.NewRelease(),
-				EnvironmentID: id.NewEnvironment(),
+			name: "External attachment has no file to delete",
+			mockSetup: func(auth *svc.AuthorizationService, storage *storage.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("DeleteReleaseAttachment", mock.Anything, mock.Anything, mock.Anything).Return(model.ReleaseAttachment{ExternalURL: &url.URL{Scheme: "https", Host: "github.com"}}, nil)
 			},
-			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
-				authSvc.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				releaseRepo.On("ReadReleaseForProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Release{}, svcerrors.NewReleaseNotFoundError())
+			wantErr: false,
+		},
+		{
+			name: "Unauthorized",
+			mockSetup: func(auth *svc.AuthorizationService, storage *storage.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewInsufficientProjectRoleError())
 			},
 			wantErr: true,
 		},
 		{
-			name: "env not found",
-			input: model.CreateDeploymentInput{
-				ReleaseID:     id.NewRelease(),
-				EnvironmentID: id.NewEnvironment(),
-			},
-			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
-				authSvc.On("AuthorizeProjectRoleEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				releaseRepo.On("ReadReleaseForProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Release{}, nil)
-				projectSvc.On("GetEnvironment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.Environment{}, svcerrors.NewEnvironmentNotFoundError())
+			name: "Attachment not found",
+			mockSetup: func(auth *svc.AuthorizationService, storage *storage.Client, releaseRepo *repo.ReleaseRepository) {
+				auth.On("AuthorizeReleaseEditor", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("DeleteReleaseAttachment", mock.Anything, mock.Anything, mock.Anything).Return(model.ReleaseAttachment{}, svcerrors.NewReleaseAttachmentNotFoundError())
 			},
 			wantErr: true,
 		},
@@ -771,11 +2677,16 @@ func TestReleaseService_CreateDeployment(t *testing.T) {
 			releaseRepo := new(repo.ReleaseRepository)
 			slackClient := new(slack.Client)
 			githubClient := new(github.Client)
-			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, slackClient, githubClient, releaseRepo)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
 
-			tc.mockSetup(authSvc, projectSvc, releaseRepo)
+			tc.mockSetup(authSvc, storageClient, releaseRepo)
+
+			err := service.DeleteReleaseAttachment(context.TODO(), id.NewRelease(), uuid.New(), id.AuthUser{})
 
-			_, err := service.CreateDeployment(context.TODO(), tc.input, id.NewProject(), id.AuthUser{})
 			if tc.wantErr {
 				assert.Error(t, err)
 			} else {
@@ -783,81 +2694,91 @@ func TestReleaseService_CreateDeployment(t *testing.T) {
 			}
 
 			authSvc.AssertExpectations(t)
-			projectSvc.AssertExpectations(t)
+			storageClient.AssertExpectations(t)
 			releaseRepo.AssertExpectations(t)
 		})
 	}
 }
 
-func TestReleaseService_ListDeploymentsForProject(t *testing.T) {
-	envID := id.NewEnvironment()
-	rlsID := id.NewRelease()
+func TestReleaseService_ApproveDeployment(t *testing.T) {
+	authUserID := id.AuthUser(uuid.New())
+	approver := model.ProjectMember{User: model.User{ID: id.User(authUserID)}, ProjectRole: model.ProjectRoleOwner}
+	githubDeploymentID := int64(42)
+	mirroredDpl := model.Deployment{
+		Status:             model.DeploymentStatusPendingApproval,
+		Approvals:          []model.DeploymentApproval{},
+		Environment:        model.Environment{ApprovalPolicy: model.ApprovalPolicy{RequiredApprovals: 1, ApproverRoles: []model.ProjectRole{model.ProjectRoleOwner}}},
+		GithubDeploymentID: &githubDeploymentID,
+	}
 
 	testCases := []struct {
 		name      string
-		params    model.ListDeploymentsFilterParams
-		mockSetup func(*svc.AuthorizationService, *svc.ProjectService, *repo.ReleaseRepository)
+		mockSetup func(*svc.AuthorizationService, *svc.ProjectService, *svc.SettingsService, *github.Client, *repo.ReleaseRepository)
 		wantErr   bool
 	}{
 		{
-			name:   "Deployments fetched successfully - without params",
-			params: model.ListDeploymentsFilterParams{},
-			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+			name: "success",
+			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, settingsSvc *svc.SettingsService, githubClient *github.Client, releaseRepo *repo.ReleaseRepository) {
 				authSvc.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				releaseRepo.On("ListDeploymentsForProject", mock.Anything, mock.Anything, mock.Anything).Return([]model.Deployment{
-					{
-						ID: id.NewDeployment(),
-					},
-					{
-						ID: id.NewDeployment(),
-					},
-				}, nil)
+				releaseRepo.On("ReadDeployment", mock.Anything, mock.Anything, mock.Anything).Return(model.Deployment{}, nil)
+				projectSvc.On("ListEnvironmentApprovers", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]model.ProjectMember{approver}, nil)
+				releaseRepo.On("UpdateDeployment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 			},
 			wantErr: false,
 		},
 		{
-			name: "Deployments fetched successfully - with valid params",
-			params: model.ListDeploymentsFilterParams{
-				EnvironmentID: &envID,
-				ReleaseID:     &rlsID,
-			},
-			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+			name: "success - status mirrored to github",
+			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, settingsSvc *svc.SettingsService, githubClient *github.Client, releaseRepo *repo.ReleaseRepository) {
 				authSvc.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				releaseRepo.On("ReadReleaseForProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Release{}, nil)
-				projectSvc.On("GetEnvironment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.Environment{}, nil)
-				releaseRepo.On("ListDeploymentsForProject", mock.Anything, mock.Anything, mock.Anything).Return([]model.Deployment{
-					{
-						ID: id.NewDeployment(),
-					},
-					{
-						ID: id.NewDeployment(),
-					},
+				releaseRepo.On("ReadDeployment", mock.Anything, mock.Anything, mock.Anything).Return(mirroredDpl, nil)
+				projectSvc.On("ListEnvironmentApprovers", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]model.ProjectMember{approver}, nil)
+				releaseRepo.On("UpdateDeployment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Run(func(args mock.Arguments) {
+						updateFn := args.Get(3).(func(model.Deployment) (model.Deployment, error))
+						_, _ = updateFn(mirroredDpl)
+					}).
+					Return(nil)
+				settingsSvc.On("GetGithubToken", mock.Anything).Return(model.GithubToken("token"), nil)
+				projectSvc.On("GetProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Project{
+					GithubRepo: &model.GithubRepo{OwnerSlug: "owner", RepoSlug: "repo"},
 				}, nil)
+				githubClient.On("CreateDeploymentStatus", mock.Anything, mock.Anything, mock.Anything, githubDeploymentID, mock.MatchedBy(func(d model.Deployment) bool {
+					return d.GithubDeploymentState() == model.GithubDeploymentStateSuccess
+				})).Return(nil)
 			},
 			wantErr: false,
 		},
 		{
-			name: "Deployments fetched successfully - release provided in params not found",
-			params: model.ListDeploymentsFilterParams{
-				EnvironmentID: &envID,
-				ReleaseID:     &rlsID,
+			name: "unauthorized",
+			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, settingsSvc *svc.SettingsService, githubClient *github.Client, releaseRepo *repo.ReleaseRepository) {
+				authSvc.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewInsufficientProjectRoleError())
 			},
-			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+			wantErr: true,
+		},
+		{
+			name: "deployment not found",
+			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, settingsSvc *svc.SettingsService, githubClient *github.Client, releaseRepo *repo.ReleaseRepository) {
 				authSvc.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				releaseRepo.On("ReadReleaseForProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Release{}, svcerrors.NewReleaseNotFoundError())
+				releaseRepo.On("ReadDeployment", mock.Anything, mock.Anything, mock.Anything).Return(model.Deployment{}, svcerrors.NewDeploymentNotFoundError())
 			},
 			wantErr: true,
 		},
 		{
-			name: "Deployments fetched successfully - env provided in params not found",
-			params: model.ListDeploymentsFilterParams{
-				EnvironmentID: &envID,
-				ReleaseID:     &rlsID,
+			name: "user is not approver",
+			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, settingsSvc *svc.SettingsService, githubClient *github.Client, releaseRepo *repo.ReleaseRepository) {
+				authSvc.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadDeployment", mock.Anything, mock.Anything, mock.Anything).Return(model.Deployment{}, nil)
+				projectSvc.On("ListEnvironmentApprovers", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]model.ProjectMember{}, nil)
 			},
-			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+			wantErr: true,
+		},
+		{
+			name: "deployment not pending approval",
+			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, settingsSvc *svc.SettingsService, githubClient *github.Client, releaseRepo *repo.ReleaseRepository) {
 				authSvc.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				releaseRepo.On("ReadReleaseForProject", mock.Anything, mock.Anything, mock.Anything).Return(model.Release{}, nil)
-				projectSvc.On("GetEnvironment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.Environment{}, svcerrors.NewEnvironmentNotFoundError())
+				releaseRepo.On("ReadDeployment", mock.Anything, mock.Anything, mock.Anything).Return(model.Deployment{}, nil)
+				projectSvc.On("ListEnvironmentApprovers", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]model.ProjectMember{approver}, nil)
+				releaseRepo.On("UpdateDeployment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(svcerrors.NewDeploymentInvalidError())
 			},
 			wantErr: true,
 		},
@@ -871,11 +2792,15 @@ func TestReleaseService_ListDeploymentsForProject(t *testing.T) {
 			releaseRepo := new(repo.ReleaseRepository)
 			slackClient := new(slack.Client)
 			githubClient := new(github.Client)
-			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, slackClient, githubClient, releaseRepo)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
 
-			tc.mockSetup(authSvc, projectSvc, releaseRepo)
+			tc.mockSetup(authSvc, projectSvc, settingsSvc, githubClient, releaseRepo)
 
-			_, err := service.ListDeploymentsForProject(context.TODO(), tc.params, id.NewProject(), id.AuthUser{})
+			_, err := service.ApproveDeployment(context.TODO(), id.NewProject(), id.NewDeployment(), authUserID)
 			if tc.wantErr {
 				assert.Error(t, err)
 			} else {
@@ -884,63 +2809,38 @@ func TestReleaseService_ListDeploymentsForProject(t *testing.T) {
 
 			authSvc.AssertExpectations(t)
 			projectSvc.AssertExpectations(t)
+			settingsSvc.AssertExpectations(t)
+			githubClient.AssertExpectations(t)
 			releaseRepo.AssertExpectations(t)
 		})
 	}
 }
 
-func TestReleaseService_DeleteReleaseOnGitTagRemoval(t *testing.T) {
+func TestReleaseService_RejectDeployment(t *testing.T) {
+	authUserID := id.AuthUser(uuid.New())
+	approver := model.ProjectMember{User: model.User{ID: id.User(authUserID)}, ProjectRole: model.ProjectRoleOwner}
+
 	testCases := []struct {
-		name            string
-		mockSetup       func(*svc.SettingsService, *github.Client, *repo.ReleaseRepository)
-		deletedTagInput model.GithubTagDeletionWebhookInput
-		wantErr         bool
+		name      string
+		mockSetup func(*svc.AuthorizationService, *svc.ProjectService, *repo.ReleaseRepository)
+		wantErr   bool
 	}{
 		{
-			name: "Success",
-			deletedTagInput: model.GithubTagDeletionWebhookInput{
-				RawPayload: make([]byte, 0),
-				Signature:  "signature",
-			},
-			mockSetup: func(settingsSvc *svc.SettingsService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
-				settingsSvc.On("GetGithubSettings", mock.Anything).Return(model.GithubSettings{
-					Enabled:       true,
-					Token:         "token",
-					WebhookSecret: "secret",
-				}, nil)
-				github.On("ParseTagDeletionWebhook", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.GithubTagDeletionWebhookOutput{}, nil)
-				releaseRepo.On("DeleteReleaseByGitTag", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			name: "success",
+			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				authSvc.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadDeployment", mock.Anything, mock.Anything, mock.Anything).Return(model.Deployment{}, nil)
+				projectSvc.On("ListEnvironmentApprovers", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]model.ProjectMember{approver}, nil)
+				releaseRepo.On("UpdateDeployment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 			},
 			wantErr: false,
 		},
 		{
-			name: "Github settings not enabled",
-			deletedTagInput: model.GithubTagDeletionWebhookInput{
-				RawPayload: make([]byte, 0),
-				Signature:  "signature",
-			},
-			mockSetup: func(settingsSvc *svc.SettingsService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
-				settingsSvc.On("GetGithubSettings", mock.Anything).Return(model.GithubSettings{
-					Enabled:       false,
-					Token:         "token",
-					WebhookSecret: "secret",
-				}, nil)
-			},
-			wantErr: true,
-		},
-		{
-			name: "Error parsing delete tag event",
-			deletedTagInput: model.GithubTagDeletionWebhookInput{
-				RawPayload: make([]byte, 0),
-				Signature:  "signature",
-			},
-			mockSetup: func(settingsSvc *svc.SettingsService, github *github.Client, releaseRepo *repo.ReleaseRepository) {
-				settingsSvc.On("GetGithubSettings", mock.Anything).Return(model.GithubSettings{
-					Enabled:       true,
-					Token:         "token",
-					WebhookSecret: "secret",
-				}, nil)
-				github.On("ParseTagDeletionWebhook", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.GithubTagDeletionWebhookOutput{}, svcerrors.NewInvalidGithubTagDeletionWebhookError())
+			name: "user is not approver",
+			mockSetup: func(authSvc *svc.AuthorizationService, projectSvc *svc.ProjectService, releaseRepo *repo.ReleaseRepository) {
+				authSvc.On("AuthorizeProjectRoleViewer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				releaseRepo.On("ReadDeployment", mock.Anything, mock.Anything, mock.Anything).Return(model.Deployment{}, nil)
+				projectSvc.On("ListEnvironmentApprovers", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]model.ProjectMember{}, nil)
 			},
 			wantErr: true,
 		},
@@ -954,20 +2854,23 @@ func TestReleaseService_DeleteReleaseOnGitTagRemoval(t *testing.T) {
 			releaseRepo := new(repo.ReleaseRepository)
 			slackClient := new(slack.Client)
 			githubClient := new(github.Client)
-			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, slackClient, githubClient, releaseRepo)
-
-			tc.mockSetup(settingsSvc, githubClient, releaseRepo)
+			gitlabClient := new(gitlab.Client)
+			bitbucketClient := new(bitbucket.Client)
+			emailClient := new(resend.Client)
+			storageClient := new(storage.Client)
+			service := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, projectSvc, slackClient, emailClient, githubClient, gitlabClient, bitbucketClient, storageClient, releaseRepo)
 
-			err := service.DeleteReleaseOnGitTagRemoval(context.TODO(), tc.deletedTagInput)
+			tc.mockSetup(authSvc, projectSvc, releaseRepo)
 
+			_, err := service.RejectDeployment(context.TODO(), id.NewProject(), id.NewDeployment(), authUserID)
 			if tc.wantErr {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
 			}
 
-			settingsSvc.AssertExpectations(t)
-			githubClient.AssertExpectations(t)
+			authSvc.AssertExpectations(t)
+			projectSvc.AssertExpectations(t)
 			releaseRepo.AssertExpectations(t)
 		})
 	}
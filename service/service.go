@@ -2,9 +2,12 @@ package service
 
 import (
 	"context"
+	"io"
 
 	"release-manager/pkg/id"
 	"release-manager/service/model"
+
+	"github.com/google/uuid"
 )
 
 type projectRepository interface {
@@ -12,6 +15,9 @@ type projectRepository interface {
 	ReadProject(ctx context.Context, id id.Project) (model.Project, error)
 	ListProjects(ctx context.Context) ([]model.Project, error)
 	ListProjectsForUser(ctx context.Context, userID id.AuthUser) ([]model.Project, error)
+	ListProjectsByGithubRepo(ctx context.Context, repo model.GithubRepo) ([]model.Project, error)
+	ListProjectsByGitlabRepo(ctx context.Context, gitlabProjectID int64) ([]model.Project, error)
+	ListProjectsByBitbucketRepo(ctx context.Context, workspace, repoSlug string) ([]model.Project, error)
 	DeleteProject(ctx context.Context, id id.Project) error
 	UpdateProject(
 		ctx context.Context,
@@ -30,6 +36,8 @@ type projectRepository interface {
 	DeleteEnvironment(ctx context.Context, projectID id.Project, envID id.Environment) error
 	ListEnvironmentsForProject(ctx context.Context, projectID id.Project) ([]model.Environment, error)
 
+	ListReleaseGitTagNamesForProject(ctx context.Context, projectID id.Project) ([]string, error)
+
 	CreateInvitation(ctx context.Context, i model.ProjectInvitation) error
 	ListInvitationsForProject(ctx context.Context, projectID id.Project) ([]model.ProjectInvitation, error)
 	DeleteInvitation(ctx context.Context, projectID id.Project, invitationID id.ProjectInvitation) error
@@ -79,20 +87,70 @@ type settingsRepository interface {
 
 type releaseRepository interface {
 	CreateRelease(ctx context.Context, r model.Release) error
+	// ImportRelease creates the release together with its attachments.
+	ImportRelease(ctx context.Context, r model.Release) error
 	ReadRelease(ctx context.Context, releaseID id.Release) (model.Release, error)
 	ReadReleaseForProject(ctx context.Context, projectID id.Project, releaseID id.Release) (model.Release, error)
 	DeleteRelease(ctx context.Context, releaseID id.Release) error
-	DeleteReleaseByGitTag(ctx context.Context, repo model.GithubRepo, tagName string) error
-	ListReleasesForProject(ctx context.Context, projectID id.Project) ([]model.Release, error)
+	DeleteReleaseForProjectByGitTag(ctx context.Context, projectID id.Project, tagName string) error
+	ReadLastPublishedRelease(ctx context.Context, projectID id.Project) (model.Release, error)
+	ReadPreviousPublishedRelease(ctx context.Context, rls model.Release) (model.Release, error)
+	ListReleasesForProject(ctx context.Context, params model.ListReleasesFilterParams, projectID id.Project) (model.ReleasePage, error)
 	UpdateRelease(
 		ctx context.Context,
 		releaseID id.Release,
-		updateFn func(r model.Release) (model.Release, error),
+		updateFn func(r model.Release) (model.Release, *model.ReleaseRevision, error),
+	) error
+	SearchReleases(ctx context.Context, params model.SearchReleasesParams, projectIDs []id.Project) ([]model.ReleaseSearchHit, error)
+	ReadReleaseRevision(ctx context.Context, releaseID id.Release, revisionNumber int) (model.ReleaseRevision, error)
+	ListReleaseRevisions(ctx context.Context, releaseID id.Release) ([]model.ReleaseRevision, error)
+	CreateGithubWebhookDelivery(ctx context.Context, d model.GithubWebhookDelivery) error
+	ReadGithubWebhookDelivery(ctx context.Context, deliveryID string) (model.GithubWebhookDelivery, error)
+	ListGithubWebhookDeliveries(ctx context.Context, params model.ListGithubWebhookDeliveriesParams) ([]model.GithubWebhookDelivery, error)
+	UpdateGithubWebhookDelivery(
+		ctx context.Context,
+		deliveryID string,
+		updateFn func(d model.GithubWebhookDelivery) (model.GithubWebhookDelivery, error),
 	) error
 
 	CreateDeployment(ctx context.Context, d model.Deployment) error
 	ListDeploymentsForProject(ctx context.Context, params model.ListDeploymentsFilterParams, projectID id.Project) ([]model.Deployment, error)
 	ReadLastDeploymentForRelease(ctx context.Context, releaseID id.Release) (model.Deployment, error)
+	ReadDeployment(ctx context.Context, projectID id.Project, deploymentID id.Deployment) (model.Deployment, error)
+	UpdateDeployment(
+		ctx context.Context,
+		projectID id.Project,
+		deploymentID id.Deployment,
+		updateFn func(d model.Deployment) (model.Deployment, error),
+	) error
+
+	CreateReleaseAttachment(ctx context.Context, releaseID id.Release, a model.ReleaseAttachment) error
+	ReadReleaseAttachment(ctx context.Context, releaseID id.Release, attachmentID uuid.UUID) (model.ReleaseAttachment, error)
+	UpdateReleaseAttachment(
+		ctx context.Context,
+		releaseID id.Release,
+		attachmentID uuid.UUID,
+		updateFn func(a model.ReleaseAttachment) (model.ReleaseAttachment, error),
+	) error
+	DeleteReleaseAttachment(ctx context.Context, releaseID id.Release, attachmentID uuid.UUID) (model.ReleaseAttachment, error)
+
+	CreateReleasePlan(ctx context.Context, p model.ReleasePlan) error
+	ReadReleasePlan(ctx context.Context, projectID id.Project, planID id.ReleasePlan) (model.ReleasePlan, error)
+	ListReleasePlansForProject(ctx context.Context, params model.ListReleasePlansFilterParams, projectID id.Project) ([]model.ReleasePlan, error)
+	UpdateReleasePlan(
+		ctx context.Context,
+		projectID id.Project,
+		planID id.ReleasePlan,
+		updateFn func(p model.ReleasePlan) (model.ReleasePlan, error),
+	) error
+	DeleteReleasePlan(ctx context.Context, projectID id.Project, planID id.ReleasePlan) error
+	// ConvertReleasePlan creates the release returned by convertFn and updates the plan in a single transaction.
+	ConvertReleasePlan(
+		ctx context.Context,
+		projectID id.Project,
+		planID id.ReleasePlan,
+		convertFn func(p model.ReleasePlan) (model.ReleasePlan, model.Release, error),
+	) error
 }
 
 type authGuard interface {
@@ -109,6 +167,10 @@ type settingsGetter interface {
 	GetSlackToken(ctx context.Context) (model.SlackToken, error)
 	GetDefaultReleaseMessage(ctx context.Context) (string, error)
 	GetGithubSettings(ctx context.Context) (model.GithubSettings, error)
+	GetGitlabSettings(ctx context.Context) (model.GitlabSettings, error)
+	GetGitlabInstance(ctx context.Context) (model.GitlabInstance, error)
+	GetBitbucketSettings(ctx context.Context) (model.BitbucketSettings, error)
+	GetBitbucketInstance(ctx context.Context) (model.BitbucketInstance, error)
 }
 
 type userGetter interface {
@@ -118,40 +180,112 @@ type userGetter interface {
 
 type projectGetter interface {
 	GetProject(ctx context.Context, projectID id.Project, authUserID id.AuthUser) (model.Project, error)
+	ListProjects(ctx context.Context, authUserID id.AuthUser) ([]model.Project, error)
+	ListProjectsByGithubRepo(ctx context.Context, repo model.GithubRepo) ([]model.Project, error)
+	GetProjectByGithubRepo(ctx context.Context, repo model.GithubRepo, tagName string) (model.Project, error)
+	GetProjectByGitlabRepo(ctx context.Context, repo model.GitlabRepo, tagName string) (model.Project, error)
+	GetProjectByBitbucketRepo(ctx context.Context, repo model.BitbucketRepo, tagName string) (model.Project, error)
+	IsGitRepoShared(ctx context.Context, p model.Project) (bool, error)
 }
 
 type environmentGetter interface {
 	GetEnvironment(ctx context.Context, projectID id.Project, envID id.Environment, authUserID id.AuthUser) (model.Environment, error)
 }
 
+type memberGetter interface {
+	GetMember(ctx context.Context, projectID id.Project, userID id.User, authUserID id.AuthUser) (model.ProjectMember, error)
+	ListEnvironmentApprovers(ctx context.Context, projectID id.Project, envID id.Environment, authUserID id.AuthUser) ([]model.ProjectMember, error)
+}
+
 type githubManager interface {
 	ReadRepo(ctx context.Context, tkn model.GithubToken, rawRepoURL string) (model.GithubRepo, error)
-	ReadTagsForRepo(ctx context.Context, tkn model.GithubToken, repo model.GithubRepo) ([]model.GitTag, error)
+	ReadTagsForRepo(ctx context.Context, tkn model.GithubToken, repo model.GithubRepo) ([]model.RepoGitTag, error)
 	DeleteReleaseByTag(ctx context.Context, tkn model.GithubToken, repo model.GithubRepo, tag model.GitTag) error
 	ReadTag(ctx context.Context, tkn model.GithubToken, repo model.GithubRepo, tagName string) (model.GitTag, error)
+	CreateTag(ctx context.Context, tkn model.GithubToken, repo model.GithubRepo, tagName, target, message string) (model.GitTag, error)
+	DeleteTag(ctx context.Context, tkn model.GithubToken, repo model.GithubRepo, tagName string) error
 	UpsertRelease(ctx context.Context, tkn model.GithubToken, repo model.GithubRepo, rls model.Release) error
+	ListReleases(ctx context.Context, tkn model.GithubToken, repo model.GithubRepo) ([]model.GithubRelease, error)
 	GenerateReleaseNotes(
 		ctx context.Context,
 		tkn model.GithubToken,
 		repo model.GithubRepo,
-		input model.GithubReleaseNotesInput,
-	) (model.GithubReleaseNotes, error)
+		input model.GitReleaseNotesInput,
+	) (model.GitReleaseNotes, error)
+	CompareTags(
+		ctx context.Context,
+		tkn model.GithubToken,
+		repo model.GithubRepo,
+		baseTagName string,
+		headTagName string,
+	) (model.GitTagComparison, error)
+	ListReleaseLinkedItems(
+		ctx context.Context,
+		tkn model.GithubToken,
+		repo model.GithubRepo,
+		previousTagName string,
+		tagName string,
+	) (model.ReleaseLinkedItems, error)
 	ParseTagDeletionWebhook(
 		ctx context.Context,
 		input model.GithubTagDeletionWebhookInput,
 		tkn model.GithubToken,
 		secret model.GithubWebhookSecret,
 	) (model.GithubTagDeletionWebhookOutput, error)
+	ParseTagCreationWebhook(
+		ctx context.Context,
+		input model.GithubTagCreationWebhookInput,
+		tkn model.GithubToken,
+		secret model.GithubWebhookSecret,
+	) (model.GithubTagCreationWebhookOutput, error)
+	ReadWebhookDeliveryInfo(input model.GithubWebhookDeliveryInput, secret model.GithubWebhookSecret) model.GithubWebhookDeliveryInfo
+	ReadWebhookRepo(rawPayload []byte) (model.GithubRepo, bool)
+	CreateRepoWebhook(ctx context.Context, tkn model.GithubToken, repo model.GithubRepo, secret model.GithubWebhookSecret) (int64, error)
+	UpdateRepoWebhookSecret(ctx context.Context, tkn model.GithubToken, repo model.GithubRepo, hookID int64, secret model.GithubWebhookSecret) error
+	DeleteRepoWebhook(ctx context.Context, tkn model.GithubToken, repo model.GithubRepo, hookID int64) error
+	CreateInstallationToken(ctx context.Context, app model.GithubAppSettings) (model.GithubToken, error)
+	CreateDeployment(ctx context.Context, tkn model.GithubToken, repo model.GithubRepo, dpl model.Deployment) (int64, error)
+	CreateDeploymentStatus(ctx context.Context, tkn model.GithubToken, repo model.GithubRepo, githubDeploymentID int64, dpl model.Deployment) error
+}
+
+type gitlabManager interface {
+	ReadRepo(ctx context.Context, inst model.GitlabInstance, rawRepoURL string) (model.GitlabRepo, error)
+	ReadTagsForRepo(ctx context.Context, inst model.GitlabInstance, repo model.GitlabRepo) ([]model.RepoGitTag, error)
+	ReadTag(ctx context.Context, inst model.GitlabInstance, repo model.GitlabRepo, tagName string) (model.GitTag, error)
+	CreateTag(ctx context.Context, inst model.GitlabInstance, repo model.GitlabRepo, tagName, target, message string) (model.GitTag, error)
+	DeleteTag(ctx context.Context, inst model.GitlabInstance, repo model.GitlabRepo, tagName string) error
+	UpsertRelease(ctx context.Context, inst model.GitlabInstance, repo model.GitlabRepo, rls model.Release) error
+	DeleteReleaseByTag(ctx context.Context, inst model.GitlabInstance, repo model.GitlabRepo, tag model.GitTag) error
+	GenerateReleaseNotes(
+		ctx context.Context,
+		inst model.GitlabInstance,
+		repo model.GitlabRepo,
+		input model.GitReleaseNotesInput,
+	) (model.GitReleaseNotes, error)
+	ParseTagPushWebhook(input model.GitlabWebhookInput, secret model.GitlabWebhookSecret) (model.GitlabTagPushWebhookOutput, error)
+}
+
+type bitbucketManager interface {
+	ReadRepo(ctx context.Context, inst model.BitbucketInstance, rawRepoURL string) (model.BitbucketRepo, error)
+	ReadTagsForRepo(ctx context.Context, inst model.BitbucketInstance, repo model.BitbucketRepo) ([]model.RepoGitTag, error)
+	ReadTag(ctx context.Context, inst model.BitbucketInstance, repo model.BitbucketRepo, tagName string) (model.GitTag, error)
+	ParseTagWebhook(input model.BitbucketWebhookInput, secret model.BitbucketWebhookSecret) (model.BitbucketTagWebhookOutput, error)
 }
 
 type emailSender interface {
 	SendProjectInvitationEmailAsync(ctx context.Context, data model.ProjectInvitationEmailData, recipient string)
+	SendDeploymentApprovalRequestEmailAsync(ctx context.Context, data model.DeploymentApprovalRequestEmailData, recipients []string)
 }
 
 type slackNotifier interface {
 	SendReleaseNotification(ctx context.Context, tkn model.SlackToken, channel string, notification model.ReleaseNotification) error
 }
 
+type fileStorage interface {
+	UploadFile(ctx context.Context, filePath, contentType string, content io.Reader) error
+	DeleteFile(ctx context.Context, filePath string) error
+}
+
 type Service struct {
 	Authorization *AuthorizationService
 	User          *UserService
@@ -166,14 +300,30 @@ func NewService(
 	settingsRepo settingsRepository,
 	releaseRepo releaseRepository,
 	githubManager githubManager,
+	gitlabManager gitlabManager,
+	bitbucketManager bitbucketManager,
 	emailSender emailSender,
 	slackNotifier slackNotifier,
+	fileStorage fileStorage,
 ) *Service {
 	authSvc := NewAuthorizationService(userRepo, projectRepo, releaseRepo)
 	userSvc := NewUserService(authSvc, userRepo)
-	settingsSvc := NewSettingsService(authSvc, settingsRepo)
-	projectSvc := NewProjectService(authSvc, settingsSvc, userSvc, emailSender, githubManager, projectRepo)
-	releaseSvc := NewReleaseService(authSvc, projectSvc, settingsSvc, projectSvc, slackNotifier, githubManager, releaseRepo)
+	settingsSvc := NewSettingsService(authSvc, githubManager, settingsRepo)
+	projectSvc := NewProjectService(authSvc, settingsSvc, userSvc, emailSender, githubManager, gitlabManager, bitbucketManager, projectRepo)
+	releaseSvc := NewReleaseService(
+		authSvc,
+		projectSvc,
+		settingsSvc,
+		projectSvc,
+		projectSvc,
+		slackNotifier,
+		emailSender,
+		githubManager,
+		gitlabManager,
+		bitbucketManager,
+		fileStorage,
+		releaseRepo,
+	)
 
 	return &Service{
 		Authorization: authSvc,
@@ -10,14 +10,16 @@ import (
 )
 
 type SettingsService struct {
-	authGuard  authGuard
-	repository settingsRepository
+	authGuard     authGuard
+	githubManager githubManager
+	repository    settingsRepository
 }
 
-func NewSettingsService(guard authGuard, r settingsRepository) *SettingsService {
+func NewSettingsService(guard authGuard, githubManager githubManager, r settingsRepository) *SettingsService {
 	return &SettingsService{
-		authGuard:  guard,
-		repository: r,
+		authGuard:     guard,
+		githubManager: githubManager,
+		repository:    r,
 	}
 }
 
@@ -52,6 +54,8 @@ func (s *SettingsService) Update(ctx context.Context, input model.UpdateSettings
 	return nil
 }
 
+// GetGithubToken returns the token used for GitHub API calls.
+// If the GitHub App is configured, an installation token is returned instead of the personal access token.
 func (s *SettingsService) GetGithubToken(ctx context.Context) (model.GithubToken, error) {
 	settings, err := s.repository.Read(ctx)
 	if err != nil {
@@ -62,7 +66,16 @@ func (s *SettingsService) GetGithubToken(ctx context.Context) (model.GithubToken
 		return "", svcerrors.NewGithubIntegrationNotEnabledError()
 	}
 
-	return settings.Github.Token, nil
+	if !settings.Github.UsesApp() {
+		return settings.Github.Token, nil
+	}
+
+	tkn, err := s.githubManager.CreateInstallationToken(ctx, settings.Github.App)
+	if err != nil {
+		return "", fmt.Errorf("creating github app installation token: %w", err)
+	}
+
+	return tkn, nil
 }
 
 func (s *SettingsService) GetSlackToken(ctx context.Context) (model.SlackToken, error) {
@@ -87,6 +100,62 @@ func (s *SettingsService) GetGithubSettings(ctx context.Context) (model.GithubSe
 	return settings.Github, nil
 }
 
+func (s *SettingsService) GetGitlabSettings(ctx context.Context) (model.GitlabSettings, error) {
+	settings, err := s.repository.Read(ctx)
+	if err != nil {
+		return model.GitlabSettings{}, fmt.Errorf("reading settings: %w", err)
+	}
+
+	return settings.Gitlab, nil
+}
+
+// GetGitlabInstance returns the GitLab instance along with the token used for GitLab API calls.
+func (s *SettingsService) GetGitlabInstance(ctx context.Context) (model.GitlabInstance, error) {
+	settings, err := s.repository.Read(ctx)
+	if err != nil {
+		return model.GitlabInstance{}, fmt.Errorf("reading settings: %w", err)
+	}
+
+	if !settings.Gitlab.Enabled {
+		return model.GitlabInstance{}, svcerrors.NewGitlabIntegrationNotEnabledError()
+	}
+
+	inst, err := settings.Gitlab.Instance()
+	if err != nil {
+		return model.GitlabInstance{}, svcerrors.NewSettingsInvalidError().Wrap(err).WithMessage(err.Error())
+	}
+
+	return inst, nil
+}
+
+func (s *SettingsService) GetBitbucketSettings(ctx context.Context) (model.BitbucketSettings, error) {
+	settings, err := s.repository.Read(ctx)
+	if err != nil {
+		return model.BitbucketSettings{}, fmt.Errorf("reading settings: %w", err)
+	}
+
+	return settings.Bitbucket, nil
+}
+
+// GetBitbucketInstance returns the Bitbucket instance along with the token used for Bitbucket API calls.
+func (s *SettingsService) GetBitbucketInstance(ctx context.Context) (model.BitbucketInstance, error) {
+	settings, err := s.repository.Read(ctx)
+	if err != nil {
+		return model.BitbucketInstance{}, fmt.Errorf("reading settings: %w", err)
+	}
+
+	if !settings.Bitbucket.Enabled {
+		return model.BitbucketInstance{}, svcerrors.NewBitbucketIntegrationNotEnabledError()
+	}
+
+	inst, err := settings.Bitbucket.Instance()
+	if err != nil {
+		return model.BitbucketInstance{}, svcerrors.NewSettingsInvalidError().Wrap(err).WithMessage(err.Error())
+	}
+
+	return inst, nil
+}
+
 func (s *SettingsService) GetDefaultReleaseMessage(ctx context.Context) (string, error) {
 	settings, err := s.repository.Read(ctx)
 	if err != nil {
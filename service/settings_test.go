@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	githubmock "release-manager/github/mock"
 	"release-manager/pkg/id"
 	"release-manager/pkg/pointer"
 	repo "release-manager/repository/mock"
@@ -65,7 +66,8 @@ func TestSettingsService_Update(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			authSvc := new(svc.AuthorizationService)
 			settingsRepo := new(repo.SettingsRepository)
-			settingsSvc := NewSettingsService(authSvc, settingsRepo)
+			githubClient := new(githubmock.Client)
+			settingsSvc := NewSettingsService(authSvc, githubClient, settingsRepo)
 
 			tc.mockSetup(authSvc, settingsRepo)
 
@@ -82,3 +84,78 @@ func TestSettingsService_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestSettingsService_GetGithubToken(t *testing.T) {
+	app := model.GithubAppSettings{AppID: 1, InstallationID: 2, PrivateKey: "key"}
+
+	testCases := []struct {
+		name      string
+		settings  model.Settings
+		mockSetup func(*githubmock.Client)
+		wantToken model.GithubToken
+		wantErr   bool
+	}{
+		{
+			name: "Personal access token",
+			settings: model.Settings{
+				Github: model.GithubSettings{Enabled: true, Token: "pat"},
+			},
+			mockSetup: func(githubClient *githubmock.Client) {},
+			wantToken: "pat",
+			wantErr:   false,
+		},
+		{
+			name: "Installation token of the GitHub App",
+			settings: model.Settings{
+				Github: model.GithubSettings{Enabled: true, Token: "pat", App: app},
+			},
+			mockSetup: func(githubClient *githubmock.Client) {
+				githubClient.On("CreateInstallationToken", mock.Anything, app).Return(model.GithubToken("installation"), nil)
+			},
+			wantToken: "installation",
+			wantErr:   false,
+		},
+		{
+			name: "Installation token cannot be created",
+			settings: model.Settings{
+				Github: model.GithubSettings{Enabled: true, App: app},
+			},
+			mockSetup: func(githubClient *githubmock.Client) {
+				githubClient.On("CreateInstallationToken", mock.Anything, app).Return(model.GithubToken(""), svcerrors.NewGithubAppInstallationNotFoundError())
+			},
+			wantErr: true,
+		},
+		{
+			name: "Github integration not enabled",
+			settings: model.Settings{
+				Github: model.GithubSettings{Enabled: false, App: app},
+			},
+			mockSetup: func(githubClient *githubmock.Client) {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authSvc := new(svc.AuthorizationService)
+			settingsRepo := new(repo.SettingsRepository)
+			githubClient := new(githubmock.Client)
+			settingsSvc := NewSettingsService(authSvc, githubClient, settingsRepo)
+
+			settingsRepo.On("Read", mock.Anything).Return(tc.settings, nil)
+			tc.mockSetup(githubClient)
+
+			tkn, err := settingsSvc.GetGithubToken(context.Background())
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.wantToken, tkn)
+			}
+
+			settingsRepo.AssertExpectations(t)
+			githubClient.AssertExpectations(t)
+		})
+	}
+}
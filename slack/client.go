@@ -48,9 +48,36 @@ func (c *Client) SendReleaseNotification(ctx context.Context, tkn model.SlackTok
 		msgOptions.AddAttachmentField("Deployed at", n.DeployedAt.Format("2006-01-02 15:04:05"))
 	}
 
+	if n.LinkedItems != nil {
+		if len(n.LinkedItems.PullRequests) > 0 {
+			msgOptions.AddAttachmentFieldWithLinks("Pull requests", toPullRequestLinks(n.LinkedItems.PullRequests))
+		}
+		if len(n.LinkedItems.Issues) > 0 {
+			msgOptions.AddAttachmentFieldWithLinks("Closed issues", toIssueLinks(n.LinkedItems.Issues))
+		}
+	}
+
 	return c.sendMessage(ctx, tkn, channelID, msgOptions.Build())
 }
 
+func toPullRequestLinks(prs []model.GithubPullRequest) []Link {
+	links := make([]Link, 0, len(prs))
+	for _, pr := range prs {
+		links = append(links, Link{URL: pr.URL, Text: fmt.Sprintf("#%d %s", pr.Number, pr.Title)})
+	}
+
+	return links
+}
+
+func toIssueLinks(issues []model.GithubIssue) []Link {
+	links := make([]Link, 0, len(issues))
+	for _, i := range issues {
+		links = append(links, Link{URL: i.URL, Text: fmt.Sprintf("#%d %s", i.Number, i.Title)})
+	}
+
+	return links
+}
+
 func (c *Client) sendMessage(ctx context.Context, tkn model.SlackToken, channelID string, msgOptions []slack.MsgOption) error {
 	client := slack.New(tkn.String())
 
@@ -3,10 +3,16 @@ package slack
 import (
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/slack-go/slack"
 )
 
+type Link struct {
+	URL  url.URL
+	Text string
+}
+
 type MsgOptionsBuilder struct {
 	msg              string
 	msgParams        slack.PostMessageParameters
@@ -34,6 +40,16 @@ func (b *MsgOptionsBuilder) AddAttachmentFieldWithLink(title string, linkURL url
 	return b.addAttachmentField(title, link)
 }
 
+// AddAttachmentFieldWithLinks shows each link on a separate line.
+func (b *MsgOptionsBuilder) AddAttachmentFieldWithLinks(title string, links []Link) *MsgOptionsBuilder {
+	lines := make([]string, 0, len(links))
+	for _, l := range links {
+		lines = append(lines, fmt.Sprintf("<%s|%s>", l.URL.String(), l.Text))
+	}
+
+	return b.addAttachmentField(title, strings.Join(lines, "\n"))
+}
+
 func (b *MsgOptionsBuilder) addAttachmentField(title, value string) *MsgOptionsBuilder {
 	// Some of the fields can be empty (e.g. release notes).
 	// But we still want to show all fields in the message to keep the consistency and let user know that the value for field was not set.
@@ -1,8 +1,15 @@
 package storage
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"net/url"
+	"strings"
+
+	"release-manager/config"
 
 	"github.com/nedpals/supabase-go"
 )
@@ -13,14 +20,22 @@ const (
 )
 
 type Client struct {
-	client *supabase.Client
-	bucket string
+	client     *supabase.Client
+	httpClient *http.Client
+	apiURL     string
+	apiKey     string
+	bucket     string
 }
 
-func NewClient(client *supabase.Client, bucket string) *Client {
+func NewClient(client *supabase.Client, cfg config.SupabaseConfig) *Client {
 	return &Client{
 		client: client,
-		bucket: bucket,
+		// Supabase client has a fixed request timeout which is too short for uploading large files,
+		// therefore a separate HTTP client is used for uploads and request duration is controlled by the context.
+		httpClient: &http.Client{},
+		apiURL:     strings.TrimSuffix(cfg.APIURL, "/"),
+		apiKey:     cfg.APISecretKey,
+		bucket:     cfg.StorageBucket,
 	}
 }
 
@@ -37,3 +52,65 @@ func (c *Client) GenerateFileURL(filePath string) (url.URL, error) {
 
 	return *signedURL, nil
 }
+
+// UploadFile streams the content into the storage bucket, an existing file with the same path is overwritten.
+// Upload from supabase-go is not used, because it buffers the whole file, overrides the content type and panics on errors.
+func (c *Client) UploadFile(ctx context.Context, filePath, contentType string, content io.Reader) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.objectURL(filePath), content)
+	if err != nil {
+		return fmt.Errorf("creating http request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("x-upsert", "true")
+
+	if err := c.sendRequest(req); err != nil {
+		return fmt.Errorf("uploading file %s: %w", filePath, err)
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteFile(ctx context.Context, filePath string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.objectURL(filePath), nil)
+	if err != nil {
+		return fmt.Errorf("creating http request: %w", err)
+	}
+
+	if err := c.sendRequest(req); err != nil {
+		return fmt.Errorf("deleting file %s: %w", filePath, err)
+	}
+
+	return nil
+}
+
+func (c *Client) sendRequest(req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending http request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		var errResp supabase.FileErrorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
+			return fmt.Errorf("unexpected response status: %s", resp.Status)
+		}
+
+		return &errResp
+	}
+
+	return nil
+}
+
+// objectURL returns the storage API URL of the file, route for objects is /object/:bucketId/:objectKey
+func (c *Client) objectURL(filePath string) string {
+	segments := strings.Split(filePath, "/")
+	for i, s := range segments {
+		segments[i] = url.PathEscape(s)
+	}
+
+	return fmt.Sprintf("%s/%s/object/%s/%s", c.apiURL, supabase.StorageEndpoint, url.PathEscape(c.bucket), strings.Join(segments, "/"))
+}
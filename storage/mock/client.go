@@ -0,0 +1,22 @@
+package mock
+
+import (
+	"context"
+	"io"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type Client struct {
+	mock.Mock
+}
+
+func (m *Client) UploadFile(ctx context.Context, filePath, contentType string, content io.Reader) error {
+	args := m.Called(ctx, filePath, contentType, content)
+	return args.Error(0)
+}
+
+func (m *Client) DeleteFile(ctx context.Context, filePath string) error {
+	args := m.Called(ctx, filePath)
+	return args.Error(0)
+}
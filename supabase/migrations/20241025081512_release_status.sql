@@ -0,0 +1,12 @@
+BEGIN;
+
+CREATE TYPE release_status AS ENUM ('draft', 'ready', 'published', 'deprecated', 'yanked');
+
+-- Releases created before the lifecycle states were introduced were treated as published
+ALTER TABLE public.releases
+    ADD COLUMN status release_status NOT NULL DEFAULT 'published';
+
+ALTER TABLE public.releases
+    ALTER COLUMN status DROP DEFAULT;
+
+COMMIT;
@@ -0,0 +1,32 @@
+BEGIN;
+
+-- Zero required approvals means that deployments to the environment are not subject to approval
+ALTER TABLE public.environments
+    ADD COLUMN approval_policy JSON NOT NULL DEFAULT '{"required_approvals": 0, "approver_user_ids": [], "approver_roles": []}'::json;
+
+ALTER TABLE public.environments
+    ALTER COLUMN approval_policy DROP DEFAULT;
+
+CREATE TYPE deployment_status AS ENUM ('pending_approval', 'rejected', 'deployed');
+
+-- Deployments created before the approval workflow was introduced were deployed immediately
+ALTER TABLE public.deployments
+    ADD COLUMN status deployment_status NOT NULL DEFAULT 'deployed';
+
+ALTER TABLE public.deployments
+    ALTER COLUMN status DROP DEFAULT;
+
+CREATE TYPE deployment_approval_decision AS ENUM ('approved', 'rejected');
+
+CREATE TABLE public.deployment_approvals (
+    deployment_id UUID NOT NULL REFERENCES public.deployments ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES public.users ON DELETE CASCADE,
+    decision deployment_approval_decision NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (deployment_id, user_id)
+);
+
+GRANT DELETE, INSERT, REFERENCES, SELECT, TRIGGER, TRUNCATE, UPDATE
+    ON TABLE public.deployment_approvals TO service_role;
+
+COMMIT;
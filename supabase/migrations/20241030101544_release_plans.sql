@@ -0,0 +1,18 @@
+CREATE TABLE public.release_plans (
+    id UUID NOT NULL PRIMARY KEY,
+    project_id UUID NOT NULL REFERENCES public.projects ON DELETE CASCADE,
+    title TEXT NOT NULL,
+    target_date DATE NOT NULL,
+    owner_user_id UUID REFERENCES public.users ON DELETE SET NULL,
+    scope_items JSON NOT NULL,
+    -- Set when the plan is converted to a release, deleting the release makes the plan open again
+    release_id UUID REFERENCES public.releases ON DELETE SET NULL,
+    created_by UUID REFERENCES public.users ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX release_plans_project_id_target_date_idx ON public.release_plans (project_id, target_date);
+
+GRANT DELETE, INSERT, REFERENCES, SELECT, TRIGGER, TRUNCATE, UPDATE
+    ON TABLE public.release_plans TO service_role;
@@ -0,0 +1,24 @@
+BEGIN;
+
+ALTER TABLE public.projects
+    ADD COLUMN version_tag_prefix TEXT NOT NULL DEFAULT 'v';
+
+-- Version is parsed from the git tag name, it is NULL for tags which do not contain a semantic version.
+-- Sort key orders versions by SemVer precedence, it must be compared byte by byte, hence the "C" collation.
+ALTER TABLE public.releases
+    ADD COLUMN version TEXT,
+    ADD COLUMN version_sort_key TEXT COLLATE "C";
+
+-- Backfill releases tagged with plain versions (e.g. v1.2.3), other tags get a version once they are recreated.
+UPDATE public.releases
+SET
+    version = SUBSTRING(git_tag_name FROM 2),
+    version_sort_key =
+        LPAD(SPLIT_PART(SUBSTRING(git_tag_name FROM 2), '.', 1), 20, '0') || '.' ||
+        LPAD(SPLIT_PART(SUBSTRING(git_tag_name FROM 2), '.', 2), 20, '0') || '.' ||
+        LPAD(SPLIT_PART(SUBSTRING(git_tag_name FROM 2), '.', 3), 20, '0') || '~'
+WHERE git_tag_name ~ '^v(0|[1-9][0-9]{0,19})\.(0|[1-9][0-9]{0,19})\.(0|[1-9][0-9]{0,19})$';
+
+CREATE INDEX releases_project_id_version_sort_key_idx ON public.releases (project_id, version_sort_key);
+
+COMMIT;
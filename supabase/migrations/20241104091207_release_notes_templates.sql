@@ -0,0 +1,8 @@
+BEGIN;
+
+-- Sections of the template, e.g. {"sections": [{"title": "Features", "required": true}]}.
+-- Projects without sections do not prefill or validate release notes.
+ALTER TABLE public.projects
+    ADD COLUMN release_notes_template JSONB NOT NULL DEFAULT '{"sections": []}'::jsonb;
+
+COMMIT;
@@ -0,0 +1,16 @@
+-- Each revision stores the release title and notes before and after the change.
+CREATE TABLE public.release_revisions (
+    id UUID NOT NULL PRIMARY KEY,
+    release_id UUID NOT NULL REFERENCES public.releases ON DELETE CASCADE,
+    revision_number INTEGER NOT NULL,
+    release_title_before TEXT NOT NULL,
+    release_notes_before TEXT NOT NULL,
+    release_title_after TEXT NOT NULL,
+    release_notes_after TEXT NOT NULL,
+    created_by UUID REFERENCES public.users ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CONSTRAINT unique_revision_number_per_release UNIQUE (release_id, revision_number)
+);
+
+GRANT DELETE, INSERT, REFERENCES, SELECT, TRIGGER, TRUNCATE, UPDATE
+    ON TABLE public.release_revisions TO service_role;
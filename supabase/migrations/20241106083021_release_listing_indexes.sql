@@ -0,0 +1,8 @@
+BEGIN;
+
+-- Releases are listed page by page from the newest, the ID breaks ties of releases created at the same time.
+CREATE INDEX releases_project_id_created_at_id_idx ON public.releases (project_id, created_at, id);
+
+CREATE INDEX deployments_release_id_environment_id_idx ON public.deployments (release_id, environment_id);
+
+COMMIT;
@@ -0,0 +1,22 @@
+BEGIN;
+
+-- Search vector of a release, tag names are not stemmed, so versions like v1.2.3 match exactly.
+-- The function is used both by the index and by the search query, so the index can be used.
+CREATE FUNCTION public.release_search_vector(git_tag_name TEXT, release_title TEXT, release_notes TEXT)
+    RETURNS tsvector
+    LANGUAGE sql
+    IMMUTABLE
+    PARALLEL SAFE
+AS $$
+    SELECT
+        setweight(to_tsvector('simple'::regconfig, COALESCE(git_tag_name, '')), 'A') ||
+        setweight(to_tsvector('english'::regconfig, COALESCE(release_title, '')), 'A') ||
+        setweight(to_tsvector('english'::regconfig, COALESCE(release_notes, '')), 'B')
+$$;
+
+CREATE INDEX releases_search_vector_idx ON public.releases
+    USING GIN (public.release_search_vector(git_tag_name, release_title, release_notes));
+
+GRANT EXECUTE ON FUNCTION public.release_search_vector(TEXT, TEXT, TEXT) TO service_role;
+
+COMMIT;
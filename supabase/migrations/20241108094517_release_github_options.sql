@@ -0,0 +1,17 @@
+BEGIN;
+
+-- NULL make latest is not sent to GitHub, GitHub then makes the release latest.
+ALTER TABLE public.releases
+    ADD COLUMN github_draft BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN github_prerelease BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN github_make_latest TEXT CHECK (github_make_latest IN ('true', 'false', 'legacy'));
+
+-- Releases with a prerelease version must not become latest on GitHub.
+UPDATE public.releases
+SET
+    github_prerelease = true,
+    github_make_latest = 'false'
+WHERE
+    SPLIT_PART(version, '+', 1) LIKE '%-%';
+
+COMMIT;
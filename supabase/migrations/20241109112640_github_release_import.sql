@@ -0,0 +1,9 @@
+BEGIN;
+
+-- Assets of imported GitHub releases are not copied to the storage, the attachment links to GitHub instead.
+ALTER TABLE public.release_attachments
+    ALTER COLUMN file_path DROP NOT NULL,
+    ADD COLUMN external_url TEXT,
+    ADD CONSTRAINT release_attachments_file_path_or_external_url CHECK ((file_path IS NULL) <> (external_url IS NULL));
+
+COMMIT;
@@ -0,0 +1,22 @@
+BEGIN;
+
+-- Every incoming GitHub webhook delivery with a valid signature is stored with its payload, so failed deliveries can be inspected and replayed.
+CREATE TABLE public.github_webhook_deliveries (
+    delivery_id TEXT PRIMARY KEY,
+    event TEXT NOT NULL,
+    repo_full_name TEXT NOT NULL DEFAULT '',
+    payload BYTEA NOT NULL,
+    signature TEXT NOT NULL,
+    signature_valid BOOLEAN NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('processing', 'succeeded', 'failed')),
+    error TEXT,
+    replay_count INTEGER NOT NULL DEFAULT 0,
+    received_at TIMESTAMPTZ NOT NULL,
+    processed_at TIMESTAMPTZ
+);
+
+CREATE INDEX github_webhook_deliveries_received_at_idx ON public.github_webhook_deliveries (received_at DESC);
+
+GRANT DELETE, INSERT, REFERENCES, SELECT, TRIGGER, TRUNCATE, UPDATE ON TABLE public.github_webhook_deliveries TO service_role;
+
+COMMIT;
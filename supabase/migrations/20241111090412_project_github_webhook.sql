@@ -0,0 +1,9 @@
+BEGIN;
+
+-- Webhook registered on the GitHub repo of the project, deliveries are signed with the per-project secret.
+ALTER TABLE public.projects
+    ADD COLUMN github_webhook_id BIGINT,
+    ADD COLUMN github_webhook_secret TEXT,
+    ADD CONSTRAINT projects_github_webhook_id_and_secret CHECK ((github_webhook_id IS NULL) = (github_webhook_secret IS NULL));
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+-- Deployment mirrored to the GitHub repo of the project, statuses of the deployment are reported to it.
+ALTER TABLE public.deployments
+    ADD COLUMN github_deployment_id BIGINT;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+-- Snapshot of pull requests merged and issues closed between the previous release and the release, synced from GitHub.
+ALTER TABLE public.releases
+    ADD COLUMN linked_items JSONB;
+
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+
+-- GitLab project linked instead of the GitHub repo, the project ID does not change when the project is moved or renamed.
+ALTER TABLE public.projects
+    ADD COLUMN gitlab_project_id BIGINT,
+    ADD COLUMN gitlab_project_path TEXT,
+    ADD COLUMN gitlab_project_url TEXT,
+    ADD CONSTRAINT unique_gitlab_project UNIQUE (gitlab_project_id),
+    ADD CONSTRAINT projects_single_git_repo CHECK (gitlab_project_id IS NULL OR github_repo_slug IS NULL);
+
+-- Application expects that the settings are present, GitLab integration is disabled by default.
+INSERT INTO public.settings (key, value) VALUES
+    ('gitlab', '{"enabled": false, "base_url": "", "token": "", "webhook_secret": ""}')
+ON CONFLICT (key) DO NOTHING;
+
+COMMIT;
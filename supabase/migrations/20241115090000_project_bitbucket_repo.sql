@@ -0,0 +1,22 @@
+BEGIN;
+
+-- Bitbucket repo linked instead of the GitHub or GitLab repo, the workspace is the project key on Bitbucket Server.
+ALTER TABLE public.projects
+    ADD COLUMN bitbucket_workspace TEXT,
+    ADD COLUMN bitbucket_repo_slug TEXT,
+    ADD COLUMN bitbucket_repo_url TEXT,
+    ADD CONSTRAINT unique_bitbucket_repo UNIQUE (bitbucket_workspace, bitbucket_repo_slug);
+
+-- A project has at most one repo regardless of its provider.
+ALTER TABLE public.projects
+    DROP CONSTRAINT projects_single_git_repo,
+    ADD CONSTRAINT projects_single_git_repo CHECK (
+        num_nonnulls(github_repo_slug, gitlab_project_id, bitbucket_repo_slug) <= 1
+    );
+
+-- Application expects that the settings are present, Bitbucket integration is disabled by default.
+INSERT INTO public.settings (key, value) VALUES
+    ('bitbucket', '{"enabled": false, "base_url": "", "token": "", "webhook_secret": ""}')
+ON CONFLICT (key) DO NOTHING;
+
+COMMIT;
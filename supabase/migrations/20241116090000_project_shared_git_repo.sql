@@ -0,0 +1,12 @@
+BEGIN;
+
+-- Several projects can share a repo (monorepo), tags of the repo are told apart by the version tag prefix of the project.
+ALTER TABLE public.projects
+    DROP CONSTRAINT unique_github_repo,
+    ADD CONSTRAINT unique_github_repo UNIQUE (github_owner_slug, github_repo_slug, version_tag_prefix),
+    DROP CONSTRAINT unique_gitlab_project,
+    ADD CONSTRAINT unique_gitlab_project UNIQUE (gitlab_project_id, version_tag_prefix),
+    DROP CONSTRAINT unique_bitbucket_repo,
+    ADD CONSTRAINT unique_bitbucket_repo UNIQUE (bitbucket_workspace, bitbucket_repo_slug, version_tag_prefix);
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+-- Pings and events of branches are acknowledged without processing.
+ALTER TABLE public.github_webhook_deliveries
+    DROP CONSTRAINT github_webhook_deliveries_status_check,
+    ADD CONSTRAINT github_webhook_deliveries_status_check CHECK (status IN ('processing', 'succeeded', 'failed', 'ignored'));
+
+COMMIT;
@@ -38,6 +38,10 @@ func NewFromSvcErr(err error) *Error {
 		return NewDefaultConflictError().Wrap(err)
 	case isBadRequestError(err):
 		return NewDefaultBadRequestError().Wrap(err)
+	case isPayloadTooLargeError(err):
+		return NewDefaultPayloadTooLargeError().Wrap(err)
+	case isTooManyRequestsError(err):
+		return NewDefaultTooManyRequestsError().Wrap(err)
 	default:
 		return NewUnknownError().Wrap(err)
 	}
@@ -55,22 +59,45 @@ func isNotFoundError(err error) bool {
 		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGithubIntegrationNotEnabled) ||
 		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeReleaseNotFound) ||
 		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGitTagNotFound) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGitTagTargetNotFound) ||
 		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGithubReleaseNotFound) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeReleaseAttachmentNotFound) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeReleaseRevisionNotFound) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeDeploymentNotFound) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeReleasePlanNotFound) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodePreviousReleaseNotFound) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGithubWebhookDeliveryNotFound) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGithubWebhookNotFound) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGithubAppInstallationNotFound) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGitRepoNotSetForProject) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGitlabIntegrationNotEnabled) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGitlabRepoNotSetForProject) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGitlabRepoNotFound) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGitlabReleaseNotFound) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeBitbucketIntegrationNotEnabled) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeBitbucketRepoNotSetForProject) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeBitbucketRepoNotFound) ||
 		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeSlackChannelNotFound)
 }
 
 func isUnauthorizedError(err error) bool {
 	return svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeUnauthenticatedUser) ||
 		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGithubClientUnauthorized) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGithubAppCredentialsInvalid) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGitlabClientUnauthorized) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeBitbucketClientUnauthorized) ||
 		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeSlackClientUnauthorized)
 }
 
 func isForbiddenError(err error) bool {
 	return svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeInsufficientUserRole) ||
 		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGithubClientForbidden) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGitlabClientForbidden) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeBitbucketClientForbidden) ||
 		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeInsufficientProjectRole) ||
 		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeUserNotProjectMember) ||
-		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeAdminUserCannotBeDeleted)
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeAdminUserCannotBeDeleted) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeDeploymentApproverNotAllowed)
 }
 
 func isConflictError(err error) bool {
@@ -78,7 +105,14 @@ func isConflictError(err error) bool {
 		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeProjectInvitationAlreadyExists) ||
 		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeProjectMemberAlreadyExists) ||
 		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeReleaseGitTagAlreadyUsed) ||
-		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeProjectGithubRepoAlreadyUsed)
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGitTagAlreadyExists) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeReleaseVersionNotIncreased) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeProjectGithubRepoAlreadyUsed) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeProjectGithubRepoChanged) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeProjectGitlabRepoAlreadyUsed) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeProjectBitbucketRepoAlreadyUsed) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeProjectVersionTagPrefixOverlap) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGithubWebhookDeliveryProcessed)
 }
 
 func isBadRequestError(err error) bool {
@@ -86,12 +120,34 @@ func isBadRequestError(err error) bool {
 		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeSlackIntegrationNotEnabled) ||
 		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGithubNotesInvalidInput) ||
 		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeInvalidGithubTagDeletionWebhook) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeInvalidGithubTagCreationWebhook) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGithubWebhookDeliveryInvalid) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGithubWebhookEventNotSupported) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGitTagListInvalid) ||
 		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeProjectInvalid) ||
 		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeEnvironmentInvalid) ||
 		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeProjectInvitationInvalid) ||
 		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGithubRepoInvalidURL) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGitlabRepoInvalidURL) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeInvalidGitlabTagPushWebhook) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGitlabWebhookEventNotSupported) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeBitbucketRepoInvalidURL) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeInvalidBitbucketWebhook) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeBitbucketEventNotSupported) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGitRepoOperationNotSupported) ||
 		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeReleaseInvalid) ||
 		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeDeploymentInvalid) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeReleasePlanInvalid) ||
 		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeProjectMemberInvalid) ||
-		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeSettingsInvalid)
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeSettingsInvalid) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeReleaseAttachmentInvalid) ||
+		svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeReleaseNotPublished)
+}
+
+func isPayloadTooLargeError(err error) bool {
+	return svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeReleaseAttachmentTooLarge)
+}
+
+func isTooManyRequestsError(err error) bool {
+	return svcerrors.IsErrorWithCode(err, svcerrors.ErrCodeGithubRateLimited)
 }
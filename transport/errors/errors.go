@@ -19,6 +19,8 @@ var (
 	errCodeDefaultMethodNotAllowed = "ERR_METHOD_NOT_ALLOWED"
 	errCodeDefaultBadRequest       = "ERR_BAD_REQUEST"
 	errCodeDefaultConflict         = "ERR_CONFLICT"
+	errCodeDefaultPayloadTooLarge  = "ERR_PAYLOAD_TOO_LARGE"
+	errCodeDefaultTooManyRequests  = "ERR_TOO_MANY_REQUESTS"
 	errCodeInvalidRequestPayload   = "ERR_INVALID_REQUEST_PAYLOAD"
 	errCodeInvalidURLParams        = "ERR_INVALID_URL_PARAMS"
 	errCodeUnknown                 = "ERR_UNKNOWN"
@@ -144,3 +146,17 @@ func NewDefaultConflictError() *Error {
 		Code:       errCodeDefaultConflict,
 	}
 }
+
+func NewDefaultPayloadTooLargeError() *Error {
+	return &Error{
+		StatusCode: http.StatusRequestEntityTooLarge,
+		Code:       errCodeDefaultPayloadTooLarge,
+	}
+}
+
+func NewDefaultTooManyRequestsError() *Error {
+	return &Error{
+		StatusCode: http.StatusTooManyRequests,
+		Code:       errCodeDefaultTooManyRequests,
+	}
+}
@@ -56,3 +56,35 @@ func (h *Handler) listDeploymentsForProject(w http.ResponseWriter, r *http.Reque
 
 	util.WriteJSONResponse(w, http.StatusOK, model.ToDeployments(dpls))
 }
+
+func (h *Handler) approveDeployment(w http.ResponseWriter, r *http.Request) {
+	params, err := util.UnmarshalURLParams[model.DeploymentURLParams](r)
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromURLParamsUnmarshalErr(err))
+		return
+	}
+
+	dpl, err := h.ReleaseSvc.ApproveDeployment(r.Context(), params.ProjectID, params.DeploymentID, util.ContextAuthUserID(r))
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromSvcErr(err))
+		return
+	}
+
+	util.WriteJSONResponse(w, http.StatusOK, model.ToDeployment(dpl))
+}
+
+func (h *Handler) rejectDeployment(w http.ResponseWriter, r *http.Request) {
+	params, err := util.UnmarshalURLParams[model.DeploymentURLParams](r)
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromURLParamsUnmarshalErr(err))
+		return
+	}
+
+	dpl, err := h.ReleaseSvc.RejectDeployment(r.Context(), params.ProjectID, params.DeploymentID, util.ContextAuthUserID(r))
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromSvcErr(err))
+		return
+	}
+
+	util.WriteJSONResponse(w, http.StatusOK, model.ToDeployment(dpl))
+}
@@ -8,6 +8,7 @@ import (
 	"release-manager/transport/middleware"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 )
 
 type projectService interface {
@@ -25,7 +26,20 @@ type projectService interface {
 
 	SetGithubRepoForProject(ctx context.Context, rawRepoURL string, projectID id.Project, authUserID id.AuthUser) error
 	GetGithubRepoForProject(ctx context.Context, projectID id.Project, authUserID id.AuthUser) (svcmodel.GithubRepo, error)
-	ListGithubRepoTags(ctx context.Context, projectID id.Project, authUserID id.AuthUser) ([]svcmodel.GitTag, error)
+	UnlinkGithubRepoFromProject(ctx context.Context, projectID id.Project, authUserID id.AuthUser) error
+	RotateGithubWebhookSecret(ctx context.Context, projectID id.Project, authUserID id.AuthUser) error
+	SetGitlabRepoForProject(ctx context.Context, rawRepoURL string, projectID id.Project, authUserID id.AuthUser) error
+	GetGitlabRepoForProject(ctx context.Context, projectID id.Project, authUserID id.AuthUser) (svcmodel.GitlabRepo, error)
+	UnlinkGitlabRepoFromProject(ctx context.Context, projectID id.Project, authUserID id.AuthUser) error
+	SetBitbucketRepoForProject(ctx context.Context, rawRepoURL string, projectID id.Project, authUserID id.AuthUser) error
+	GetBitbucketRepoForProject(ctx context.Context, projectID id.Project, authUserID id.AuthUser) (svcmodel.BitbucketRepo, error)
+	UnlinkBitbucketRepoFromProject(ctx context.Context, projectID id.Project, authUserID id.AuthUser) error
+	ListGitRepoTags(
+		ctx context.Context,
+		projectID id.Project,
+		params svcmodel.ListGitTagsParams,
+		authUserID id.AuthUser,
+	) ([]svcmodel.RepoGitTag, error)
 
 	Invite(ctx context.Context, c svcmodel.CreateProjectInvitationInput, authUserID id.AuthUser) (svcmodel.ProjectInvitation, error)
 	ListInvitations(ctx context.Context, projectID id.Project, authUserID id.AuthUser) ([]svcmodel.ProjectInvitation, error)
@@ -53,17 +67,43 @@ type settingsService interface {
 
 type releaseService interface {
 	CreateRelease(ctx context.Context, input svcmodel.CreateReleaseInput, projectID id.Project, authUserID id.AuthUser) (svcmodel.Release, error)
+	ImportGithubReleases(ctx context.Context, projectID id.Project, authUserID id.AuthUser) (svcmodel.GithubReleaseImportSummary, error)
 	GetRelease(ctx context.Context, releaseID id.Release, authUserID id.AuthUser) (svcmodel.Release, error)
 	DeleteRelease(ctx context.Context, input svcmodel.DeleteReleaseInput, releaseID id.Release, authUserID id.AuthUser) error
-	DeleteReleaseOnGitTagRemoval(ctx context.Context, input svcmodel.GithubTagDeletionWebhookInput) error
+	HandleGithubWebhook(ctx context.Context, input svcmodel.GithubWebhookDeliveryInput) error
+	HandleGitlabWebhook(ctx context.Context, input svcmodel.GitlabWebhookInput) error
+	HandleBitbucketWebhook(ctx context.Context, input svcmodel.BitbucketWebhookInput) error
+	ListGithubWebhookDeliveries(ctx context.Context, params svcmodel.ListGithubWebhookDeliveriesParams, authUserID id.AuthUser) ([]svcmodel.GithubWebhookDelivery, error)
+	ReplayGithubWebhookDelivery(ctx context.Context, deliveryID string, authUserID id.AuthUser) (svcmodel.GithubWebhookDelivery, error)
 	UpdateRelease(ctx context.Context, input svcmodel.UpdateReleaseInput, releaseID id.Release, authUserID id.AuthUser) error
-	ListReleasesForProject(ctx context.Context, projectID id.Project, authUserID id.AuthUser) ([]svcmodel.Release, error)
+	UpdateReleaseStatus(ctx context.Context, status svcmodel.ReleaseStatus, releaseID id.Release, authUserID id.AuthUser) error
+	ListReleaseRevisions(ctx context.Context, releaseID id.Release, authUserID id.AuthUser) ([]svcmodel.ReleaseRevision, error)
+	DiffReleaseRevisions(ctx context.Context, params svcmodel.DiffReleaseRevisionsParams, releaseID id.Release, authUserID id.AuthUser) (svcmodel.ReleaseRevisionDiff, error)
+	RestoreReleaseRevision(ctx context.Context, releaseID id.Release, revisionNumber int, authUserID id.AuthUser) error
+	ListReleasesForProject(ctx context.Context, params svcmodel.ListReleasesFilterParams, projectID id.Project, authUserID id.AuthUser) (svcmodel.ReleasePage, error)
+	SearchReleases(ctx context.Context, params svcmodel.SearchReleasesParams, authUserID id.AuthUser) ([]svcmodel.ReleaseSearchHit, error)
+	GetNextReleaseVersion(ctx context.Context, bump svcmodel.VersionBump, projectID id.Project, authUserID id.AuthUser) (svcmodel.NextReleaseVersion, error)
+	CompareReleases(ctx context.Context, input svcmodel.CompareReleasesInput, projectID id.Project, authUserID id.AuthUser) (svcmodel.ReleaseComparison, error)
+	ExportChangelog(ctx context.Context, params svcmodel.ExportChangelogParams, projectID id.Project, authUserID id.AuthUser) (svcmodel.Changelog, error)
 	SendReleaseNotification(ctx context.Context, releaseID id.Release, authUserID id.AuthUser) error
-	UpsertGithubRelease(ctx context.Context, releaseID id.Release, authUserID id.AuthUser) error
-	GenerateGithubReleaseNotes(ctx context.Context, input svcmodel.GithubReleaseNotesInput, projectID id.Project, authUserID id.AuthUser) (svcmodel.GithubReleaseNotes, error)
+	UpsertGitRelease(ctx context.Context, releaseID id.Release, authUserID id.AuthUser) error
+	SyncReleaseLinkedItems(ctx context.Context, releaseID id.Release, authUserID id.AuthUser) (svcmodel.Release, error)
+	GenerateGitReleaseNotes(ctx context.Context, input svcmodel.GitReleaseNotesInput, projectID id.Project, authUserID id.AuthUser) (svcmodel.GitReleaseNotes, error)
+	UploadReleaseAttachment(ctx context.Context, input svcmodel.ReleaseAttachmentInput, releaseID id.Release, authUserID id.AuthUser) (svcmodel.ReleaseAttachment, error)
+	ReplaceReleaseAttachment(ctx context.Context, input svcmodel.ReleaseAttachmentInput, releaseID id.Release, attachmentID uuid.UUID, authUserID id.AuthUser) (svcmodel.ReleaseAttachment, error)
+	DeleteReleaseAttachment(ctx context.Context, releaseID id.Release, attachmentID uuid.UUID, authUserID id.AuthUser) error
 
 	CreateDeployment(ctx context.Context, input svcmodel.CreateDeploymentInput, projectID id.Project, authUserID id.AuthUser) (svcmodel.Deployment, error)
 	ListDeploymentsForProject(ctx context.Context, params svcmodel.ListDeploymentsFilterParams, projectID id.Project, authUserID id.AuthUser) ([]svcmodel.Deployment, error)
+	ApproveDeployment(ctx context.Context, projectID id.Project, deploymentID id.Deployment, authUserID id.AuthUser) (svcmodel.Deployment, error)
+	RejectDeployment(ctx context.Context, projectID id.Project, deploymentID id.Deployment, authUserID id.AuthUser) (svcmodel.Deployment, error)
+
+	CreateReleasePlan(ctx context.Context, input svcmodel.CreateReleasePlanInput, projectID id.Project, authUserID id.AuthUser) (svcmodel.ReleasePlan, error)
+	GetReleasePlan(ctx context.Context, projectID id.Project, planID id.ReleasePlan, authUserID id.AuthUser) (svcmodel.ReleasePlan, error)
+	ListReleasePlansForProject(ctx context.Context, params svcmodel.ListReleasePlansFilterParams, projectID id.Project, authUserID id.AuthUser) ([]svcmodel.ReleasePlan, error)
+	UpdateReleasePlan(ctx context.Context, input svcmodel.UpdateReleasePlanInput, projectID id.Project, planID id.ReleasePlan, authUserID id.AuthUser) error
+	DeleteReleasePlan(ctx context.Context, projectID id.Project, planID id.ReleasePlan, authUserID id.AuthUser) error
+	ConvertReleasePlan(ctx context.Context, input svcmodel.ConvertReleasePlanInput, projectID id.Project, planID id.ReleasePlan, authUserID id.AuthUser) (svcmodel.Release, error)
 }
 
 type Handler struct {
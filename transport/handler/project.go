@@ -96,16 +96,17 @@ func (h *Handler) deleteProject(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
-func (h *Handler) listGithubRepoTags(w http.ResponseWriter, r *http.Request) {
-	projectID, err := util.GetPathParam[id.Project](r, "project_id")
+func (h *Handler) listGitRepoTags(w http.ResponseWriter, r *http.Request) {
+	params, err := util.UnmarshalURLParams[model.ListGitRepoTagsParams](r)
 	if err != nil {
-		util.WriteResponseError(w, resperr.NewInvalidURLParamsError().Wrap(err).WithMessage(err.Error()))
+		util.WriteResponseError(w, resperr.NewFromURLParamsUnmarshalErr(err))
 		return
 	}
 
-	t, err := h.ProjectSvc.ListGithubRepoTags(
+	t, err := h.ProjectSvc.ListGitRepoTags(
 		r.Context(),
-		projectID,
+		params.ProjectID,
+		model.ToSvcListGitTagsParams(params),
 		util.ContextAuthUserID(r),
 	)
 	if err != nil {
@@ -113,7 +114,7 @@ func (h *Handler) listGithubRepoTags(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	util.WriteJSONResponse(w, http.StatusOK, model.ToGitTags(t))
+	util.WriteJSONResponse(w, http.StatusOK, model.ToRepoGitTags(t))
 }
 
 func (h *Handler) setGithubRepoForProject(w http.ResponseWriter, r *http.Request) {
@@ -142,6 +143,36 @@ func (h *Handler) setGithubRepoForProject(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (h *Handler) unlinkGithubRepoFromProject(w http.ResponseWriter, r *http.Request) {
+	projectID, err := util.GetPathParam[id.Project](r, "project_id")
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewInvalidURLParamsError().Wrap(err).WithMessage(err.Error()))
+		return
+	}
+
+	if err := h.ProjectSvc.UnlinkGithubRepoFromProject(r.Context(), projectID, util.ContextAuthUserID(r)); err != nil {
+		util.WriteResponseError(w, resperr.NewFromSvcErr(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) rotateGithubWebhookSecret(w http.ResponseWriter, r *http.Request) {
+	projectID, err := util.GetPathParam[id.Project](r, "project_id")
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewInvalidURLParamsError().Wrap(err).WithMessage(err.Error()))
+		return
+	}
+
+	if err := h.ProjectSvc.RotateGithubWebhookSecret(r.Context(), projectID, util.ContextAuthUserID(r)); err != nil {
+		util.WriteResponseError(w, resperr.NewFromSvcErr(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func (h *Handler) getGithubRepoForProject(w http.ResponseWriter, r *http.Request) {
 	projectID, err := util.GetPathParam[id.Project](r, "project_id")
 	if err != nil {
@@ -161,3 +192,125 @@ func (h *Handler) getGithubRepoForProject(w http.ResponseWriter, r *http.Request
 
 	util.WriteJSONResponse(w, http.StatusOK, model.ToGithubRepo(repo))
 }
+
+func (h *Handler) setGitlabRepoForProject(w http.ResponseWriter, r *http.Request) {
+	projectID, err := util.GetPathParam[id.Project](r, "project_id")
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewInvalidURLParamsError().Wrap(err).WithMessage(err.Error()))
+		return
+	}
+
+	var input model.SetProjectGitlabRepoInput
+	if err := util.UnmarshalBody(r, &input); err != nil {
+		util.WriteResponseError(w, resperr.NewFromBodyUnmarshalErr(err))
+		return
+	}
+
+	if err := h.ProjectSvc.SetGitlabRepoForProject(
+		r.Context(),
+		input.RawRepoURL,
+		projectID,
+		util.ContextAuthUserID(r),
+	); err != nil {
+		util.WriteResponseError(w, resperr.NewFromSvcErr(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) unlinkGitlabRepoFromProject(w http.ResponseWriter, r *http.Request) {
+	projectID, err := util.GetPathParam[id.Project](r, "project_id")
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewInvalidURLParamsError().Wrap(err).WithMessage(err.Error()))
+		return
+	}
+
+	if err := h.ProjectSvc.UnlinkGitlabRepoFromProject(r.Context(), projectID, util.ContextAuthUserID(r)); err != nil {
+		util.WriteResponseError(w, resperr.NewFromSvcErr(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) getGitlabRepoForProject(w http.ResponseWriter, r *http.Request) {
+	projectID, err := util.GetPathParam[id.Project](r, "project_id")
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewInvalidURLParamsError().Wrap(err).WithMessage(err.Error()))
+		return
+	}
+
+	repo, err := h.ProjectSvc.GetGitlabRepoForProject(
+		r.Context(),
+		projectID,
+		util.ContextAuthUserID(r),
+	)
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromSvcErr(err))
+		return
+	}
+
+	util.WriteJSONResponse(w, http.StatusOK, model.ToGitlabRepo(repo))
+}
+
+func (h *Handler) setBitbucketRepoForProject(w http.ResponseWriter, r *http.Request) {
+	projectID, err := util.GetPathParam[id.Project](r, "project_id")
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewInvalidURLParamsError().Wrap(err).WithMessage(err.Error()))
+		return
+	}
+
+	var input model.SetProjectBitbucketRepoInput
+	if err := util.UnmarshalBody(r, &input); err != nil {
+		util.WriteResponseError(w, resperr.NewFromBodyUnmarshalErr(err))
+		return
+	}
+
+	if err := h.ProjectSvc.SetBitbucketRepoForProject(
+		r.Context(),
+		input.RawRepoURL,
+		projectID,
+		util.ContextAuthUserID(r),
+	); err != nil {
+		util.WriteResponseError(w, resperr.NewFromSvcErr(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) unlinkBitbucketRepoFromProject(w http.ResponseWriter, r *http.Request) {
+	projectID, err := util.GetPathParam[id.Project](r, "project_id")
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewInvalidURLParamsError().Wrap(err).WithMessage(err.Error()))
+		return
+	}
+
+	if err := h.ProjectSvc.UnlinkBitbucketRepoFromProject(r.Context(), projectID, util.ContextAuthUserID(r)); err != nil {
+		util.WriteResponseError(w, resperr.NewFromSvcErr(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) getBitbucketRepoForProject(w http.ResponseWriter, r *http.Request) {
+	projectID, err := util.GetPathParam[id.Project](r, "project_id")
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewInvalidURLParamsError().Wrap(err).WithMessage(err.Error()))
+		return
+	}
+
+	repo, err := h.ProjectSvc.GetBitbucketRepoForProject(
+		r.Context(),
+		projectID,
+		util.ContextAuthUserID(r),
+	)
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromSvcErr(err))
+		return
+	}
+
+	util.WriteJSONResponse(w, http.StatusOK, model.ToBitbucketRepo(repo))
+}
@@ -4,11 +4,16 @@ import (
 	"net/http"
 
 	"release-manager/pkg/id"
+	svcmodel "release-manager/service/model"
 	resperr "release-manager/transport/errors"
 	"release-manager/transport/model"
 	"release-manager/transport/util"
 )
 
+const (
+	markdownContentType = "text/markdown; charset=utf-8"
+)
+
 func (h *Handler) createRelease(w http.ResponseWriter, r *http.Request) {
 	projectID, err := util.GetPathParam[id.Project](r, "project_id")
 	if err != nil {
@@ -83,15 +88,53 @@ func (h *Handler) deleteRelease(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *Handler) listReleases(w http.ResponseWriter, r *http.Request) {
-	projectID, err := util.GetPathParam[id.Project](r, "project_id")
+	params, err := util.UnmarshalURLParams[model.ListReleasesParams](r)
 	if err != nil {
-		util.WriteResponseError(w, resperr.NewInvalidURLParamsError().Wrap(err).WithMessage(err.Error()))
+		util.WriteResponseError(w, resperr.NewFromURLParamsUnmarshalErr(err))
 		return
 	}
 
-	rls, err := h.ReleaseSvc.ListReleasesForProject(
+	page, err := h.ReleaseSvc.ListReleasesForProject(
 		r.Context(),
-		projectID,
+		model.ToSvcListReleasesFilterParams(params),
+		params.ProjectID,
+		util.ContextAuthUserID(r),
+	)
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromSvcErr(err))
+		return
+	}
+
+	util.WriteJSONResponse(w, http.StatusOK, model.ToReleasePage(page))
+}
+
+func (h *Handler) searchReleases(w http.ResponseWriter, r *http.Request) {
+	params, err := util.UnmarshalURLParams[model.SearchReleasesParams](r)
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromURLParamsUnmarshalErr(err))
+		return
+	}
+
+	hits, err := h.ReleaseSvc.SearchReleases(r.Context(), model.ToSvcSearchReleasesParams(params), util.ContextAuthUserID(r))
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromSvcErr(err))
+		return
+	}
+
+	util.WriteJSONResponse(w, http.StatusOK, model.ToReleaseSearchHits(hits))
+}
+
+func (h *Handler) getNextReleaseVersion(w http.ResponseWriter, r *http.Request) {
+	params, err := util.UnmarshalURLParams[model.NextReleaseVersionParams](r)
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromURLParamsUnmarshalErr(err))
+		return
+	}
+
+	v, err := h.ReleaseSvc.GetNextReleaseVersion(
+		r.Context(),
+		svcmodel.VersionBump(params.Bump),
+		params.ProjectID,
 		util.ContextAuthUserID(r),
 	)
 	if err != nil {
@@ -99,7 +142,56 @@ func (h *Handler) listReleases(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	util.WriteJSONResponse(w, http.StatusOK, model.ToReleases(rls))
+	util.WriteJSONResponse(w, http.StatusOK, model.ToNextReleaseVersion(v))
+}
+
+func (h *Handler) compareReleases(w http.ResponseWriter, r *http.Request) {
+	params, err := util.UnmarshalURLParams[model.CompareReleasesParams](r)
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromURLParamsUnmarshalErr(err))
+		return
+	}
+
+	cmp, err := h.ReleaseSvc.CompareReleases(
+		r.Context(),
+		model.ToSvcCompareReleasesInput(params),
+		params.ProjectID,
+		util.ContextAuthUserID(r),
+	)
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromSvcErr(err))
+		return
+	}
+
+	util.WriteJSONResponse(w, http.StatusOK, model.ToReleaseComparison(cmp))
+}
+
+func (h *Handler) exportChangelog(w http.ResponseWriter, r *http.Request) {
+	params, err := util.UnmarshalURLParams[model.ExportChangelogParams](r)
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromURLParamsUnmarshalErr(err))
+		return
+	}
+
+	c, err := h.ReleaseSvc.ExportChangelog(
+		r.Context(),
+		model.ToSvcExportChangelogParams(params),
+		params.ProjectID,
+		util.ContextAuthUserID(r),
+	)
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromSvcErr(err))
+		return
+	}
+
+	switch c.Format {
+	case svcmodel.ChangelogFormatJSON:
+		util.WriteJSONResponse(w, http.StatusOK, model.ToChangelog(c))
+	case svcmodel.ChangelogFormatKeepAChangelog:
+		util.WriteFileResponse(w, http.StatusOK, markdownContentType, model.ChangelogFileName, []byte(c.KeepAChangelog()))
+	default:
+		util.WriteFileResponse(w, http.StatusOK, markdownContentType, model.ChangelogFileName, []byte(c.Markdown()))
+	}
 }
 
 func (h *Handler) updateRelease(w http.ResponseWriter, r *http.Request) {
@@ -128,6 +220,32 @@ func (h *Handler) updateRelease(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (h *Handler) updateReleaseStatus(w http.ResponseWriter, r *http.Request) {
+	rlsID, err := util.GetPathParam[id.Release](r, "release_id")
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewInvalidURLParamsError().Wrap(err).WithMessage(err.Error()))
+		return
+	}
+
+	var input model.UpdateReleaseStatusInput
+	if err := util.UnmarshalBody(r, &input); err != nil {
+		util.WriteResponseError(w, resperr.NewFromBodyUnmarshalErr(err))
+		return
+	}
+
+	if err := h.ReleaseSvc.UpdateReleaseStatus(
+		r.Context(),
+		svcmodel.ReleaseStatus(input.Status),
+		rlsID,
+		util.ContextAuthUserID(r),
+	); err != nil {
+		util.WriteResponseError(w, resperr.NewFromSvcErr(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func (h *Handler) sendReleaseNotification(w http.ResponseWriter, r *http.Request) {
 	rlsID, err := util.GetPathParam[id.Release](r, "release_id")
 	if err != nil {
@@ -147,14 +265,14 @@ func (h *Handler) sendReleaseNotification(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusNoContent)
 }
 
-func (h *Handler) upsertGithubRelease(w http.ResponseWriter, r *http.Request) {
+func (h *Handler) upsertGitRelease(w http.ResponseWriter, r *http.Request) {
 	rlsID, err := util.GetPathParam[id.Release](r, "release_id")
 	if err != nil {
 		util.WriteResponseError(w, resperr.NewInvalidURLParamsError().Wrap(err).WithMessage(err.Error()))
 		return
 	}
 
-	if err := h.ReleaseSvc.UpsertGithubRelease(
+	if err := h.ReleaseSvc.UpsertGitRelease(
 		r.Context(),
 		rlsID,
 		util.ContextAuthUserID(r),
@@ -166,22 +284,42 @@ func (h *Handler) upsertGithubRelease(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
-func (h *Handler) generateGithubReleaseNotes(w http.ResponseWriter, r *http.Request) {
+func (h *Handler) syncReleaseLinkedItems(w http.ResponseWriter, r *http.Request) {
+	rlsID, err := util.GetPathParam[id.Release](r, "release_id")
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewInvalidURLParamsError().Wrap(err).WithMessage(err.Error()))
+		return
+	}
+
+	rls, err := h.ReleaseSvc.SyncReleaseLinkedItems(
+		r.Context(),
+		rlsID,
+		util.ContextAuthUserID(r),
+	)
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromSvcErr(err))
+		return
+	}
+
+	util.WriteJSONResponse(w, http.StatusOK, model.ToRelease(rls))
+}
+
+func (h *Handler) generateGitReleaseNotes(w http.ResponseWriter, r *http.Request) {
 	projectID, err := util.GetPathParam[id.Project](r, "project_id")
 	if err != nil {
 		util.WriteResponseError(w, resperr.NewInvalidURLParamsError().Wrap(err).WithMessage(err.Error()))
 		return
 	}
 
-	var input model.GithubReleaseNotesInput
+	var input model.GitReleaseNotesInput
 	if err := util.UnmarshalBody(r, &input); err != nil {
 		util.WriteResponseError(w, resperr.NewFromBodyUnmarshalErr(err))
 		return
 	}
 
-	notes, err := h.ReleaseSvc.GenerateGithubReleaseNotes(
+	notes, err := h.ReleaseSvc.GenerateGitReleaseNotes(
 		r.Context(),
-		model.ToSvcGithubReleaseNotesInput(input),
+		model.ToSvcGitReleaseNotesInput(input),
 		projectID,
 		util.ContextAuthUserID(r),
 	)
@@ -190,5 +328,5 @@ func (h *Handler) generateGithubReleaseNotes(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	util.WriteJSONResponse(w, http.StatusOK, model.ToGithubReleaseNotes(notes))
+	util.WriteJSONResponse(w, http.StatusOK, model.ToGitReleaseNotes(notes))
 }
@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"release-manager/pkg/id"
+	svcmodel "release-manager/service/model"
+	resperr "release-manager/transport/errors"
+	"release-manager/transport/model"
+	"release-manager/transport/util"
+
+	"github.com/google/uuid"
+)
+
+const (
+	releaseAttachmentFormField = "file"
+	// releaseAttachmentUploadTimeout overrides the server read and write timeouts,
+	// which are too short for streaming large files to the storage.
+	releaseAttachmentUploadTimeout = 5 * time.Minute
+	// releaseAttachmentMaxRequestSize leaves room for the multipart boundaries and part headers.
+	releaseAttachmentMaxRequestSize = svcmodel.ReleaseAttachmentMaxSize + 1<<20
+)
+
+func (h *Handler) uploadReleaseAttachment(w http.ResponseWriter, r *http.Request) {
+	rlsID, err := util.GetPathParam[id.Release](r, "release_id")
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewInvalidURLParamsError().Wrap(err).WithMessage(err.Error()))
+		return
+	}
+
+	extendUploadDeadline(w)
+
+	file, err := util.GetMultipartFile(w, r, releaseAttachmentFormField, releaseAttachmentMaxRequestSize)
+	if err != nil {
+		util.WriteResponseError(w, toMultipartFileError(err))
+		return
+	}
+	defer file.Close()
+
+	a, err := h.ReleaseSvc.UploadReleaseAttachment(
+		r.Context(),
+		model.ToSvcReleaseAttachmentInput(file),
+		rlsID,
+		util.ContextAuthUserID(r),
+	)
+	if err != nil {
+		util.WriteResponseError(w, toReleaseAttachmentUploadError(err))
+		return
+	}
+
+	util.WriteJSONResponse(w, http.StatusCreated, model.ToReleaseAttachment(a))
+}
+
+func (h *Handler) replaceReleaseAttachment(w http.ResponseWriter, r *http.Request) {
+	rlsID, err := util.GetPathParam[id.Release](r, "release_id")
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewInvalidURLParamsError().Wrap(err).WithMessage(err.Error()))
+		return
+	}
+
+	attachmentID, err := util.GetPathParam[uuid.UUID](r, "attachment_id")
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewInvalidURLParamsError().Wrap(err).WithMessage(err.Error()))
+		return
+	}
+
+	extendUploadDeadline(w)
+
+	file, err := util.GetMultipartFile(w, r, releaseAttachmentFormField, releaseAttachmentMaxRequestSize)
+	if err != nil {
+		util.WriteResponseError(w, toMultipartFileError(err))
+		return
+	}
+	defer file.Close()
+
+	a, err := h.ReleaseSvc.ReplaceReleaseAttachment(
+		r.Context(),
+		model.ToSvcReleaseAttachmentInput(file),
+		rlsID,
+		attachmentID,
+		util.ContextAuthUserID(r),
+	)
+	if err != nil {
+		util.WriteResponseError(w, toReleaseAttachmentUploadError(err))
+		return
+	}
+
+	util.WriteJSONResponse(w, http.StatusOK, model.ToReleaseAttachment(a))
+}
+
+func (h *Handler) deleteReleaseAttachment(w http.ResponseWriter, r *http.Request) {
+	rlsID, err := util.GetPathParam[id.Release](r, "release_id")
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewInvalidURLParamsError().Wrap(err).WithMessage(err.Error()))
+		return
+	}
+
+	attachmentID, err := util.GetPathParam[uuid.UUID](r, "attachment_id")
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewInvalidURLParamsError().Wrap(err).WithMessage(err.Error()))
+		return
+	}
+
+	if err := h.ReleaseSvc.DeleteReleaseAttachment(
+		r.Context(),
+		rlsID,
+		attachmentID,
+		util.ContextAuthUserID(r),
+	); err != nil {
+		util.WriteResponseError(w, resperr.NewFromSvcErr(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func toMultipartFileError(err error) *resperr.Error {
+	if isRequestTooLarge(err) {
+		return newRequestTooLargeError(err)
+	}
+
+	return resperr.NewInvalidRequestPayloadError().Wrap(err).WithMessage(err.Error())
+}
+
+// toReleaseAttachmentUploadError maps the error of the upload, a request body without Content-Length
+// exceeds its limit only while the file is streamed to the storage.
+func toReleaseAttachmentUploadError(err error) *resperr.Error {
+	if isRequestTooLarge(err) {
+		return newRequestTooLargeError(err)
+	}
+
+	return resperr.NewFromSvcErr(err)
+}
+
+func isRequestTooLarge(err error) bool {
+	var maxBytesErr *http.MaxBytesError
+	return errors.As(err, &maxBytesErr)
+}
+
+func newRequestTooLargeError(err error) *resperr.Error {
+	return resperr.NewDefaultPayloadTooLargeError().Wrap(err).WithMessage("request body exceeds the maximum allowed size")
+}
+
+// extendUploadDeadline extends the connection deadlines for the upload request.
+// If the response writer does not support it, the server timeouts are kept.
+func extendUploadDeadline(w http.ResponseWriter) {
+	rc := http.NewResponseController(w)
+	deadline := time.Now().Add(releaseAttachmentUploadTimeout)
+	_ = rc.SetReadDeadline(deadline)
+	_ = rc.SetWriteDeadline(deadline)
+}
@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"net/http"
+
+	"release-manager/pkg/id"
+	resperr "release-manager/transport/errors"
+	"release-manager/transport/model"
+	"release-manager/transport/util"
+)
+
+func (h *Handler) importGithubReleases(w http.ResponseWriter, r *http.Request) {
+	projectID, err := util.GetPathParam[id.Project](r, "project_id")
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewInvalidURLParamsError().Wrap(err).WithMessage(err.Error()))
+		return
+	}
+
+	summary, err := h.ReleaseSvc.ImportGithubReleases(r.Context(), projectID, util.ContextAuthUserID(r))
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromSvcErr(err))
+		return
+	}
+
+	util.WriteJSONResponse(w, http.StatusOK, model.ToGithubReleaseImportSummary(summary))
+}
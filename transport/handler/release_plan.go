@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"net/http"
+
+	"release-manager/pkg/id"
+	resperr "release-manager/transport/errors"
+	"release-manager/transport/model"
+	"release-manager/transport/util"
+)
+
+func (h *Handler) createReleasePlan(w http.ResponseWriter, r *http.Request) {
+	projectID, err := util.GetPathParam[id.Project](r, "project_id")
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewInvalidURLParamsError().Wrap(err).WithMessage(err.Error()))
+		return
+	}
+
+	var input model.CreateReleasePlanInput
+	if err := util.UnmarshalBody(r, &input); err != nil {
+		util.WriteResponseError(w, resperr.NewFromBodyUnmarshalErr(err))
+		return
+	}
+
+	p, err := h.ReleaseSvc.CreateReleasePlan(
+		r.Context(),
+		model.ToSvcCreateReleasePlanInput(input),
+		projectID,
+		util.ContextAuthUserID(r),
+	)
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromSvcErr(err))
+		return
+	}
+
+	util.WriteJSONResponse(w, http.StatusCreated, model.ToReleasePlan(p))
+}
+
+func (h *Handler) listReleasePlans(w http.ResponseWriter, r *http.Request) {
+	params, err := util.UnmarshalURLParams[model.ListReleasePlansParams](r)
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromURLParamsUnmarshalErr(err))
+		return
+	}
+
+	plans, err := h.ReleaseSvc.ListReleasePlansForProject(
+		r.Context(),
+		model.ToSvcListReleasePlansFilterParams(params),
+		params.ProjectID,
+		util.ContextAuthUserID(r),
+	)
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromSvcErr(err))
+		return
+	}
+
+	util.WriteJSONResponse(w, http.StatusOK, model.ToReleasePlans(plans))
+}
+
+func (h *Handler) getReleasePlan(w http.ResponseWriter, r *http.Request) {
+	params, err := util.UnmarshalURLParams[model.ReleasePlanURLParams](r)
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromURLParamsUnmarshalErr(err))
+		return
+	}
+
+	p, err := h.ReleaseSvc.GetReleasePlan(
+		r.Context(),
+		params.ProjectID,
+		params.PlanID,
+		util.ContextAuthUserID(r),
+	)
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromSvcErr(err))
+		return
+	}
+
+	util.WriteJSONResponse(w, http.StatusOK, model.ToReleasePlan(p))
+}
+
+func (h *Handler) updateReleasePlan(w http.ResponseWriter, r *http.Request) {
+	params, err := util.UnmarshalURLParams[model.ReleasePlanURLParams](r)
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromURLParamsUnmarshalErr(err))
+		return
+	}
+
+	var input model.UpdateReleasePlanInput
+	if err := util.UnmarshalBody(r, &input); err != nil {
+		util.WriteResponseError(w, resperr.NewFromBodyUnmarshalErr(err))
+		return
+	}
+
+	if err := h.ReleaseSvc.UpdateReleasePlan(
+		r.Context(),
+		model.ToSvcUpdateReleasePlanInput(input),
+		params.ProjectID,
+		params.PlanID,
+		util.ContextAuthUserID(r),
+	); err != nil {
+		util.WriteResponseError(w, resperr.NewFromSvcErr(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) deleteReleasePlan(w http.ResponseWriter, r *http.Request) {
+	params, err := util.UnmarshalURLParams[model.ReleasePlanURLParams](r)
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromURLParamsUnmarshalErr(err))
+		return
+	}
+
+	if err := h.ReleaseSvc.DeleteReleasePlan(
+		r.Context(),
+		params.ProjectID,
+		params.PlanID,
+		util.ContextAuthUserID(r),
+	); err != nil {
+		util.WriteResponseError(w, resperr.NewFromSvcErr(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) convertReleasePlan(w http.ResponseWriter, r *http.Request) {
+	params, err := util.UnmarshalURLParams[model.ReleasePlanURLParams](r)
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromURLParamsUnmarshalErr(err))
+		return
+	}
+
+	var input model.ConvertReleasePlanInput
+	if err := util.UnmarshalBody(r, &input); err != nil {
+		util.WriteResponseError(w, resperr.NewFromBodyUnmarshalErr(err))
+		return
+	}
+
+	rls, err := h.ReleaseSvc.ConvertReleasePlan(
+		r.Context(),
+		model.ToSvcConvertReleasePlanInput(input),
+		params.ProjectID,
+		params.PlanID,
+		util.ContextAuthUserID(r),
+	)
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromSvcErr(err))
+		return
+	}
+
+	util.WriteJSONResponse(w, http.StatusCreated, model.ToRelease(rls))
+}
@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"net/http"
+
+	"release-manager/pkg/id"
+	resperr "release-manager/transport/errors"
+	"release-manager/transport/model"
+	"release-manager/transport/util"
+)
+
+func (h *Handler) listReleaseRevisions(w http.ResponseWriter, r *http.Request) {
+	rlsID, err := util.GetPathParam[id.Release](r, "release_id")
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewInvalidURLParamsError().Wrap(err).WithMessage(err.Error()))
+		return
+	}
+
+	revisions, err := h.ReleaseSvc.ListReleaseRevisions(r.Context(), rlsID, util.ContextAuthUserID(r))
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromSvcErr(err))
+		return
+	}
+
+	util.WriteJSONResponse(w, http.StatusOK, model.ToReleaseRevisions(revisions))
+}
+
+func (h *Handler) diffReleaseRevisions(w http.ResponseWriter, r *http.Request) {
+	params, err := util.UnmarshalURLParams[model.DiffReleaseRevisionsParams](r)
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromURLParamsUnmarshalErr(err))
+		return
+	}
+
+	d, err := h.ReleaseSvc.DiffReleaseRevisions(
+		r.Context(),
+		model.ToSvcDiffReleaseRevisionsParams(params),
+		params.ReleaseID,
+		util.ContextAuthUserID(r),
+	)
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromSvcErr(err))
+		return
+	}
+
+	util.WriteJSONResponse(w, http.StatusOK, model.ToReleaseRevisionDiff(d))
+}
+
+func (h *Handler) restoreReleaseRevision(w http.ResponseWriter, r *http.Request) {
+	params, err := util.UnmarshalURLParams[model.ReleaseRevisionURLParams](r)
+	if err != nil {
+		util.WriteResponseError(w, resperr.NewFromURLParamsUnmarshalErr(err))
+		return
+	}
+
+	if err := h.ReleaseSvc.RestoreReleaseRevision(
+		r.Context(),
+		params.ReleaseID,
+		params.RevisionNumber,
+		util.ContextAuthUserID(r),
+	); err != nil {
+		util.WriteResponseError(w, resperr.NewFromSvcErr(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
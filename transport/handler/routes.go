@@ -28,6 +28,11 @@ func (h *Handler) setupRoutes() {
 		})
 	})
 
+	h.Mux.Route("/admin/github-webhook-deliveries", func(r chi.Router) {
+		r.Get("/", middleware.RequireAuthUser(h.listGithubWebhookDeliveries))
+		r.Post("/{delivery_id}/replay", middleware.RequireAuthUser(h.replayGithubWebhookDelivery))
+	})
+
 	h.Mux.Route("/projects", func(r chi.Router) {
 		r.Post("/", middleware.RequireAuthUser(h.createProject))
 		r.Get("/", middleware.RequireAuthUser(h.listProjects))
@@ -65,26 +70,76 @@ func (h *Handler) setupRoutes() {
 			r.Route("/github-repo", func(r chi.Router) {
 				r.Post("/", middleware.RequireAuthUser(h.setGithubRepoForProject))
 				r.Get("/", middleware.RequireAuthUser(h.getGithubRepoForProject))
-				r.Get("/tags", middleware.RequireAuthUser(h.listGithubRepoTags))
-				r.Post("/release-notes", middleware.RequireAuthUser(h.generateGithubReleaseNotes))
+				r.Delete("/", middleware.RequireAuthUser(h.unlinkGithubRepoFromProject))
+				r.Post("/webhook-secret/rotate", middleware.RequireAuthUser(h.rotateGithubWebhookSecret))
+				r.Get("/tags", middleware.RequireAuthUser(h.listGitRepoTags))
+				r.Post("/release-notes", middleware.RequireAuthUser(h.generateGitReleaseNotes))
+			})
+			r.Route("/gitlab-repo", func(r chi.Router) {
+				r.Post("/", middleware.RequireAuthUser(h.setGitlabRepoForProject))
+				r.Get("/", middleware.RequireAuthUser(h.getGitlabRepoForProject))
+				r.Delete("/", middleware.RequireAuthUser(h.unlinkGitlabRepoFromProject))
+				// Tags and release notes are served by the provider of the repo linked to the project
+				r.Get("/tags", middleware.RequireAuthUser(h.listGitRepoTags))
+				r.Post("/release-notes", middleware.RequireAuthUser(h.generateGitReleaseNotes))
+			})
+			r.Route("/bitbucket-repo", func(r chi.Router) {
+				r.Post("/", middleware.RequireAuthUser(h.setBitbucketRepoForProject))
+				r.Get("/", middleware.RequireAuthUser(h.getBitbucketRepoForProject))
+				r.Delete("/", middleware.RequireAuthUser(h.unlinkBitbucketRepoFromProject))
+				r.Get("/tags", middleware.RequireAuthUser(h.listGitRepoTags))
 			})
 			r.Route("/releases", func(r chi.Router) {
 				r.Get("/", middleware.RequireAuthUser(h.listReleases))
 				r.Post("/", middleware.RequireAuthUser(h.createRelease))
+				r.Post("/import", middleware.RequireAuthUser(h.importGithubReleases))
+				r.Get("/next-version", middleware.RequireAuthUser(h.getNextReleaseVersion))
+				r.Get("/compare", middleware.RequireAuthUser(h.compareReleases))
+				r.Get("/changelog", middleware.RequireAuthUser(h.exportChangelog))
 			})
 			r.Route("/deployments", func(r chi.Router) {
 				r.Post("/", middleware.RequireAuthUser(h.createDeployment))
 				r.Get("/", middleware.RequireAuthUser(h.listDeploymentsForProject))
+				r.Route("/{deployment_id}", func(r chi.Router) {
+					r.Post("/approve", middleware.RequireAuthUser(h.approveDeployment))
+					r.Post("/reject", middleware.RequireAuthUser(h.rejectDeployment))
+				})
+			})
+			r.Route("/plans", func(r chi.Router) {
+				r.Post("/", middleware.RequireAuthUser(h.createReleasePlan))
+				r.Get("/", middleware.RequireAuthUser(h.listReleasePlans))
+				r.Route("/{plan_id}", func(r chi.Router) {
+					r.Get("/", middleware.RequireAuthUser(h.getReleasePlan))
+					r.Patch("/", middleware.RequireAuthUser(h.updateReleasePlan))
+					r.Delete("/", middleware.RequireAuthUser(h.deleteReleasePlan))
+					r.Post("/release", middleware.RequireAuthUser(h.convertReleasePlan))
+				})
 			})
 		})
 	})
 
+	h.Mux.Get("/releases/search", middleware.RequireAuthUser(h.searchReleases))
 	h.Mux.Route("/releases/{release_id}", func(r chi.Router) {
 		r.Get("/", middleware.RequireAuthUser(h.getRelease))
 		r.Patch("/", middleware.RequireAuthUser(h.updateRelease))
 		r.Delete("/", middleware.RequireAuthUser(h.deleteRelease))
+		r.Put("/status", middleware.RequireAuthUser(h.updateReleaseStatus))
 		r.Post("/slack-notifications", middleware.RequireAuthUser(h.sendReleaseNotification))
-		r.Put("/github-release", middleware.RequireAuthUser(h.upsertGithubRelease))
+		r.Put("/github-release", middleware.RequireAuthUser(h.upsertGitRelease))
+		r.Put("/gitlab-release", middleware.RequireAuthUser(h.upsertGitRelease))
+		r.Post("/linked-items/sync", middleware.RequireAuthUser(h.syncReleaseLinkedItems))
+		r.Route("/revisions", func(r chi.Router) {
+			r.Get("/", middleware.RequireAuthUser(h.listReleaseRevisions))
+			r.Get("/diff", middleware.RequireAuthUser(h.diffReleaseRevisions))
+			r.Post("/{revision_number}/restore", middleware.RequireAuthUser(h.restoreReleaseRevision))
+		})
+		r.Route("/attachments", func(r chi.Router) {
+			r.Post("/", middleware.RequireAuthUser(h.uploadReleaseAttachment))
+			r.Route("/{attachment_id}", func(r chi.Router) {
+				r.Put("/", middleware.RequireAuthUser(h.replaceReleaseAttachment))
+				r.Delete("/", middleware.RequireAuthUser(h.deleteReleaseAttachment))
+			})
+		})
 	})
 
 	h.Mux.Route("/settings", func(r chi.Router) {
@@ -92,7 +147,9 @@ func (h *Handler) setupRoutes() {
 		r.Patch("/", middleware.RequireAuthUser(h.updateSettings))
 	})
 
-	h.Mux.Post("/webhooks/github/tags", h.handleGithubTagDeletionWebhook)
+	h.Mux.Post("/webhooks/github/tags", h.handleGithubTagWebhook)
+	h.Mux.Post("/webhooks/gitlab/tags", h.handleGitlabTagWebhook)
+	h.Mux.Post("/webhooks/bitbucket/tags", h.handleBitbucketTagWebhook)
 
 	h.Mux.Get("/ping", func(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusNoContent)
@@ -1,7 +1,6 @@
 package handler
 
 import (
-	"errors"
 	"io"
 	"net/http"
 
@@ -11,38 +10,115 @@ import (
 )
 
 const (
-	githubWebhookDeleteEventName = "delete"
-	SignatureHeader              = "X-Hub-Signature-256"
+	SignatureHeader = "X-Hub-Signature-256"
 	// GithubHookEvent is the header key for the GitHub webhook event type.
 	// Docs: https://docs.github.com/en/webhooks/webhook-events-and-payloads
 	GithubHookEvent = "X-GitHub-Event"
+	// GithubHookDelivery is the header key for the unique ID of the GitHub webhook delivery.
+	GithubHookDelivery = "X-GitHub-Delivery"
+	// GitlabHookEvent is the header key for the GitLab webhook event type.
+	// Docs: https://docs.gitlab.com/ee/user/project/integrations/webhooks.html
+	GitlabHookEvent = "X-Gitlab-Event"
+	// GitlabHookToken is the header key for the secret token of the GitLab webhook.
+	GitlabHookToken = "X-Gitlab-Token"
+	// BitbucketHookEvent is the header key for the Bitbucket webhook event type, both Cloud and Server send it.
+	// Docs: https://support.atlassian.com/bitbucket-cloud/docs/event-payloads/#HTTP-headers
+	BitbucketHookEvent = "X-Event-Key"
+	// BitbucketHookSignature is the header key for the signature of the Bitbucket webhook payload.
+	BitbucketHookSignature = "X-Hub-Signature"
 )
 
-func (h *Handler) handleGithubTagDeletionWebhook(w http.ResponseWriter, r *http.Request) {
-	event := r.Header.Get(GithubHookEvent)
-	if event != githubWebhookDeleteEventName {
-		util.WriteResponseError(w, resperrors.NewDefaultBadRequestError().Wrap(errors.New("not a delete event")))
-		return
-	}
-
+// handleGithubTagWebhook creates a draft release when a tag is created and deletes the release when its tag is deleted.
+func (h *Handler) handleGithubTagWebhook(w http.ResponseWriter, r *http.Request) {
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
 		util.WriteResponseError(w, resperrors.NewInvalidRequestPayloadError().Wrap(err))
 		return
 	}
 
-	input := model.ToSvcGithubTagDeletionWebhookInput(
+	input := model.ToSvcGithubWebhookDeliveryInput(
+		r.Header.Get(GithubHookDelivery),
+		r.Header.Get(GithubHookEvent),
 		body,
 		r.Header.Get(SignatureHeader),
 	)
 
-	if err := h.ReleaseSvc.DeleteReleaseOnGitTagRemoval(
-		r.Context(),
-		input,
-	); err != nil {
+	if err := h.ReleaseSvc.HandleGithubWebhook(r.Context(), input); err != nil {
+		util.WriteResponseError(w, resperrors.NewFromSvcErr(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleGitlabTagWebhook creates a draft release when a tag is pushed and deletes the release when its tag is deleted.
+func (h *Handler) handleGitlabTagWebhook(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		util.WriteResponseError(w, resperrors.NewInvalidRequestPayloadError().Wrap(err))
+		return
+	}
+
+	input := model.ToSvcGitlabWebhookInput(r.Header.Get(GitlabHookEvent), r.Header.Get(GitlabHookToken), body)
+
+	if err := h.ReleaseSvc.HandleGitlabWebhook(r.Context(), input); err != nil {
+		util.WriteResponseError(w, resperrors.NewFromSvcErr(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleBitbucketTagWebhook deletes the releases of the tags deleted by a push.
+func (h *Handler) handleBitbucketTagWebhook(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		util.WriteResponseError(w, resperrors.NewInvalidRequestPayloadError().Wrap(err))
+		return
+	}
+
+	input := model.ToSvcBitbucketWebhookInput(r.Header.Get(BitbucketHookEvent), r.Header.Get(BitbucketHookSignature), body)
+
+	if err := h.ReleaseSvc.HandleBitbucketWebhook(r.Context(), input); err != nil {
 		util.WriteResponseError(w, resperrors.NewFromSvcErr(err))
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+func (h *Handler) listGithubWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	params, err := util.UnmarshalURLParams[model.ListGithubWebhookDeliveriesParams](r)
+	if err != nil {
+		util.WriteResponseError(w, resperrors.NewFromURLParamsUnmarshalErr(err))
+		return
+	}
+
+	deliveries, err := h.ReleaseSvc.ListGithubWebhookDeliveries(
+		r.Context(),
+		model.ToSvcListGithubWebhookDeliveriesParams(params),
+		util.ContextAuthUserID(r),
+	)
+	if err != nil {
+		util.WriteResponseError(w, resperrors.NewFromSvcErr(err))
+		return
+	}
+
+	util.WriteJSONResponse(w, http.StatusOK, model.ToGithubWebhookDeliveries(deliveries))
+}
+
+func (h *Handler) replayGithubWebhookDelivery(w http.ResponseWriter, r *http.Request) {
+	params, err := util.UnmarshalURLParams[model.ReplayGithubWebhookDeliveryParams](r)
+	if err != nil {
+		util.WriteResponseError(w, resperrors.NewFromURLParamsUnmarshalErr(err))
+		return
+	}
+
+	d, err := h.ReleaseSvc.ReplayGithubWebhookDelivery(r.Context(), params.DeliveryID, util.ContextAuthUserID(r))
+	if err != nil {
+		util.WriteResponseError(w, resperrors.NewFromSvcErr(err))
+		return
+	}
+
+	util.WriteJSONResponse(w, http.StatusOK, model.ToGithubWebhookDelivery(d))
+}
@@ -0,0 +1,87 @@
+package model
+
+import (
+	"time"
+
+	"release-manager/pkg/id"
+	svcmodel "release-manager/service/model"
+)
+
+const (
+	// ChangelogSchemaVersion is increased on every breaking change of the JSON changelog.
+	ChangelogSchemaVersion = 1
+
+	ChangelogFileName = "CHANGELOG.md"
+)
+
+type ExportChangelogParams struct {
+	ProjectID  id.Project        `param:"path=project_id"`
+	Format     *string           `param:"query=format"`
+	MinVersion *svcmodel.Version `param:"query=min_version"`
+	MaxVersion *svcmodel.Version `param:"query=max_version"`
+}
+
+// Changelog is a JSON changelog, its schema must stay backward compatible within the schema version.
+type Changelog struct {
+	SchemaVersion int                `json:"schema_version"`
+	Project       ChangelogProject   `json:"project"`
+	Releases      []ChangelogRelease `json:"releases"`
+}
+
+type ChangelogProject struct {
+	ID   id.Project `json:"id"`
+	Name string     `json:"name"`
+}
+
+type ChangelogRelease struct {
+	Version    *string   `json:"version"`
+	GitTagName string    `json:"git_tag_name"`
+	GitTagURL  string    `json:"git_tag_url"`
+	Title      string    `json:"title"`
+	Notes      string    `json:"notes"`
+	Status     string    `json:"status"`
+	ReleasedAt time.Time `json:"released_at"`
+}
+
+func ToSvcExportChangelogParams(p ExportChangelogParams) svcmodel.ExportChangelogParams {
+	format := svcmodel.ChangelogFormatMarkdown
+	if p.Format != nil {
+		format = svcmodel.ChangelogFormat(*p.Format)
+	}
+
+	return svcmodel.ExportChangelogParams{
+		Format:     format,
+		MinVersion: p.MinVersion,
+		MaxVersion: p.MaxVersion,
+	}
+}
+
+func ToChangelog(c svcmodel.Changelog) Changelog {
+	releases := make([]ChangelogRelease, 0, len(c.Releases))
+	for _, rls := range c.Releases {
+		var version *string
+		if rls.Version != nil {
+			v := rls.Version.String()
+			version = &v
+		}
+
+		releases = append(releases, ChangelogRelease{
+			Version:    version,
+			GitTagName: rls.Tag.Name,
+			GitTagURL:  rls.Tag.URL.String(),
+			Title:      rls.ReleaseTitle,
+			Notes:      rls.ReleaseNotes,
+			Status:     string(rls.Status),
+			ReleasedAt: rls.CreatedAt,
+		})
+	}
+
+	return Changelog{
+		SchemaVersion: ChangelogSchemaVersion,
+		Project: ChangelogProject{
+			ID:   c.Project.ID,
+			Name: c.Project.Name,
+		},
+		Releases: releases,
+	}
+}
@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// Date is a calendar date in the YYYY-MM-DD format, used in JSON bodies and query params.
+type Date time.Time
+
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(time.Time(d).Format(time.DateOnly)), nil
+}
+
+func (d *Date) UnmarshalText(data []byte) error {
+	t, err := time.Parse(time.DateOnly, string(data))
+	if err != nil {
+		return err
+	}
+
+	*d = Date(t)
+	return nil
+}
+
+func (d *Date) toTimePtr() *time.Time {
+	if d == nil {
+		return nil
+	}
+
+	t := time.Time(*d)
+	return &t
+}
@@ -13,20 +13,34 @@ type CreateDeploymentInput struct {
 }
 
 type Deployment struct {
-	ID                    id.Deployment  `json:"id"`
-	ReleaseID             id.Release     `json:"release_id"`
-	ReleaseTitle          string         `json:"release_title"`
-	EnvironmentID         id.Environment `json:"environment_id"`
-	EnvironmentName       string         `json:"environment_name"`
-	EnvironmentServiceURL string         `json:"environment_service_url"`
-	DeployedByUserID      id.AuthUser    `json:"deployed_by_user_id"`
-	DeployedAt            time.Time      `json:"deployed_at"`
+	ID                    id.Deployment        `json:"id"`
+	ReleaseID             id.Release           `json:"release_id"`
+	ReleaseTitle          string               `json:"release_title"`
+	EnvironmentID         id.Environment       `json:"environment_id"`
+	EnvironmentName       string               `json:"environment_name"`
+	EnvironmentServiceURL string               `json:"environment_service_url"`
+	Status                string               `json:"status"`
+	Approvals             []DeploymentApproval `json:"approvals"`
+	DeployedByUserID      id.AuthUser          `json:"deployed_by_user_id"`
+	DeployedAt            time.Time            `json:"deployed_at"`
+}
+
+type DeploymentApproval struct {
+	UserID    id.AuthUser `json:"user_id"`
+	Decision  string      `json:"decision"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+type DeploymentURLParams struct {
+	ProjectID    id.Project    `param:"path=project_id"`
+	DeploymentID id.Deployment `param:"path=deployment_id"`
 }
 
 type ListDeploymentsParams struct {
 	ProjectID     id.Project      `param:"path=project_id"`
 	ReleaseID     *id.Release     `param:"query=release_id"`
 	EnvironmentID *id.Environment `param:"query=environment_id"`
+	Status        *string         `param:"query=status"`
 	LatestOnly    *bool           `param:"query=latest_only"`
 }
 
@@ -41,11 +55,21 @@ func ToSvcListDeploymentsFilterParams(p ListDeploymentsParams) svcmodel.ListDepl
 	return svcmodel.ListDeploymentsFilterParams{
 		ReleaseID:     p.ReleaseID,
 		EnvironmentID: p.EnvironmentID,
+		Status:        (*svcmodel.DeploymentStatus)(p.Status),
 		LatestOnly:    p.LatestOnly,
 	}
 }
 
 func ToDeployment(dpl svcmodel.Deployment) Deployment {
+	approvals := make([]DeploymentApproval, 0, len(dpl.Approvals))
+	for _, a := range dpl.Approvals {
+		approvals = append(approvals, DeploymentApproval{
+			UserID:    a.UserID,
+			Decision:  string(a.Decision),
+			CreatedAt: a.CreatedAt,
+		})
+	}
+
 	return Deployment{
 		ID:                    dpl.ID,
 		ReleaseID:             dpl.Release.ID,
@@ -53,6 +77,8 @@ func ToDeployment(dpl svcmodel.Deployment) Deployment {
 		EnvironmentID:         dpl.Environment.ID,
 		EnvironmentName:       dpl.Environment.Name,
 		EnvironmentServiceURL: dpl.Environment.ServiceURL.String(),
+		Status:                string(dpl.Status),
+		Approvals:             approvals,
 		DeployedByUserID:      dpl.DeployedByUserID,
 		DeployedAt:            dpl.DeployedAt,
 	}
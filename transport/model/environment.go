@@ -8,21 +8,30 @@ import (
 )
 
 type CreateEnvironmentInput struct {
-	Name       string `json:"name" validate:"required"`
-	ServiceURL string `json:"service_url" validate:"omitempty,http_url"`
+	Name           string         `json:"name" validate:"required"`
+	ServiceURL     string         `json:"service_url" validate:"omitempty,http_url"`
+	ApprovalPolicy ApprovalPolicy `json:"approval_policy"`
 }
 
 type UpdateEnvironmentInput struct {
-	Name       *string `json:"name" validate:"omitempty,min=1"`
-	ServiceURL *string `json:"service_url" validate:"omitempty,optional_http_url"`
+	Name           *string         `json:"name" validate:"omitempty,min=1"`
+	ServiceURL     *string         `json:"service_url" validate:"omitempty,optional_http_url"`
+	ApprovalPolicy *ApprovalPolicy `json:"approval_policy"`
 }
 
 type Environment struct {
-	ID         id.Environment `json:"id"`
-	Name       string         `json:"name"`
-	ServiceURL string         `json:"service_url"`
-	CreatedAt  time.Time      `json:"created_at"`
-	UpdatedAt  time.Time      `json:"updated_at"`
+	ID             id.Environment `json:"id"`
+	Name           string         `json:"name"`
+	ServiceURL     string         `json:"service_url"`
+	ApprovalPolicy ApprovalPolicy `json:"approval_policy"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+}
+
+type ApprovalPolicy struct {
+	RequiredApprovals int       `json:"required_approvals"`
+	ApproverUserIDs   []id.User `json:"approver_user_ids"`
+	ApproverRoles     []string  `json:"approver_roles"`
 }
 
 type EnvironmentURLParams struct {
@@ -32,26 +41,64 @@ type EnvironmentURLParams struct {
 
 func ToSvcCreateEnvironmentInput(c CreateEnvironmentInput, projectID id.Project) svcmodel.CreateEnvironmentInput {
 	return svcmodel.CreateEnvironmentInput{
-		ProjectID:     projectID,
-		Name:          c.Name,
-		ServiceRawURL: c.ServiceURL,
+		ProjectID:      projectID,
+		Name:           c.Name,
+		ServiceRawURL:  c.ServiceURL,
+		ApprovalPolicy: ToSvcApprovalPolicy(c.ApprovalPolicy),
 	}
 }
 
 func ToSvcUpdateEnvironmentInput(u UpdateEnvironmentInput) svcmodel.UpdateEnvironmentInput {
+	var policy *svcmodel.ApprovalPolicy
+	if u.ApprovalPolicy != nil {
+		p := ToSvcApprovalPolicy(*u.ApprovalPolicy)
+		policy = &p
+	}
+
 	return svcmodel.UpdateEnvironmentInput{
-		Name:          u.Name,
-		ServiceRawURL: u.ServiceURL,
+		Name:           u.Name,
+		ServiceRawURL:  u.ServiceURL,
+		ApprovalPolicy: policy,
+	}
+}
+
+func ToSvcApprovalPolicy(p ApprovalPolicy) svcmodel.ApprovalPolicy {
+	roles := make([]svcmodel.ProjectRole, 0, len(p.ApproverRoles))
+	for _, r := range p.ApproverRoles {
+		roles = append(roles, svcmodel.ProjectRole(r))
+	}
+
+	return svcmodel.ApprovalPolicy{
+		RequiredApprovals: p.RequiredApprovals,
+		ApproverUserIDs:   p.ApproverUserIDs,
+		ApproverRoles:     roles,
+	}
+}
+
+func ToApprovalPolicy(p svcmodel.ApprovalPolicy) ApprovalPolicy {
+	userIDs := make([]id.User, 0, len(p.ApproverUserIDs))
+	userIDs = append(userIDs, p.ApproverUserIDs...)
+
+	roles := make([]string, 0, len(p.ApproverRoles))
+	for _, r := range p.ApproverRoles {
+		roles = append(roles, string(r))
+	}
+
+	return ApprovalPolicy{
+		RequiredApprovals: p.RequiredApprovals,
+		ApproverUserIDs:   userIDs,
+		ApproverRoles:     roles,
 	}
 }
 
 func ToEnvironment(e svcmodel.Environment) Environment {
 	return Environment{
-		ID:         e.ID,
-		Name:       e.Name,
-		ServiceURL: e.ServiceURL.String(),
-		CreatedAt:  e.CreatedAt,
-		UpdatedAt:  e.UpdatedAt,
+		ID:             e.ID,
+		Name:           e.Name,
+		ServiceURL:     e.ServiceURL.String(),
+		ApprovalPolicy: ToApprovalPolicy(e.ApprovalPolicy),
+		CreatedAt:      e.CreatedAt,
+		UpdatedAt:      e.UpdatedAt,
 	}
 }
 
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"time"
+
+	"release-manager/pkg/id"
+	svcmodel "release-manager/service/model"
+)
+
+type ListGitRepoTagsParams struct {
+	ProjectID id.Project `param:"path=project_id"`
+	Prefix    *string    `param:"query=prefix"`
+	Search    *string    `param:"query=search"`
+	SortBy    *string    `param:"query=sort_by"`
+}
+
+type RepoGitTag struct {
+	Name          string     `json:"name"`
+	URL           string     `json:"url"`
+	CommittedAt   *time.Time `json:"committed_at"`
+	UsedByRelease bool       `json:"used_by_release"`
+}
+
+func ToSvcListGitTagsParams(p ListGitRepoTagsParams) svcmodel.ListGitTagsParams {
+	params := svcmodel.ListGitTagsParams{
+		Prefix: p.Prefix,
+		Search: p.Search,
+	}
+	if p.SortBy != nil {
+		params.SortBy = svcmodel.GitTagSortBy(*p.SortBy)
+	}
+
+	return params
+}
+
+func ToRepoGitTags(tags []svcmodel.RepoGitTag) []RepoGitTag {
+	t := make([]RepoGitTag, 0, len(tags))
+	for _, tag := range tags {
+		t = append(t, RepoGitTag{
+			Name:          tag.Tag.Name,
+			URL:           tag.Tag.URL.String(),
+			CommittedAt:   tag.CommittedAt,
+			UsedByRelease: tag.UsedByRelease,
+		})
+	}
+	return t
+}
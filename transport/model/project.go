@@ -11,12 +11,16 @@ type CreateProjectInput struct {
 	Name                      string                    `json:"name" validate:"required"`
 	SlackChannelID            string                    `json:"slack_channel_id"`
 	ReleaseNotificationConfig ReleaseNotificationConfig `json:"release_notification_config"`
+	VersionTagPrefix          *string                   `json:"version_tag_prefix"`
+	ReleaseNotesTemplate      *ReleaseNotesTemplate     `json:"release_notes_template"`
 }
 
 type UpdateProjectInput struct {
 	Name                      *string                              `json:"name" validate:"omitempty,min=1"`
 	SlackChannelID            *string                              `json:"slack_channel_id"`
 	ReleaseNotificationConfig UpdateReleaseNotificationConfigInput `json:"release_notification_config"`
+	VersionTagPrefix          *string                              `json:"version_tag_prefix"`
+	ReleaseNotesTemplate      *ReleaseNotesTemplate                `json:"release_notes_template"`
 }
 
 type SetProjectGithubRepoInput struct {
@@ -25,11 +29,25 @@ type SetProjectGithubRepoInput struct {
 	RawRepoURL string `json:"github_repo_url" validate:"required"`
 }
 
+type SetProjectGitlabRepoInput struct {
+	// RawRepoURL can be also relative URL, e.g. /group/subgroup/project
+	// Therefore URL is not validated here
+	RawRepoURL string `json:"gitlab_repo_url" validate:"required"`
+}
+
+type SetProjectBitbucketRepoInput struct {
+	// RawRepoURL can be also relative URL, e.g. /projects/KEY/repos/repo on Bitbucket Server
+	// Therefore URL is not validated here
+	RawRepoURL string `json:"bitbucket_repo_url" validate:"required"`
+}
+
 type Project struct {
 	ID                        id.Project                `json:"id"`
 	Name                      string                    `json:"name"`
 	SlackChannelID            string                    `json:"slack_channel_id"`
 	ReleaseNotificationConfig ReleaseNotificationConfig `json:"release_notification_config"`
+	VersionTagPrefix          string                    `json:"version_tag_prefix"`
+	ReleaseNotesTemplate      ReleaseNotesTemplate      `json:"release_notes_template"`
 	CreatedAt                 time.Time                 `json:"created_at"`
 	UpdatedAt                 time.Time                 `json:"updated_at"`
 }
@@ -41,6 +59,16 @@ type ReleaseNotificationConfig struct {
 	ShowReleaseNotes   bool   `json:"show_release_notes"`
 	ShowLastDeployment bool   `json:"show_last_deployment"`
 	ShowSourceCode     bool   `json:"show_source_code"`
+	ShowLinkedItems    bool   `json:"show_linked_items"`
+}
+
+type ReleaseNotesTemplate struct {
+	Sections []ReleaseNotesTemplateSection `json:"sections"`
+}
+
+type ReleaseNotesTemplateSection struct {
+	Title    string `json:"title"`
+	Required bool   `json:"required"`
 }
 
 type UpdateReleaseNotificationConfigInput struct {
@@ -50,21 +78,48 @@ type UpdateReleaseNotificationConfigInput struct {
 	ShowReleaseNotes   *bool   `json:"show_release_notes"`
 	ShowLastDeployment *bool   `json:"show_last_deployment"`
 	ShowSourceCode     *bool   `json:"show_source_code"`
+	ShowLinkedItems    *bool   `json:"show_linked_items"`
 }
 
 func ToSvcCreateProjectInput(c CreateProjectInput) svcmodel.CreateProjectInput {
+	var tmpl svcmodel.ReleaseNotesTemplate
+	if c.ReleaseNotesTemplate != nil {
+		tmpl = ToSvcReleaseNotesTemplate(*c.ReleaseNotesTemplate)
+	}
+
 	return svcmodel.CreateProjectInput{
 		Name:                      c.Name,
 		SlackChannelID:            c.SlackChannelID,
 		ReleaseNotificationConfig: svcmodel.ReleaseNotificationConfig(c.ReleaseNotificationConfig),
+		VersionTagPrefix:          c.VersionTagPrefix,
+		ReleaseNotesTemplate:      tmpl,
 	}
 }
 
 func ToSvcUpdateProjectInput(u UpdateProjectInput) svcmodel.UpdateProjectInput {
+	var tmpl *svcmodel.ReleaseNotesTemplate
+	if u.ReleaseNotesTemplate != nil {
+		t := ToSvcReleaseNotesTemplate(*u.ReleaseNotesTemplate)
+		tmpl = &t
+	}
+
 	return svcmodel.UpdateProjectInput{
 		Name:                            u.Name,
 		SlackChannelID:                  u.SlackChannelID,
 		ReleaseNotificationConfigUpdate: svcmodel.UpdateReleaseNotificationConfigInput(u.ReleaseNotificationConfig),
+		VersionTagPrefix:                u.VersionTagPrefix,
+		ReleaseNotesTemplate:            tmpl,
+	}
+}
+
+func ToSvcReleaseNotesTemplate(t ReleaseNotesTemplate) svcmodel.ReleaseNotesTemplate {
+	sections := make([]svcmodel.ReleaseNotesTemplateSection, 0, len(t.Sections))
+	for _, s := range t.Sections {
+		sections = append(sections, svcmodel.ReleaseNotesTemplateSection(s))
+	}
+
+	return svcmodel.ReleaseNotesTemplate{
+		Sections: sections,
 	}
 }
 
@@ -74,11 +129,24 @@ func ToProject(p svcmodel.Project) Project {
 		Name:                      p.Name,
 		SlackChannelID:            p.SlackChannelID,
 		ReleaseNotificationConfig: ReleaseNotificationConfig(p.ReleaseNotificationConfig),
+		VersionTagPrefix:          p.VersionTagPrefix,
+		ReleaseNotesTemplate:      ToReleaseNotesTemplate(p.ReleaseNotesTemplate),
 		CreatedAt:                 p.CreatedAt,
 		UpdatedAt:                 p.UpdatedAt,
 	}
 }
 
+func ToReleaseNotesTemplate(t svcmodel.ReleaseNotesTemplate) ReleaseNotesTemplate {
+	sections := make([]ReleaseNotesTemplateSection, 0, len(t.Sections))
+	for _, s := range t.Sections {
+		sections = append(sections, ReleaseNotesTemplateSection(s))
+	}
+
+	return ReleaseNotesTemplate{
+		Sections: sections,
+	}
+}
+
 func ToProjects(projects []svcmodel.Project) []Project {
 	p := make([]Project, 0, len(projects))
 	for _, project := range projects {
@@ -97,3 +165,31 @@ func ToGithubRepo(repo svcmodel.GithubRepo) GithubRepo {
 		GithubRepoURL: repo.URL.String(),
 	}
 }
+
+type GitlabRepo struct {
+	GitlabRepoURL   string `json:"gitlab_repo_url"`
+	GitlabProjectID int64  `json:"gitlab_project_id"`
+	GitlabPath      string `json:"gitlab_path"`
+}
+
+func ToGitlabRepo(repo svcmodel.GitlabRepo) GitlabRepo {
+	return GitlabRepo{
+		GitlabRepoURL:   repo.URL.String(),
+		GitlabProjectID: repo.ProjectID,
+		GitlabPath:      repo.Path,
+	}
+}
+
+type BitbucketRepo struct {
+	BitbucketRepoURL   string `json:"bitbucket_repo_url"`
+	BitbucketWorkspace string `json:"bitbucket_workspace"`
+	BitbucketRepoSlug  string `json:"bitbucket_repo_slug"`
+}
+
+func ToBitbucketRepo(repo svcmodel.BitbucketRepo) BitbucketRepo {
+	return BitbucketRepo{
+		BitbucketRepoURL:   repo.URL.String(),
+		BitbucketWorkspace: repo.Workspace,
+		BitbucketRepoSlug:  repo.RepoSlug,
+	}
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"mime/multipart"
 	"time"
 
 	"release-manager/pkg/id"
@@ -13,26 +14,86 @@ type CreateReleaseInput struct {
 	ReleaseTitle string `json:"release_title" validate:"required"`
 	ReleaseNotes string `json:"release_notes"`
 	GitTagName   string `json:"git_tag_name" validate:"required"`
+	// GitTagTarget is a branch name or a commit SHA to create the tag from, the tag must exist if it is not set.
+	GitTagTarget  *string                   `json:"git_tag_target" validate:"omitempty,min=1"`
+	GithubOptions GithubReleaseOptionsInput `json:"github_options"`
 }
 
 type UpdateReleaseInput struct {
-	ReleaseTitle *string `json:"release_title" validate:"omitempty,min=1"`
-	ReleaseNotes *string `json:"release_notes"`
+	ReleaseTitle  *string                   `json:"release_title" validate:"omitempty,min=1"`
+	ReleaseNotes  *string                   `json:"release_notes"`
+	GithubOptions GithubReleaseOptionsInput `json:"github_options"`
+}
+
+// GithubReleaseOptionsInput changes only the options which are set,
+// defaults of a new release are based on its version.
+type GithubReleaseOptionsInput struct {
+	Draft      *bool   `json:"draft"`
+	Prerelease *bool   `json:"prerelease"`
+	MakeLatest *string `json:"make_latest"`
+}
+
+type UpdateReleaseStatusInput struct {
+	Status string `json:"status" validate:"required"`
 }
 
 type DeleteReleaseInput struct {
+	// DeleteGitRelease deletes the release of the provider of the repo linked to the project
+	DeleteGitRelease bool `json:"delete_git_release"`
+	// DeleteGithubRelease is kept for existing clients, it is the same as DeleteGitRelease
 	DeleteGithubRelease bool `json:"delete_github_release"`
 }
 
 type Release struct {
-	ID           id.Release          `json:"id"`
-	ProjectID    id.Project          `json:"project_id"`
-	ReleaseTitle string              `json:"release_title"`
-	ReleaseNotes string              `json:"release_notes"`
-	Tag          GitTag              `json:"git_tag"`
-	Attachments  []ReleaseAttachment `json:"attachments"`
-	CreatedAt    time.Time           `json:"created_at"`
-	UpdatedAt    time.Time           `json:"updated_at"`
+	ID            id.Release           `json:"id"`
+	ProjectID     id.Project           `json:"project_id"`
+	ReleaseTitle  string               `json:"release_title"`
+	ReleaseNotes  string               `json:"release_notes"`
+	Status        string               `json:"status"`
+	Tag           GitTag               `json:"git_tag"`
+	Version       *string              `json:"version"`
+	GithubOptions GithubReleaseOptions `json:"github_options"`
+	Attachments   []ReleaseAttachment  `json:"attachments"`
+	LinkedItems   *ReleaseLinkedItems  `json:"linked_items"`
+	CreatedAt     time.Time            `json:"created_at"`
+	UpdatedAt     time.Time            `json:"updated_at"`
+}
+
+type GithubReleaseOptions struct {
+	Draft      bool    `json:"draft"`
+	Prerelease bool    `json:"prerelease"`
+	MakeLatest *string `json:"make_latest"`
+}
+
+type ListReleasesParams struct {
+	ProjectID               id.Project              `param:"path=project_id"`
+	SortBy                  *string                 `param:"query=sort_by"`
+	SortOrder               *string                 `param:"query=sort_order"`
+	MinVersion              *svcmodel.Version       `param:"query=min_version"`
+	MaxVersion              *svcmodel.Version       `param:"query=max_version"`
+	CreatedFrom             *time.Time              `param:"query=created_from"`
+	CreatedTo               *time.Time              `param:"query=created_to"`
+	AuthorUserID            *id.AuthUser            `param:"query=author_user_id"`
+	GitTagPrefix            *string                 `param:"query=git_tag_prefix"`
+	DeployedToEnvironmentID *id.Environment         `param:"query=deployed_to_environment_id"`
+	Limit                   *int                    `param:"query=limit"`
+	Cursor                  *svcmodel.ReleaseCursor `param:"query=cursor"`
+}
+
+type ReleasePage struct {
+	Releases   []Release `json:"releases"`
+	NextCursor *string   `json:"next_cursor"`
+}
+
+type NextReleaseVersionParams struct {
+	ProjectID id.Project `param:"path=project_id"`
+	Bump      string     `param:"query=bump"`
+}
+
+type NextReleaseVersion struct {
+	PreviousVersion *string `json:"previous_version"`
+	Version         string  `json:"version"`
+	GitTagName      string  `json:"git_tag_name"`
 }
 
 type GitTag struct {
@@ -40,6 +101,21 @@ type GitTag struct {
 	URL  string `json:"url"`
 }
 
+type ReleaseLinkedItems struct {
+	PreviousGitTagName string              `json:"previous_git_tag_name"`
+	PullRequests       []GithubPullRequest `json:"pull_requests"`
+	Issues             []GithubIssue       `json:"issues"`
+	SyncedAt           time.Time           `json:"synced_at"`
+}
+
+type GithubIssue struct {
+	Number      int       `json:"number"`
+	Title       string    `json:"title"`
+	AuthorLogin string    `json:"author_login"`
+	URL         string    `json:"url"`
+	ClosedAt    time.Time `json:"closed_at"`
+}
+
 type ReleaseAttachment struct {
 	ID   uuid.UUID `json:"id"`
 	Name string    `json:"name"`
@@ -48,35 +124,115 @@ type ReleaseAttachment struct {
 
 func ToSvcCreateReleaseInput(r CreateReleaseInput) svcmodel.CreateReleaseInput {
 	return svcmodel.CreateReleaseInput{
-		ReleaseTitle: r.ReleaseTitle,
-		ReleaseNotes: r.ReleaseNotes,
-		GitTagName:   r.GitTagName,
+		ReleaseTitle:  r.ReleaseTitle,
+		ReleaseNotes:  r.ReleaseNotes,
+		GitTagName:    r.GitTagName,
+		GitTagTarget:  r.GitTagTarget,
+		GithubOptions: ToSvcGithubReleaseOptionsInput(r.GithubOptions),
+	}
+}
+
+func ToSvcGithubReleaseOptionsInput(o GithubReleaseOptionsInput) svcmodel.GithubReleaseOptionsInput {
+	var makeLatest *svcmodel.GithubMakeLatest
+	if o.MakeLatest != nil {
+		m := svcmodel.GithubMakeLatest(*o.MakeLatest)
+		makeLatest = &m
+	}
+
+	return svcmodel.GithubReleaseOptionsInput{
+		Draft:      o.Draft,
+		Prerelease: o.Prerelease,
+		MakeLatest: makeLatest,
+	}
+}
+
+func ToSvcListReleasesFilterParams(p ListReleasesParams) svcmodel.ListReleasesFilterParams {
+	var sortBy svcmodel.ReleaseSortBy
+	if p.SortBy != nil {
+		sortBy = svcmodel.ReleaseSortBy(*p.SortBy)
+	}
+
+	var sortOrder svcmodel.ReleaseSortOrder
+	if p.SortOrder != nil {
+		sortOrder = svcmodel.ReleaseSortOrder(*p.SortOrder)
+	}
+
+	limit := svcmodel.ReleasesPageDefaultLimit
+	if p.Limit != nil {
+		limit = *p.Limit
+	}
+
+	return svcmodel.ListReleasesFilterParams{
+		SortBy:                  sortBy,
+		SortOrder:               sortOrder,
+		MinVersion:              p.MinVersion,
+		MaxVersion:              p.MaxVersion,
+		CreatedFrom:             p.CreatedFrom,
+		CreatedTo:               p.CreatedTo,
+		AuthorUserID:            p.AuthorUserID,
+		GitTagPrefix:            p.GitTagPrefix,
+		DeployedToEnvironmentID: p.DeployedToEnvironmentID,
+		Limit:                   &limit,
+		Cursor:                  p.Cursor,
+	}
+}
+
+func ToSvcReleaseAttachmentInput(file *multipart.Part) svcmodel.ReleaseAttachmentInput {
+	return svcmodel.ReleaseAttachmentInput{
+		Name:        file.FileName(),
+		ContentType: file.Header.Get("Content-Type"),
+		Content:     file,
 	}
 }
 
 func ToSvcUpdateReleaseInput(r UpdateReleaseInput) svcmodel.UpdateReleaseInput {
 	return svcmodel.UpdateReleaseInput{
-		ReleaseTitle: r.ReleaseTitle,
-		ReleaseNotes: r.ReleaseNotes,
+		ReleaseTitle:  r.ReleaseTitle,
+		ReleaseNotes:  r.ReleaseNotes,
+		GithubOptions: ToSvcGithubReleaseOptionsInput(r.GithubOptions),
 	}
 }
 
 func ToSvcDeleteReleaseInput(r DeleteReleaseInput) svcmodel.DeleteReleaseInput {
 	return svcmodel.DeleteReleaseInput{
-		DeleteGithubRelease: r.DeleteGithubRelease,
+		DeleteGitRelease: r.DeleteGitRelease || r.DeleteGithubRelease,
 	}
 }
 
 func ToRelease(r svcmodel.Release) Release {
+	var version *string
+	if r.Version != nil {
+		v := r.Version.String()
+		version = &v
+	}
+
 	return Release{
-		ID:           r.ID,
-		ProjectID:    r.ProjectID,
-		ReleaseTitle: r.ReleaseTitle,
-		ReleaseNotes: r.ReleaseNotes,
-		Tag:          ToGitTag(r.Tag),
-		Attachments:  ToReleaseAttachments(r.Attachments),
-		CreatedAt:    r.CreatedAt,
-		UpdatedAt:    r.UpdatedAt,
+		ID:            r.ID,
+		ProjectID:     r.ProjectID,
+		ReleaseTitle:  r.ReleaseTitle,
+		ReleaseNotes:  r.ReleaseNotes,
+		Status:        string(r.Status),
+		Tag:           ToGitTag(r.Tag),
+		Version:       version,
+		GithubOptions: ToGithubReleaseOptions(r.GithubOptions),
+		Attachments:   ToReleaseAttachments(r.Attachments),
+		LinkedItems:   ToReleaseLinkedItems(r.LinkedItems),
+		CreatedAt:     r.CreatedAt,
+		UpdatedAt:     r.UpdatedAt,
+	}
+}
+
+func ToGithubReleaseOptions(o svcmodel.GithubReleaseOptions) GithubReleaseOptions {
+	var makeLatest *string
+	if o.MakeLatest != "" {
+		m := string(o.MakeLatest)
+		makeLatest = &m
+	}
+
+	return GithubReleaseOptions{
+		Draft:      o.Draft,
+		Prerelease: o.Prerelease,
+		MakeLatest: makeLatest,
 	}
 }
 
@@ -87,12 +243,28 @@ func ToGitTag(t svcmodel.GitTag) GitTag {
 	}
 }
 
-func ToGitTags(tags []svcmodel.GitTag) []GitTag {
-	t := make([]GitTag, 0, len(tags))
-	for _, tag := range tags {
-		t = append(t, ToGitTag(tag))
+func ToReleaseLinkedItems(i *svcmodel.ReleaseLinkedItems) *ReleaseLinkedItems {
+	if i == nil {
+		return nil
+	}
+
+	issues := make([]GithubIssue, 0, len(i.Issues))
+	for _, issue := range i.Issues {
+		issues = append(issues, GithubIssue{
+			Number:      issue.Number,
+			Title:       issue.Title,
+			AuthorLogin: issue.AuthorLogin,
+			URL:         issue.URL.String(),
+			ClosedAt:    issue.ClosedAt,
+		})
+	}
+
+	return &ReleaseLinkedItems{
+		PreviousGitTagName: i.PreviousGitTagName,
+		PullRequests:       ToGithubPullRequests(i.PullRequests),
+		Issues:             issues,
+		SyncedAt:           i.SyncedAt,
 	}
-	return t
 }
 
 func ToReleaseAttachments(attachments []svcmodel.ReleaseAttachment) []ReleaseAttachment {
@@ -119,25 +291,54 @@ func ToReleases(releases []svcmodel.Release) []Release {
 	return r
 }
 
-type GithubReleaseNotesInput struct {
+func ToReleasePage(p svcmodel.ReleasePage) ReleasePage {
+	var nextCursor *string
+	if p.NextCursor != nil {
+		c := p.NextCursor.String()
+		nextCursor = &c
+	}
+
+	return ReleasePage{
+		Releases:   ToReleases(p.Releases),
+		NextCursor: nextCursor,
+	}
+}
+
+func ToNextReleaseVersion(v svcmodel.NextReleaseVersion) NextReleaseVersion {
+	var previous *string
+	if v.PreviousVersion != nil {
+		s := v.PreviousVersion.String()
+		previous = &s
+	}
+
+	return NextReleaseVersion{
+		PreviousVersion: previous,
+		Version:         v.Version.String(),
+		GitTagName:      v.GitTagName,
+	}
+}
+
+type GitReleaseNotesInput struct {
 	GitTagName         string  `json:"git_tag_name" validate:"required"`
 	PreviousGitTagName *string `json:"previous_git_tag_name"`
+	IncludeLinkedItems bool    `json:"include_linked_items"`
 }
 
-type GithubReleaseNotes struct {
+type GitReleaseNotes struct {
 	Title string `json:"title"`
 	Notes string `json:"notes"`
 }
 
-func ToSvcGithubReleaseNotesInput(n GithubReleaseNotesInput) svcmodel.GithubReleaseNotesInput {
-	return svcmodel.GithubReleaseNotesInput{
+func ToSvcGitReleaseNotesInput(n GitReleaseNotesInput) svcmodel.GitReleaseNotesInput {
+	return svcmodel.GitReleaseNotesInput{
 		GitTagName:         &n.GitTagName,
 		PreviousGitTagName: n.PreviousGitTagName,
+		IncludeLinkedItems: n.IncludeLinkedItems,
 	}
 }
 
-func ToGithubReleaseNotes(n svcmodel.GithubReleaseNotes) GithubReleaseNotes {
-	return GithubReleaseNotes{
+func ToGitReleaseNotes(n svcmodel.GitReleaseNotes) GitReleaseNotes {
+	return GitReleaseNotes{
 		Title: n.Title,
 		Notes: n.Notes,
 	}
@@ -0,0 +1,151 @@
+package model
+
+import (
+	"time"
+
+	"release-manager/pkg/id"
+	svcmodel "release-manager/service/model"
+)
+
+type CompareReleasesParams struct {
+	ProjectID     id.Project `param:"path=project_id"`
+	BaseReleaseID id.Release `param:"query=base"`
+	HeadReleaseID id.Release `param:"query=head"`
+}
+
+type ReleaseComparison struct {
+	BaseRelease  Release             `json:"base_release"`
+	HeadRelease  Release             `json:"head_release"`
+	Status       string              `json:"status"`
+	AheadBy      int                 `json:"ahead_by"`
+	BehindBy     int                 `json:"behind_by"`
+	TotalCommits int                 `json:"total_commits"`
+	URL          string              `json:"url"`
+	Commits      []GitCommit         `json:"commits"`
+	Authors      []GitCommitAuthor   `json:"authors"`
+	PullRequests []GithubPullRequest `json:"pull_requests"`
+	Files        []GitChangedFile    `json:"files"`
+	Stats        ReleaseDiffStats    `json:"stats"`
+}
+
+type GitCommit struct {
+	SHA         string          `json:"sha"`
+	Message     string          `json:"message"`
+	Author      GitCommitAuthor `json:"author"`
+	URL         string          `json:"url"`
+	CommittedAt time.Time       `json:"committed_at"`
+}
+
+type GitCommitAuthor struct {
+	Name  string  `json:"name"`
+	Email string  `json:"email"`
+	Login *string `json:"login"`
+}
+
+type GithubPullRequest struct {
+	Number      int       `json:"number"`
+	Title       string    `json:"title"`
+	AuthorLogin string    `json:"author_login"`
+	URL         string    `json:"url"`
+	MergedAt    time.Time `json:"merged_at"`
+}
+
+type GitChangedFile struct {
+	Filename  string `json:"filename"`
+	Status    string `json:"status"`
+	Additions int    `json:"additions"`
+	Deletions int    `json:"deletions"`
+	Changes   int    `json:"changes"`
+}
+
+type ReleaseDiffStats struct {
+	ChangedFiles int `json:"changed_files"`
+	Additions    int `json:"additions"`
+	Deletions    int `json:"deletions"`
+}
+
+func ToSvcCompareReleasesInput(p CompareReleasesParams) svcmodel.CompareReleasesInput {
+	return svcmodel.CompareReleasesInput{
+		BaseReleaseID: p.BaseReleaseID,
+		HeadReleaseID: p.HeadReleaseID,
+	}
+}
+
+func ToReleaseComparison(c svcmodel.ReleaseComparison) ReleaseComparison {
+	return ReleaseComparison{
+		BaseRelease:  ToRelease(c.BaseRelease),
+		HeadRelease:  ToRelease(c.HeadRelease),
+		Status:       c.Status,
+		AheadBy:      c.AheadBy,
+		BehindBy:     c.BehindBy,
+		TotalCommits: c.TotalCommits,
+		URL:          c.URL.String(),
+		Commits:      ToGitCommits(c.Commits),
+		Authors:      ToGitCommitAuthors(c.Authors),
+		PullRequests: ToGithubPullRequests(c.PullRequests),
+		Files:        ToGitChangedFiles(c.Files),
+		Stats: ReleaseDiffStats{
+			ChangedFiles: len(c.Files),
+			Additions:    c.Additions,
+			Deletions:    c.Deletions,
+		},
+	}
+}
+
+func ToGitCommits(commits []svcmodel.GitCommit) []GitCommit {
+	c := make([]GitCommit, 0, len(commits))
+	for _, commit := range commits {
+		c = append(c, GitCommit{
+			SHA:         commit.SHA,
+			Message:     commit.Message,
+			Author:      ToGitCommitAuthor(commit.Author),
+			URL:         commit.URL.String(),
+			CommittedAt: commit.CommittedAt,
+		})
+	}
+	return c
+}
+
+func ToGitCommitAuthor(a svcmodel.GitCommitAuthor) GitCommitAuthor {
+	return GitCommitAuthor{
+		Name:  a.Name,
+		Email: a.Email,
+		Login: a.Login,
+	}
+}
+
+func ToGitCommitAuthors(authors []svcmodel.GitCommitAuthor) []GitCommitAuthor {
+	a := make([]GitCommitAuthor, 0, len(authors))
+	for _, author := range authors {
+		a = append(a, ToGitCommitAuthor(author))
+	}
+	return a
+}
+
+func ToGithubPullRequests(prs []svcmodel.GithubPullRequest) []GithubPullRequest {
+	p := make([]GithubPullRequest, 0, len(prs))
+	for _, pr := range prs {
+		p = append(p, GithubPullRequest{
+			Number:      pr.Number,
+			Title:       pr.Title,
+			AuthorLogin: pr.AuthorLogin,
+			URL:         pr.URL.String(),
+			MergedAt:    pr.MergedAt,
+		})
+	}
+	return p
+}
+
+func ToGitChangedFiles(files []svcmodel.GitChangedFile) []GitChangedFile {
+	f := make([]GitChangedFile, 0, len(files))
+	for _, file := range files {
+		f = append(f, GitChangedFile{
+			Filename:  file.Filename,
+			Status:    file.Status,
+			Additions: file.Additions,
+			Deletions: file.Deletions,
+			Changes:   file.Changes,
+		})
+	}
+	return f
+}
@@ -0,0 +1,34 @@
+package model
+
+import svcmodel "release-manager/service/model"
+
+type GithubReleaseImportSummary struct {
+	Imported []string                     `json:"imported"`
+	Skipped  []string                     `json:"skipped"`
+	Failed   []GithubReleaseImportFailure `json:"failed"`
+}
+
+type GithubReleaseImportFailure struct {
+	GitTagName string `json:"git_tag_name"`
+	Reason     string `json:"reason"`
+}
+
+func ToGithubReleaseImportSummary(s svcmodel.GithubReleaseImportSummary) GithubReleaseImportSummary {
+	// Empty lists are returned as empty arrays instead of null
+	imported := append(make([]string, 0, len(s.Imported)), s.Imported...)
+	skipped := append(make([]string, 0, len(s.Skipped)), s.Skipped...)
+
+	failed := make([]GithubReleaseImportFailure, 0, len(s.Failed))
+	for _, f := range s.Failed {
+		failed = append(failed, GithubReleaseImportFailure{
+			GitTagName: f.GitTagName,
+			Reason:     f.Reason,
+		})
+	}
+
+	return GithubReleaseImportSummary{
+		Imported: imported,
+		Skipped:  skipped,
+		Failed:   failed,
+	}
+}
@@ -0,0 +1,147 @@
+package model
+
+import (
+	"time"
+
+	"release-manager/pkg/id"
+	svcmodel "release-manager/service/model"
+)
+
+type CreateReleasePlanInput struct {
+	Title       string                 `json:"title" validate:"required"`
+	TargetDate  *Date                  `json:"target_date" validate:"required"`
+	OwnerUserID *id.User               `json:"owner_user_id"`
+	ScopeItems  []ReleasePlanScopeItem `json:"scope_items"`
+}
+
+type UpdateReleasePlanInput struct {
+	Title       *string                 `json:"title" validate:"omitempty,min=1"`
+	TargetDate  *Date                   `json:"target_date"`
+	OwnerUserID *id.User                `json:"owner_user_id"`
+	UnsetOwner  bool                    `json:"unset_owner"`
+	ScopeItems  *[]ReleasePlanScopeItem `json:"scope_items"`
+}
+
+type ConvertReleasePlanInput struct {
+	GitTagName   string  `json:"git_tag_name" validate:"required"`
+	ReleaseNotes *string `json:"release_notes"`
+}
+
+type ReleasePlan struct {
+	ID          id.ReleasePlan         `json:"id"`
+	ProjectID   id.Project             `json:"project_id"`
+	Title       string                 `json:"title"`
+	TargetDate  Date                   `json:"target_date"`
+	OwnerUserID *id.User               `json:"owner_user_id"`
+	ScopeItems  []ReleasePlanScopeItem `json:"scope_items"`
+	ReleaseID   *id.Release            `json:"release_id"`
+	CreatedAt   time.Time              `json:"created_at"`
+	UpdatedAt   time.Time              `json:"updated_at"`
+}
+
+type ReleasePlanScopeItem struct {
+	Title  string `json:"title"`
+	Status string `json:"status"`
+}
+
+type ReleasePlanURLParams struct {
+	ProjectID id.Project     `param:"path=project_id"`
+	PlanID    id.ReleasePlan `param:"path=plan_id"`
+}
+
+type ListReleasePlansParams struct {
+	ProjectID      id.Project `param:"path=project_id"`
+	OwnerUserID    *id.User   `param:"query=owner_user_id"`
+	TargetDateFrom *Date      `param:"query=target_date_from"`
+	TargetDateTo   *Date      `param:"query=target_date_to"`
+	Converted      *bool      `param:"query=converted"`
+}
+
+func ToSvcCreateReleasePlanInput(input CreateReleasePlanInput) svcmodel.CreateReleasePlanInput {
+	var targetDate time.Time
+	if input.TargetDate != nil {
+		targetDate = time.Time(*input.TargetDate)
+	}
+
+	return svcmodel.CreateReleasePlanInput{
+		Title:       input.Title,
+		TargetDate:  targetDate,
+		OwnerUserID: input.OwnerUserID,
+		ScopeItems:  toSvcReleasePlanScopeItems(input.ScopeItems),
+	}
+}
+
+func ToSvcUpdateReleasePlanInput(input UpdateReleasePlanInput) svcmodel.UpdateReleasePlanInput {
+	var scopeItems *[]svcmodel.ReleasePlanScopeItem
+	if input.ScopeItems != nil {
+		items := toSvcReleasePlanScopeItems(*input.ScopeItems)
+		scopeItems = &items
+	}
+
+	return svcmodel.UpdateReleasePlanInput{
+		Title:       input.Title,
+		TargetDate:  input.TargetDate.toTimePtr(),
+		OwnerUserID: input.OwnerUserID,
+		UnsetOwner:  input.UnsetOwner,
+		ScopeItems:  scopeItems,
+	}
+}
+
+func ToSvcConvertReleasePlanInput(input ConvertReleasePlanInput) svcmodel.ConvertReleasePlanInput {
+	return svcmodel.ConvertReleasePlanInput{
+		GitTagName:   input.GitTagName,
+		ReleaseNotes: input.ReleaseNotes,
+	}
+}
+
+func ToSvcListReleasePlansFilterParams(p ListReleasePlansParams) svcmodel.ListReleasePlansFilterParams {
+	return svcmodel.ListReleasePlansFilterParams{
+		OwnerUserID:    p.OwnerUserID,
+		TargetDateFrom: p.TargetDateFrom.toTimePtr(),
+		TargetDateTo:   p.TargetDateTo.toTimePtr(),
+		Converted:      p.Converted,
+	}
+}
+
+func ToReleasePlan(p svcmodel.ReleasePlan) ReleasePlan {
+	scopeItems := make([]ReleasePlanScopeItem, 0, len(p.ScopeItems))
+	for _, item := range p.ScopeItems {
+		scopeItems = append(scopeItems, ReleasePlanScopeItem{
+			Title:  item.Title,
+			Status: string(item.Status),
+		})
+	}
+
+	return ReleasePlan{
+		ID:          p.ID,
+		ProjectID:   p.ProjectID,
+		Title:       p.Title,
+		TargetDate:  Date(p.TargetDate),
+		OwnerUserID: p.OwnerUserID,
+		ScopeItems:  scopeItems,
+		ReleaseID:   p.ReleaseID,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+	}
+}
+
+func ToReleasePlans(plans []svcmodel.ReleasePlan) []ReleasePlan {
+	p := make([]ReleasePlan, 0, len(plans))
+	for _, plan := range plans {
+		p = append(p, ToReleasePlan(plan))
+	}
+
+	return p
+}
+
+func toSvcReleasePlanScopeItems(items []ReleasePlanScopeItem) []svcmodel.ReleasePlanScopeItem {
+	scopeItems := make([]svcmodel.ReleasePlanScopeItem, 0, len(items))
+	for _, item := range items {
+		scopeItems = append(scopeItems, svcmodel.ReleasePlanScopeItem{
+			Title:  item.Title,
+			Status: svcmodel.ReleasePlanScopeItemStatus(item.Status),
+		})
+	}
+
+	return scopeItems
+}
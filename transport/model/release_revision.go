@@ -0,0 +1,94 @@
+package model
+
+import (
+	"time"
+
+	"release-manager/pkg/id"
+	svcmodel "release-manager/service/model"
+
+	"github.com/google/uuid"
+)
+
+type ReleaseRevisionURLParams struct {
+	ReleaseID      id.Release `param:"path=release_id"`
+	RevisionNumber int        `param:"path=revision_number"`
+}
+
+type DiffReleaseRevisionsParams struct {
+	ReleaseID  id.Release `param:"path=release_id"`
+	FromNumber int        `param:"query=from"`
+	ToNumber   int        `param:"query=to"`
+}
+
+type ReleaseRevision struct {
+	ID           uuid.UUID      `json:"id"`
+	Number       int            `json:"revision_number"`
+	Before       ReleaseContent `json:"before"`
+	After        ReleaseContent `json:"after"`
+	AuthorUserID id.AuthUser    `json:"author_user_id"`
+	CreatedAt    time.Time      `json:"created_at"`
+}
+
+type ReleaseContent struct {
+	ReleaseTitle string `json:"release_title"`
+	ReleaseNotes string `json:"release_notes"`
+}
+
+type ReleaseRevisionDiff struct {
+	FromNumber   int            `json:"from_revision_number"`
+	ToNumber     int            `json:"to_revision_number"`
+	From         ReleaseContent `json:"from"`
+	To           ReleaseContent `json:"to"`
+	TitleChanged bool           `json:"release_title_changed"`
+	NotesDiff    []DiffLine     `json:"release_notes_diff"`
+}
+
+type DiffLine struct {
+	Kind string `json:"kind"`
+	Text string `json:"text"`
+}
+
+func ToSvcDiffReleaseRevisionsParams(p DiffReleaseRevisionsParams) svcmodel.DiffReleaseRevisionsParams {
+	return svcmodel.DiffReleaseRevisionsParams{
+		FromNumber: p.FromNumber,
+		ToNumber:   p.ToNumber,
+	}
+}
+
+func ToReleaseRevision(r svcmodel.ReleaseRevision) ReleaseRevision {
+	return ReleaseRevision{
+		ID:           r.ID,
+		Number:       r.Number,
+		Before:       ReleaseContent(r.Before),
+		After:        ReleaseContent(r.After),
+		AuthorUserID: r.AuthorUserID,
+		CreatedAt:    r.CreatedAt,
+	}
+}
+
+func ToReleaseRevisions(revisions []svcmodel.ReleaseRevision) []ReleaseRevision {
+	r := make([]ReleaseRevision, 0, len(revisions))
+	for _, revision := range revisions {
+		r = append(r, ToReleaseRevision(revision))
+	}
+	return r
+}
+
+func ToReleaseRevisionDiff(d svcmodel.ReleaseRevisionDiff) ReleaseRevisionDiff {
+	lines := make([]DiffLine, 0, len(d.NotesDiff))
+	for _, l := range d.NotesDiff {
+		lines = append(lines, DiffLine{
+			Kind: string(l.Kind),
+			Text: l.Text,
+		})
+	}
+
+	return ReleaseRevisionDiff{
+		FromNumber:   d.FromNumber,
+		ToNumber:     d.ToNumber,
+		From:         ReleaseContent(d.From),
+		To:           ReleaseContent(d.To),
+		TitleChanged: d.TitleChanged,
+		NotesDiff:    lines,
+	}
+}
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"time"
+
+	"release-manager/pkg/id"
+	svcmodel "release-manager/service/model"
+)
+
+type SearchReleasesParams struct {
+	Query string `param:"query=q"`
+	Limit *int   `param:"query=limit"`
+}
+
+type ReleaseSearchHit struct {
+	ReleaseID      id.Release `json:"release_id"`
+	ProjectID      id.Project `json:"project_id"`
+	ProjectName    string     `json:"project_name"`
+	ReleaseTitle   string     `json:"release_title"`
+	GitTagName     string     `json:"git_tag_name"`
+	Status         string     `json:"status"`
+	CreatedAt      time.Time  `json:"created_at"`
+	Rank           float64    `json:"rank"`
+	TitleHighlight string     `json:"title_highlight"`
+	NotesSnippet   string     `json:"notes_snippet"`
+}
+
+func ToSvcSearchReleasesParams(p SearchReleasesParams) svcmodel.SearchReleasesParams {
+	limit := svcmodel.ReleaseSearchDefaultLimit
+	if p.Limit != nil {
+		limit = *p.Limit
+	}
+
+	return svcmodel.SearchReleasesParams{
+		Query: p.Query,
+		Limit: limit,
+	}
+}
+
+func ToReleaseSearchHits(hits []svcmodel.ReleaseSearchHit) []ReleaseSearchHit {
+	h := make([]ReleaseSearchHit, 0, len(hits))
+	for _, hit := range hits {
+		h = append(h, ReleaseSearchHit{
+			ReleaseID:      hit.ReleaseID,
+			ProjectID:      hit.ProjectID,
+			ProjectName:    hit.ProjectName,
+			ReleaseTitle:   hit.ReleaseTitle,
+			GitTagName:     hit.GitTagName,
+			Status:         string(hit.Status),
+			CreatedAt:      hit.CreatedAt,
+			Rank:           hit.Rank,
+			TitleHighlight: hit.TitleHighlight,
+			NotesSnippet:   hit.NotesSnippet,
+		})
+	}
+	return h
+}
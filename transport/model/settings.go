@@ -5,10 +5,12 @@ import (
 )
 
 type UpdateSettingsInput struct {
-	OrganizationName      *string                   `json:"organization_name" validate:"omitempty,min=1"`
-	DefaultReleaseMessage *string                   `json:"default_release_message" validate:"omitempty,min=1"`
-	Slack                 UpdateSlackSettingsInput  `json:"slack"`
-	Github                UpdateGithubSettingsInput `json:"github"`
+	OrganizationName      *string                      `json:"organization_name" validate:"omitempty,min=1"`
+	DefaultReleaseMessage *string                      `json:"default_release_message" validate:"omitempty,min=1"`
+	Slack                 UpdateSlackSettingsInput     `json:"slack"`
+	Github                UpdateGithubSettingsInput    `json:"github"`
+	Gitlab                UpdateGitlabSettingsInput    `json:"gitlab"`
+	Bitbucket             UpdateBitbucketSettingsInput `json:"bitbucket"`
 }
 
 type UpdateSlackSettingsInput struct {
@@ -20,13 +22,36 @@ type UpdateGithubSettingsInput struct {
 	Enabled       *bool                         `json:"enabled"`
 	Token         *svcmodel.GithubToken         `json:"token"`
 	WebhookSecret *svcmodel.GithubWebhookSecret `json:"webhook_secret"`
+	App           UpdateGithubAppSettingsInput  `json:"app"`
+}
+
+type UpdateGitlabSettingsInput struct {
+	Enabled       *bool                         `json:"enabled"`
+	BaseURL       *string                       `json:"base_url"`
+	Token         *svcmodel.GitlabToken         `json:"token"`
+	WebhookSecret *svcmodel.GitlabWebhookSecret `json:"webhook_secret"`
+}
+
+type UpdateBitbucketSettingsInput struct {
+	Enabled       *bool                            `json:"enabled"`
+	BaseURL       *string                          `json:"base_url"`
+	Token         *svcmodel.BitbucketToken         `json:"token"`
+	WebhookSecret *svcmodel.BitbucketWebhookSecret `json:"webhook_secret"`
+}
+
+type UpdateGithubAppSettingsInput struct {
+	AppID          *int64                        `json:"app_id"`
+	InstallationID *int64                        `json:"installation_id"`
+	PrivateKey     *svcmodel.GithubAppPrivateKey `json:"private_key"`
 }
 
 type Settings struct {
-	OrganizationName      string         `json:"organization_name"`
-	DefaultReleaseMessage string         `json:"default_release_message"`
-	Slack                 SlackSettings  `json:"slack"`
-	Github                GithubSettings `json:"github"`
+	OrganizationName      string            `json:"organization_name"`
+	DefaultReleaseMessage string            `json:"default_release_message"`
+	Slack                 SlackSettings     `json:"slack"`
+	Github                GithubSettings    `json:"github"`
+	Gitlab                GitlabSettings    `json:"gitlab"`
+	Bitbucket             BitbucketSettings `json:"bitbucket"`
 }
 
 type SlackSettings struct {
@@ -38,6 +63,28 @@ type GithubSettings struct {
 	Enabled       bool                         `json:"enabled"`
 	Token         svcmodel.GithubToken         `json:"token"`
 	WebhookSecret svcmodel.GithubWebhookSecret `json:"webhook_secret"`
+	App           GithubAppSettings            `json:"app"`
+}
+
+type GitlabSettings struct {
+	Enabled       bool                         `json:"enabled"`
+	BaseURL       string                       `json:"base_url"`
+	Token         svcmodel.GitlabToken         `json:"token"`
+	WebhookSecret svcmodel.GitlabWebhookSecret `json:"webhook_secret"`
+}
+
+type BitbucketSettings struct {
+	Enabled       bool                            `json:"enabled"`
+	BaseURL       string                          `json:"base_url"`
+	Token         svcmodel.BitbucketToken         `json:"token"`
+	WebhookSecret svcmodel.BitbucketWebhookSecret `json:"webhook_secret"`
+}
+
+// GithubAppSettings omits the private key, it can only be set. The response only tells whether it is set.
+type GithubAppSettings struct {
+	AppID          int64 `json:"app_id"`
+	InstallationID int64 `json:"installation_id"`
+	PrivateKeySet  bool  `json:"private_key_set"`
 }
 
 func ToSvcUpdateSettingsInput(u UpdateSettingsInput) svcmodel.UpdateSettingsInput {
@@ -52,7 +99,10 @@ func ToSvcUpdateSettingsInput(u UpdateSettingsInput) svcmodel.UpdateSettingsInpu
 			Enabled:       u.Github.Enabled,
 			Token:         u.Github.Token,
 			WebhookSecret: u.Github.WebhookSecret,
+			App:           svcmodel.UpdateGithubAppSettingsInput(u.Github.App),
 		},
+		Gitlab:    svcmodel.UpdateGitlabSettingsInput(u.Gitlab),
+		Bitbucket: svcmodel.UpdateBitbucketSettingsInput(u.Bitbucket),
 	}
 }
 
@@ -68,6 +118,13 @@ func ToSettings(s svcmodel.Settings) Settings {
 			Enabled:       s.Github.Enabled,
 			Token:         s.Github.Token,
 			WebhookSecret: s.Github.WebhookSecret,
+			App: GithubAppSettings{
+				AppID:          s.Github.App.AppID,
+				InstallationID: s.Github.App.InstallationID,
+				PrivateKeySet:  s.Github.App.PrivateKey != "",
+			},
 		},
+		Gitlab:    GitlabSettings(s.Gitlab),
+		Bitbucket: BitbucketSettings(s.Bitbucket),
 	}
 }
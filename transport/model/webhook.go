@@ -1,12 +1,96 @@
 package model
 
 import (
+	"time"
+
 	svcmodel "release-manager/service/model"
 )
 
-func ToSvcGithubTagDeletionWebhookInput(payload []byte, signature string) svcmodel.GithubTagDeletionWebhookInput {
-	return svcmodel.GithubTagDeletionWebhookInput{
+type ListGithubWebhookDeliveriesParams struct {
+	Status *string `param:"query=status"`
+	Event  *string `param:"query=event"`
+	Limit  *int    `param:"query=limit"`
+}
+
+type ReplayGithubWebhookDeliveryParams struct {
+	DeliveryID string `param:"path=delivery_id"`
+}
+
+type GithubWebhookDelivery struct {
+	DeliveryID     string     `json:"delivery_id"`
+	Event          string     `json:"event"`
+	RepoFullName   string     `json:"repo_full_name"`
+	SignatureValid bool       `json:"signature_valid"`
+	Status         string     `json:"status"`
+	Error          *string    `json:"error"`
+	ReplayCount    int        `json:"replay_count"`
+	ReceivedAt     time.Time  `json:"received_at"`
+	ProcessedAt    *time.Time `json:"processed_at"`
+}
+
+func ToSvcGithubWebhookDeliveryInput(deliveryID, event string, payload []byte, signature string) svcmodel.GithubWebhookDeliveryInput {
+	return svcmodel.GithubWebhookDeliveryInput{
+		DeliveryID: deliveryID,
+		Event:      event,
 		RawPayload: payload,
 		Signature:  signature,
 	}
 }
+
+func ToSvcGitlabWebhookInput(event, token string, payload []byte) svcmodel.GitlabWebhookInput {
+	return svcmodel.GitlabWebhookInput{
+		Event:      event,
+		Token:      token,
+		RawPayload: payload,
+	}
+}
+
+func ToSvcBitbucketWebhookInput(event, signature string, payload []byte) svcmodel.BitbucketWebhookInput {
+	return svcmodel.BitbucketWebhookInput{
+		Event:      event,
+		Signature:  signature,
+		RawPayload: payload,
+	}
+}
+
+func ToSvcListGithubWebhookDeliveriesParams(p ListGithubWebhookDeliveriesParams) svcmodel.ListGithubWebhookDeliveriesParams {
+	limit := svcmodel.GithubWebhookDeliveriesDefaultLimit
+	if p.Limit != nil {
+		limit = *p.Limit
+	}
+
+	var status *svcmodel.GithubWebhookDeliveryStatus
+	if p.Status != nil {
+		s := svcmodel.GithubWebhookDeliveryStatus(*p.Status)
+		status = &s
+	}
+
+	return svcmodel.ListGithubWebhookDeliveriesParams{
+		Status: status,
+		Event:  p.Event,
+		Limit:  limit,
+	}
+}
+
+// ToGithubWebhookDelivery leaves out the payload, it can be large and it is only needed to replay the delivery.
+func ToGithubWebhookDelivery(d svcmodel.GithubWebhookDelivery) GithubWebhookDelivery {
+	return GithubWebhookDelivery{
+		DeliveryID:     d.DeliveryID,
+		Event:          d.Event,
+		RepoFullName:   d.RepoFullName,
+		SignatureValid: d.SignatureValid,
+		Status:         string(d.Status),
+		Error:          d.Error,
+		ReplayCount:    d.ReplayCount,
+		ReceivedAt:     d.ReceivedAt,
+		ProcessedAt:    d.ProcessedAt,
+	}
+}
+
+func ToGithubWebhookDeliveries(deliveries []svcmodel.GithubWebhookDelivery) []GithubWebhookDelivery {
+	d := make([]GithubWebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		d = append(d, ToGithubWebhookDelivery(delivery))
+	}
+	return d
+}
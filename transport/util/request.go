@@ -2,7 +2,10 @@ package util
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 
 	"release-manager/pkg/validatorx"
@@ -63,3 +66,33 @@ func GetPathParam[TParam any, TPtrParam interface {
 	}
 	return *p, nil
 }
+
+// GetMultipartFile returns the file part of a multipart request with the given form field name.
+// The part is streamed directly from the request body, so the file is never loaded into memory as a whole.
+// Bodies larger than maxBytes are rejected with *http.MaxBytesError.
+func GetMultipartFile(w http.ResponseWriter, r *http.Request, fieldName string, maxBytes int64) (*multipart.Part, error) {
+	if r.ContentLength > maxBytes {
+		return nil, &http.MaxBytesError{Limit: maxBytes}
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxBytes)
+
+	reader, err := r.MultipartReader()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, fmt.Errorf("file in form field %q is required", fieldName)
+			}
+
+			return nil, err
+		}
+
+		if part.FormName() == fieldName && part.FileName() != "" {
+			return part, nil
+		}
+	}
+}
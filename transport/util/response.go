@@ -1,6 +1,7 @@
 package util
 
 import (
+	"fmt"
 	"log/slog"
 	"net/http"
 
@@ -27,3 +28,14 @@ func WriteJSONResponse(w http.ResponseWriter, status int, data any) {
 		slog.Error("writing json response", "error", err)
 	}
 }
+
+// WriteFileResponse writes data as a file which is downloaded by browsers instead of being displayed.
+func WriteFileResponse(w http.ResponseWriter, status int, contentType, fileName string, data []byte) {
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	w.WriteHeader(status)
+
+	if _, err := w.Write(data); err != nil {
+		slog.Error("writing file response", "error", err)
+	}
+}